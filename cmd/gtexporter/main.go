@@ -4,9 +4,10 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	log "github.com/golang/glog"
+	log "github.com/automixer/gtexporter/pkg/logger"
 	"os"
 	"os/signal"
+	"syscall"
 
 	// Local Packages
 	"github.com/automixer/gtexporter/pkg/core"
@@ -18,6 +19,7 @@ var (
 	buildDate  = ""
 	cfgFile    = flag.String("config", "", "Config file")
 	ver        = flag.Bool("version", false, "Print version info")
+	once       = flag.Bool("once", false, "Collect metrics once, print them to stdout and exit")
 )
 
 func main() {
@@ -57,13 +59,21 @@ func main() {
 		cancel()
 	}()
 
+	// SIGHUP triggers a configuration reload
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+
 	// Load app core
-	app, err := core.New(*cfgFile)
+	app, err := core.New(*cfgFile, appVersion)
 	if err != nil {
 		log.Error(err)
 		os.Exit(2)
 	}
-	err = app.Run(ctx)
+	if *once {
+		err = app.RunOnce(ctx, os.Stdout)
+	} else {
+		err = app.Run(ctx, reload)
+	}
 	if err != nil {
 		log.Error(err)
 		os.Exit(3)
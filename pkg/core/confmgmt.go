@@ -3,10 +3,14 @@ package core
 import (
 	"errors"
 	"fmt"
-	log "github.com/golang/glog"
+	log "github.com/automixer/gtexporter/pkg/logger"
 	"github.com/openconfig/gnmi/proto/gnmi"
+	"maps"
+	"net/netip"
 	"regexp"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	// Local packages
@@ -16,24 +20,42 @@ import (
 )
 
 const (
-	minScrapeInterval = time.Second
-	minSessionTTL     = 10 * time.Minute
+	minScrapeInterval  = time.Second
+	minSessionTTL      = 10 * time.Minute
+	defaultGaugeSuffix = "_gauges"
+	pluginInstanceSep  = "#"
+	maxExpandedDevices = 4096 // Max number of devices expanded from a single addresses key
+	namePlaceholderN   = "{n}"
+	namePlaceholderIP  = "{address}"
 )
 
 type yamlGlobalConfig struct {
 	InstanceName   string            `yaml:"instance_name"`
 	MetricPrefix   string            `yaml:"metric_prefix"`
+	GaugeSuffix    *string           `yaml:"gauge_suffix"`
 	ListenAddress  string            `yaml:"listen_address"`
 	ListenPort     string            `yaml:"listen_port"`
 	ListenPath     string            `yaml:"listen_path"`
 	ScrapeInterval string            `yaml:"scrape_interval"`
+	PushgatewayURL string            `yaml:"pushgateway_url"`
+	DisableSelfMon bool              `yaml:"disable_self_monitoring"`
+	SelfMonPath    string            `yaml:"self_monitoring_path"`
+	MaxScrapes     int               `yaml:"max_concurrent_scrapes"`
+	CacheTTL       string            `yaml:"cache_ttl"`
+	LogFormat      string            `yaml:"log_format"`
+	SystemdSocket  bool              `yaml:"use_systemd_socket"`
+	DebugEndpoints bool              `yaml:"debug_endpoints"`
+	DisableRuntime bool              `yaml:"disable_runtime_metrics"`
+	AdminToken     string            `yaml:"admin_token"`
 	StaticLabels   map[string]string `yaml:"static_labels"`
 }
 
 type yamlDevConfig struct {
-	Keys    map[string]string `yaml:"devices,inline"`
-	Plugins []string          `yaml:"plugins"`
-	Options map[string]string `yaml:"options"`
+	Keys            map[string]string            `yaml:"devices,inline"`
+	Plugins         []string                     `yaml:"plugins"`
+	Options         map[string]string            `yaml:"options"`
+	InstanceOptions map[string]map[string]string `yaml:"instance_options"` // Key: plugin instance name
+	GrpcMetadata    map[string]string            `yaml:"grpc_metadata"`
 }
 
 type yamlConfig struct {
@@ -56,6 +78,13 @@ func (c *Core) parseAppConfig(yCfg *yamlConfig) error {
 	if yCfg.Devices == nil {
 		return errors.New("no devices configured")
 	}
+	if _, ok := yCfg.Templates.Keys["addresses"]; ok {
+		return errors.New("the addresses key is not allowed in device_template")
+	}
+	yCfg.Devices, err = expandDevices(yCfg.Devices)
+	if err != nil {
+		return err
+	}
 	for i, devCfg := range yCfg.Devices {
 		// Keys
 		for k, v := range yCfg.Templates.Keys {
@@ -74,6 +103,13 @@ func (c *Core) parseAppConfig(yCfg *yamlConfig) error {
 		if devCfg.Options == nil {
 			yCfg.Devices[i].Options = yCfg.Templates.Options
 		}
+		if devCfg.InstanceOptions == nil {
+			yCfg.Devices[i].InstanceOptions = yCfg.Templates.InstanceOptions
+		}
+		// gRPC metadata
+		if devCfg.GrpcMetadata == nil {
+			yCfg.Devices[i].GrpcMetadata = yCfg.Templates.GrpcMetadata
+		}
 	}
 
 	// Check devices cfg
@@ -96,6 +132,7 @@ func (c *Core) parseAppConfig(yCfg *yamlConfig) error {
 	// Build Gnmi Clients and plugins config
 	c.clientCfg = make(map[string]gnmiclient.Config, len(yCfg.Devices))
 	c.plugCfg = make(map[string][]plugins.Config, len(yCfg.Devices))
+	c.maxSeries = make(map[string]int, len(yCfg.Devices))
 	for i := range yCfg.Devices {
 		c.buildGnmiClientCfg(yCfg, i)
 		c.buildPluginCfg(yCfg, i)
@@ -119,40 +156,265 @@ func (c *Core) validateGlobalConfig(yCfg *yamlConfig) error {
 	if yCfg.Global.ListenPath == "" {
 		yCfg.Global.ListenPath = "/metrics"
 	}
+	if yCfg.Global.SelfMonPath != "" && !strings.HasPrefix(yCfg.Global.SelfMonPath, "/") {
+		return fmt.Errorf("self_monitoring_path must start with /")
+	}
+	if yCfg.Global.SelfMonPath == "/" && yCfg.Global.ListenPath != "/" {
+		return fmt.Errorf("self_monitoring_path cannot be / unless listen_path is /")
+	}
+	if yCfg.Global.MaxScrapes < 0 {
+		return fmt.Errorf("max_concurrent_scrapes cannot be negative")
+	}
+	switch yCfg.Global.LogFormat {
+	case "", log.FormatText, log.FormatJSON:
+		c.logFormat = yCfg.Global.LogFormat
+	default:
+		return fmt.Errorf("unknown log format: %s", yCfg.Global.LogFormat)
+	}
 	rx := regexp.MustCompile("^[a-zA-Z0-9_]*$")
 	if !rx.MatchString(yCfg.Global.MetricPrefix) {
 		return fmt.Errorf("%s is not a valid Prometheus metric name", yCfg.Global.MetricPrefix)
 	}
-	sInt, _ := time.ParseDuration(yCfg.Global.ScrapeInterval)
+	if yCfg.Global.GaugeSuffix == nil {
+		yCfg.Global.GaugeSuffix = new(string)
+		*yCfg.Global.GaugeSuffix = defaultGaugeSuffix
+	}
+	if !rx.MatchString(*yCfg.Global.GaugeSuffix) {
+		return fmt.Errorf("%s is not a valid Prometheus metric suffix", *yCfg.Global.GaugeSuffix)
+	}
+	sInt, err := parseDuration(yCfg.Global.ScrapeInterval)
+	if err != nil {
+		return fmt.Errorf("invalid scrape_interval: %w", err)
+	}
 	if sInt < minScrapeInterval {
 		return fmt.Errorf("scrape interval must be greater than or equal to %s", minScrapeInterval)
 	}
+	c.scrapeInterval = sInt
+	ttl, err := parseDuration(yCfg.Global.CacheTTL)
+	if err != nil || ttl < 0 {
+		return fmt.Errorf("cache_ttl must be a non negative duration")
+	}
+	if ttl >= sInt {
+		return fmt.Errorf("cache_ttl must be shorter than scrape_interval")
+	}
 	if yCfg.Global.StaticLabels == nil {
 		yCfg.Global.StaticLabels = make(map[string]string)
 	} else {
+		pluginLabels := plugins.LabelNames()
 		for k := range yCfg.Global.StaticLabels {
 			if !rx.MatchString(k) {
 				return fmt.Errorf("%s is not a valid Prometheus static_label name", k)
 			}
+			if slices.Contains(exporter.ReservedLabels, k) {
+				return fmt.Errorf("static_label %s collides with an automatic label", k)
+			}
+			if pluginLabels[k] {
+				return fmt.Errorf("static_label %s collides with a plugin label", k)
+			}
 		}
 	}
 	return nil
 }
 
+// expandDevices replaces the device entries carrying the addresses key with one entry per address.
+// The addresses key is a comma separated list of addresses, FQDNs or IP ranges (e.g.: 10.0.0.1-10.0.0.200).
+// The device name is a pattern that must contain the {n} (1-based address position) or the {address}
+// placeholder. Expanded devices inherit all the other keys of the entry.
+func expandDevices(devices []yamlDevConfig) ([]yamlDevConfig, error) {
+	out := make([]yamlDevConfig, 0, len(devices))
+	for _, dev := range devices {
+		list, ok := dev.Keys["addresses"]
+		if !ok {
+			out = append(out, dev)
+			continue
+		}
+		pattern := dev.Keys["name"]
+		if !strings.Contains(pattern, namePlaceholderN) && !strings.Contains(pattern, namePlaceholderIP) {
+			return nil, fmt.Errorf("%s: the name of a device with addresses must contain %s or %s",
+				pattern, namePlaceholderN, namePlaceholderIP)
+		}
+		if dev.Keys["address"] != "" {
+			return nil, fmt.Errorf("%s: address and addresses keys are mutually exclusive", pattern)
+		}
+		addresses, err := parseAddresses(list)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", pattern, err)
+		}
+		for i, address := range addresses {
+			newDev := dev
+			newDev.Keys = maps.Clone(dev.Keys)
+			delete(newDev.Keys, "addresses")
+			newDev.Keys["address"] = address
+			newDev.Keys["name"] = strings.NewReplacer(
+				namePlaceholderN, strconv.Itoa(i+1),
+				namePlaceholderIP, address,
+			).Replace(pattern)
+			out = append(out, newDev)
+		}
+	}
+	return out, nil
+}
+
+// splitList splits a comma separated list, dropping blanks and empty items.
+func splitList(list string) []string {
+	var out []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// parseAddresses expands a comma separated list of addresses and IP ranges.
+func parseAddresses(list string) ([]string, error) {
+	var out []string
+	for _, item := range strings.Split(strings.ReplaceAll(list, " ", ""), ",") {
+		if item == "" {
+			continue
+		}
+		first, last, isRange := strings.Cut(item, "-")
+		if !isRange {
+			// Single address or FQDN
+			out = append(out, item)
+			continue
+		}
+		start, err1 := netip.ParseAddr(first)
+		end, err2 := netip.ParseAddr(last)
+		if err1 != nil || err2 != nil || start.Is4() != end.Is4() || end.Less(start) {
+			// Not a range: FQDNs can contain dashes
+			if err1 != nil && err2 != nil {
+				out = append(out, item)
+				continue
+			}
+			return nil, fmt.Errorf("invalid address range: %s", item)
+		}
+		for addr := start; addr.Compare(end) <= 0; addr = addr.Next() {
+			if len(out) == maxExpandedDevices {
+				return nil, fmt.Errorf("addresses expand to more than %d devices", maxExpandedDevices)
+			}
+			out = append(out, addr.String())
+		}
+	}
+	if len(out) == 0 {
+		return nil, errors.New("addresses key is empty")
+	}
+	return out, nil
+}
+
 // validateDeviceConfig checks if mandatory keys are present
 func (c *Core) validateDeviceConfig(yCfg *yamlDevConfig) error {
 	if _, ok := yCfg.Keys["name"]; !ok {
 		return fmt.Errorf("device section must contain a device name")
 	}
-	if yCfg.Keys["address"] == "" {
-		return fmt.Errorf("device section must contain an address")
+	// Replayed devices are never dialed
+	if yCfg.Keys["replay_file"] == "" {
+		if len(splitList(yCfg.Keys["address"])) == 0 {
+			return fmt.Errorf("device section must contain an address")
+		}
+		if yCfg.Keys["port"] == "" {
+			return fmt.Errorf("device section must contain a port")
+		}
+	}
+	for _, plugId := range yCfg.Plugins {
+		plugName, instance, found := strings.Cut(plugId, pluginInstanceSep)
+		if plugName == "" || (found && instance == "") {
+			return fmt.Errorf("%s: invalid plugin name %s", yCfg.Keys["name"], plugId)
+		}
+		if !slices.Contains(plugins.RegisteredPlugins(), plugName) {
+			return fmt.Errorf("%s: unknown plugin %s. Available plugins: %s", yCfg.Keys["name"], plugName,
+				strings.Join(plugins.RegisteredPlugins(), ", "))
+		}
+	}
+	rx := regexp.MustCompile("^[a-z0-9_.-]+$")
+	for k := range yCfg.GrpcMetadata {
+		if !rx.MatchString(k) || strings.HasPrefix(k, "grpc-") || strings.HasSuffix(k, "-bin") {
+			return fmt.Errorf("%s: %s is not a valid grpc_metadata header name", yCfg.Keys["name"], k)
+		}
+	}
+	switch yCfg.Keys["auth_mode"] {
+	case "", "password":
+	case "mtls":
+		tls, _ := strconv.ParseBool(yCfg.Keys["tls"])
+		if !tls || yCfg.Keys["tls_cert"] == "" || yCfg.Keys["tls_key"] == "" {
+			return fmt.Errorf("%s: auth_mode mtls requires tls, tls_cert and tls_key", yCfg.Keys["name"])
+		}
+		if yCfg.Keys["user"] != "" || yCfg.Keys["password"] != "" || yCfg.Keys["token_file"] != "" {
+			log.With("device", yCfg.Keys["name"]).Warning(
+				"auth_mode is mtls, user, password and token_file are not sent to the device")
+		}
+	default:
+		return fmt.Errorf("%s: invalid auth_mode %s", yCfg.Keys["name"], yCfg.Keys["auth_mode"])
+	}
+	if yCfg.Keys["token_file"] != "" && (yCfg.Keys["user"] != "" || yCfg.Keys["password"] != "") {
+		log.With("device", yCfg.Keys["name"]).Warning(
+			"token_file is set, user and password are not sent to the device")
 	}
-	if yCfg.Keys["port"] == "" {
-		return fmt.Errorf("device section must contain a port")
+	if _, err := parseBuckets(yCfg.Keys["notification_size_buckets"]); err != nil {
+		return fmt.Errorf("%s: invalid notification_size_buckets: %w", yCfg.Keys["name"], err)
+	}
+	if yCfg.Keys["proxy"] != "" {
+		if err := gnmiclient.CheckProxy(yCfg.Keys["proxy"]); err != nil {
+			return fmt.Errorf("%s: %w", yCfg.Keys["name"], err)
+		}
+	}
+	if yCfg.Keys["gnmi_history_snapshot"] != "" {
+		if _, err := time.Parse(time.RFC3339, yCfg.Keys["gnmi_history_snapshot"]); err != nil {
+			return fmt.Errorf("%s: gnmi_history_snapshot must be a RFC3339 timestamp", yCfg.Keys["name"])
+		}
+	}
+	if yCfg.Keys["sample_interval"] != "" {
+		sInt, err := time.ParseDuration(yCfg.Keys["sample_interval"])
+		if err != nil || sInt <= 0 {
+			return fmt.Errorf("%s: sample_interval must be a positive duration", yCfg.Keys["name"])
+		}
+	}
+	if yCfg.Keys["max_series_per_device"] != "" {
+		maxSeries, err := strconv.Atoi(yCfg.Keys["max_series_per_device"])
+		if err != nil || maxSeries < 0 {
+			return fmt.Errorf("%s: invalid max_series_per_device: %s", yCfg.Keys["name"], yCfg.Keys["max_series_per_device"])
+		}
+	}
+	for _, key := range []string{"heartbeat_interval", "max_life", "down_grace_period"} {
+		d, err := parseDuration(yCfg.Keys[key])
+		if err != nil {
+			return fmt.Errorf("%s: invalid %s: %w", yCfg.Keys["name"], key, err)
+		}
+		if d < 0 {
+			return fmt.Errorf("%s: %s cannot be negative", yCfg.Keys["name"], key)
+		}
 	}
 	return nil
 }
 
+// parseBuckets parses a comma separated list of histogram bucket upper bounds.
+// Bounds must be positive and in increasing order. An empty value means no buckets.
+func parseBuckets(value string) ([]float64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var out []float64
+	for _, item := range strings.Split(value, ",") {
+		bound, err := strconv.ParseFloat(strings.TrimSpace(item), 64)
+		if err != nil {
+			return nil, err
+		}
+		if bound <= 0 || (len(out) > 0 && bound <= out[len(out)-1]) {
+			return nil, errors.New("bounds must be positive and in increasing order")
+		}
+		out = append(out, bound)
+	}
+	return out, nil
+}
+
+// parseDuration parses a duration config value. An empty value means zero.
+func parseDuration(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(value)
+}
+
 // buildExporterCfg builds the exporter configuration struct based on the provided yamlConfig object.
 func (c *Core) buildExporterCfg(yCfg *yamlConfig) {
 	c.exporterCfg = exporter.Config{
@@ -161,6 +423,25 @@ func (c *Core) buildExporterCfg(yCfg *yamlConfig) {
 		ListenPath:    yCfg.Global.ListenPath,
 		InstanceName:  yCfg.Global.InstanceName,
 		MetricPrefix:  yCfg.Global.MetricPrefix,
+		GaugeSuffix:   *yCfg.Global.GaugeSuffix,
+		PushURL:       yCfg.Global.PushgatewayURL,
+		MaxScrapes:    yCfg.Global.MaxScrapes,
+	}
+	// Durations are validated by validateGlobalConfig
+	c.exporterCfg.PushInterval = c.scrapeInterval
+	c.exporterCfg.CacheTTL, _ = parseDuration(yCfg.Global.CacheTTL)
+	c.exporterCfg.UseSystemdSocket = yCfg.Global.SystemdSocket
+	c.exporterCfg.DebugEndpoints = yCfg.Global.DebugEndpoints
+	c.exporterCfg.DisableRuntime = yCfg.Global.DisableRuntime
+	c.exporterCfg.AdminToken = yCfg.Global.AdminToken
+	c.exporterCfg.Plugins = plugins.DataModels()
+	c.exporterCfg.GroupPaths = map[string]string{exporter.SelfMonGroup: yCfg.Global.SelfMonPath}
+	c.exporterCfg.DevInstances = make(map[string]string)
+	for _, dev := range yCfg.Devices {
+		c.exporterCfg.Devices = append(c.exporterCfg.Devices, dev.Keys["name"])
+		if dev.Keys["instance_name"] != "" {
+			c.exporterCfg.DevInstances[dev.Keys["name"]] = dev.Keys["instance_name"]
+		}
 	}
 	for k, v := range yCfg.Global.StaticLabels {
 		c.exporterCfg.StaticLabels = append(c.exporterCfg.StaticLabels, exporter.StaticLabel{Key: k, Value: v})
@@ -172,37 +453,72 @@ func (c *Core) buildGnmiClientCfg(yCfg *yamlConfig, index int) {
 	src := yCfg.Devices[index]
 	// String values
 	newDev := gnmiclient.Config{
-		IPAddress:     src.Keys["address"],
+		Addresses:     splitList(src.Keys["address"]),
 		Port:          src.Keys["port"],
 		User:          src.Keys["user"],
 		Password:      src.Keys["password"],
+		TokenFile:     src.Keys["token_file"],
+		PathOrigin:    src.Keys["path_origin"],
 		TLSCert:       src.Keys["tls_cert"],
 		TLSKey:        src.Keys["tls_key"],
 		TLSCa:         src.Keys["tls_ca"],
+		TLSServerName: src.Keys["tls_server_name"],
+		GrpcMetadata:  src.GrpcMetadata,
+		GrpcUserAgent: src.Keys["grpc_user_agent"],
+		GnmiTarget:    src.Keys["gnmi_target"],
 		ForceEncoding: src.Keys["force_encoding"],
 		DevName:       src.Keys["name"],
 		Vendor:        src.Keys["vendor"],
+		ReplayFile:    src.Keys["replay_file"],
+		Proxy:         src.Keys["proxy"],
 	}
 	// Bool values
 	flag, _ := strconv.ParseBool(src.Keys["tls"])
 	newDev.TLS = flag
 	flag, _ = strconv.ParseBool(src.Keys["tls_insecure_skip_verify"])
 	newDev.TLSInsecureSkipVerify = flag
+	newDev.MTLSAuth = src.Keys["auth_mode"] == "mtls"
+	newDev.DisableSelfMon = yCfg.Global.DisableSelfMon
 	flag, _ = strconv.ParseBool(src.Keys["on_change"])
 	if flag {
 		newDev.GnmiSubscriptionMode = gnmi.SubscriptionMode_ON_CHANGE
 	} else {
 		newDev.GnmiSubscriptionMode = gnmi.SubscriptionMode_SAMPLE
 	}
+	flag, _ = strconv.ParseBool(src.Keys["suppress_redundant"])
+	newDev.SuppressRedundant = flag
+	if flag && src.Keys["mode"] != "cache" {
+		log.With("device", newDev.DevName).Warning(
+			"suppress_redundant should be used with cache mode. Suppressed samples produce gaps.")
+	}
+	flag, _ = strconv.ParseBool(src.Keys["allow_aggregation"])
+	newDev.AllowAggregation = flag
+	flag, _ = strconv.ParseBool(src.Keys["log_unrouted_paths"])
+	newDev.LogUnrouted = flag
+	flag, _ = strconv.ParseBool(src.Keys["replay_loop"])
+	newDev.ReplayLoop = flag
 	// Int values
 	newDev.OverSampling, _ = strconv.ParseInt(src.Keys["oversampling"], 10, 64)
-	// Duration values
-	scrapeInterval, _ := time.ParseDuration(yCfg.Global.ScrapeInterval)
+	newDev.MaxFailures, _ = strconv.ParseInt(src.Keys["max_consecutive_failures"], 10, 64)
+	// List values. Validated by validateDeviceConfig
+	newDev.NfSizeBuckets, _ = parseBuckets(src.Keys["notification_size_buckets"])
+	// Duration values. Validated by validateDeviceConfig
+	scrapeInterval := c.scrapeInterval
 	newDev.ScrapeInterval = scrapeInterval
-	maxLife, err := time.ParseDuration(src.Keys["max_life"])
-	if err == nil && maxLife < minSessionTTL {
-		log.Warningf("%s: max_life cannot be less than %s.", newDev.DevName, minSessionTTL)
-		maxLife = 0
+	newDev.SampleInterval, _ = parseDuration(src.Keys["sample_interval"])
+	newDev.HeartbeatInterval, _ = parseDuration(src.Keys["heartbeat_interval"])
+	newDev.DownGracePeriod, _ = parseDuration(src.Keys["down_grace_period"])
+	newDev.HistorySnapshot, _ = time.Parse(time.RFC3339, src.Keys["gnmi_history_snapshot"])
+	if newDev.SampleInterval > scrapeInterval {
+		log.With("device", newDev.DevName).Warning("sample_interval is greater than scrape_interval. Samples will be repeated.")
+	}
+	maxLife, _ := parseDuration(src.Keys["max_life"])
+	if maxLife > 0 && maxLife < minSessionTTL {
+		log.With("device", newDev.DevName).Warningf("max_life cannot be less than %s. Using %s.", minSessionTTL, minSessionTTL)
+		maxLife = minSessionTTL
+	}
+	if maxLife > 0 {
+		log.With("device", newDev.DevName).Infof("effective max_life is %s (plus up to 10%% jitter).", maxLife)
 	}
 	newDev.MaxLife = maxLife
 	// Plugin mode
@@ -220,11 +536,15 @@ func (c *Core) buildGnmiClientCfg(yCfg *yamlConfig, index int) {
 func (c *Core) buildPluginCfg(yCfg *yamlConfig, index int) {
 	src := yCfg.Devices[index]
 	c.plugCfg[src.Keys["name"]] = make([]plugins.Config, 0, len(src.Plugins))
-	for _, plugName := range src.Plugins {
+	// Series limit shared by the device plugins. Validated by validateDeviceConfig
+	c.maxSeries[src.Keys["name"]], _ = strconv.Atoi(src.Keys["max_series_per_device"])
+	for _, plugId := range src.Plugins {
+		plugName, _, _ := strings.Cut(plugId, pluginInstanceSep)
 		// String values
 		newPlug := plugins.Config{
 			DevName:      src.Keys["name"],
 			PlugName:     plugName,
+			PlugId:       plugId,
 			CustomLabel:  src.Keys["custom_label"],
 			DescSanitize: src.Keys["desc_sanitize"],
 			Options:      make(map[string]string),
@@ -237,17 +557,24 @@ func (c *Core) buildPluginCfg(yCfg *yamlConfig, index int) {
 		// Bool values
 		flag, _ := strconv.ParseBool(src.Keys["use_go_defaults"])
 		newPlug.UseGoDefaults = flag
+		newPlug.DisableSelfMon = yCfg.Global.DisableSelfMon
 		// Plugin mode
 		if src.Keys["mode"] == "cache" {
 			newPlug.CacheData = true
 		}
 		// Duration values
-		scrapeInterval, _ := time.ParseDuration(yCfg.Global.ScrapeInterval)
-		newPlug.ScrapeInterval = scrapeInterval
-		c.plugCfg[src.Keys["name"]] = append(c.plugCfg[src.Keys["name"]], newPlug)
+		newPlug.ScrapeInterval = c.scrapeInterval
 		// Plugin options
 		for k, v := range src.Options {
 			newPlug.Options[k] = v
 		}
+		// Plugin instance options override plugin options
+		for k, v := range src.InstanceOptions[plugId] {
+			newPlug.Options[k] = v
+		}
+		// Common plugin options
+		flag, _ = strconv.ParseBool(newPlug.Options["disable_gnmi_delete"])
+		newPlug.DisableDeletes = flag
+		c.plugCfg[src.Keys["name"]] = append(c.plugCfg[src.Keys["name"]], newPlug)
 	}
 }
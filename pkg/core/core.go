@@ -3,9 +3,14 @@ package core
 import (
 	"context"
 	"fmt"
-	log "github.com/golang/glog"
+	log "github.com/automixer/gtexporter/pkg/logger"
 	"gopkg.in/yaml.v2"
+	"io"
+	"maps"
 	"os"
+	"reflect"
+	"slices"
+	"time"
 
 	// Local packages
 	"github.com/automixer/gtexporter/pkg/exporter"
@@ -13,18 +18,39 @@ import (
 	"github.com/automixer/gtexporter/pkg/plugins"
 
 	// Plugins registration
+	_ "github.com/automixer/gtexporter/pkg/plugins/ocacl"
 	_ "github.com/automixer/gtexporter/pkg/plugins/ocinterfaces"
+	_ "github.com/automixer/gtexporter/pkg/plugins/ocipnbr"
 	_ "github.com/automixer/gtexporter/pkg/plugins/oclldp"
+	_ "github.com/automixer/gtexporter/pkg/plugins/ocni"
+	_ "github.com/automixer/gtexporter/pkg/plugins/ocqos"
+	_ "github.com/automixer/gtexporter/pkg/plugins/ocsystem"
+	_ "github.com/automixer/gtexporter/pkg/plugins/octermdev"
 )
 
+// metricExporter is the set of exporter methods used by the core.
+type metricExporter interface {
+	Start() error
+	Close()
+	WriteText(w io.Writer) error
+	CheckConfig(cfg exporter.Config) error
+	Reconfigure(cfg exporter.Config) error
+	ReloadDone(err error)
+}
+
 type Core struct {
-	exporterCfg exporter.Config
-	clientCfg   map[string]gnmiclient.Config // Key: device name
-	plugCfg     map[string][]plugins.Config  // Key: device name
+	cfgFile        string
+	appVersion     string
+	logFormat      string
+	scrapeInterval time.Duration
+	exporterCfg    exporter.Config
+	clientCfg      map[string]gnmiclient.Config // Key: device name
+	plugCfg        map[string][]plugins.Config  // Key: device name
+	maxSeries      map[string]int               // Key: device name. max_series_per_device, zero means no limit
 }
 
-func New(cfgFile string) (*Core, error) {
-	app := Core{}
+func New(cfgFile, appVersion string) (*Core, error) {
+	app := Core{cfgFile: cfgFile, appVersion: appVersion}
 	yCfg := &yamlConfig{}
 
 	f, err := os.ReadFile(cfgFile)
@@ -41,34 +67,209 @@ func New(cfgFile string) (*Core, error) {
 	if err != nil {
 		return nil, err
 	}
+	app.exporterCfg.AppVersion = appVersion
 	return &app, err
 }
 
-func (c *Core) Run(ctx context.Context) error {
+// Run loads and starts the exporter and the devices, then waits for ctx to be done.
+// Each signal received from reload triggers a configuration reload.
+func (c *Core) Run(ctx context.Context, reload <-chan os.Signal) error {
+	_ = log.SetFormat(c.logFormat)
+	pExp, clientList, err := c.load()
+	if err != nil {
+		return err
+	}
+
+	// Start the exporter
+	if err := pExp.Start(); err != nil {
+		return err
+	}
+
+	// Start devices
+	startClients(clientList)
+
+	// Wait for exiting
+	for {
+		select {
+		case <-reload:
+			clientList = c.reload(pExp, clientList)
+			continue
+		case <-ctx.Done():
+		}
+		break
+	}
+	// First stop the exporter
+	pExp.Close()
+	// Then unload all devices
+	closeClients(clientList)
+	return nil
+}
+
+// reload reads the configuration file again and replaces the running devices with the configured ones.
+// It returns the running device list. On failure, the previous configuration is kept active:
+// if the new devices cannot be loaded, the previous ones are loaded again.
+func (c *Core) reload(pExp metricExporter, clientList []*gnmiclient.GnmiClient) []*gnmiclient.GnmiClient {
+	log.Infof("Reloading configuration from %s...", c.cfgFile)
+	newCore, err := New(c.cfgFile, c.appVersion)
+	if err == nil {
+		err = pExp.CheckConfig(newCore.exporterCfg)
+	}
+	if err != nil {
+		log.Errorf("Configuration reload failed, keeping the previous configuration: %s", err)
+		pExp.ReloadDone(err)
+		return clientList
+	}
+
+	// Credential-only changes are applied to the running devices, without restarting them all
+	if changed, ok := c.credentialChanges(newCore); ok && len(changed) > 0 {
+		// clientList follows the device names order (see loadClients)
+		for i, name := range slices.Sorted(maps.Keys(c.clientCfg)) {
+			if cfg, ok := changed[name]; ok {
+				clientList[i].UpdateCredentials(cfg.User, cfg.Password)
+			}
+		}
+		*c = *newCore
+		pExp.ReloadDone(nil)
+		log.Infof("Configuration reloaded, credentials updated for %d device(s)...", len(changed))
+		return clientList
+	}
+
+	// Replace devices
+	closeClients(clientList)
+	newList, err := newCore.loadClients(pExp)
+	if err != nil {
+		log.Errorf("Configuration reload failed, restoring the previous configuration: %s", err)
+		pExp.ReloadDone(err)
+		oldList, err := c.loadClients(pExp)
+		if err != nil {
+			// The previous configuration was loaded successfully: this is not expected
+			log.Error(err)
+		}
+		startClients(oldList)
+		return oldList
+	}
+	*c = *newCore
+	_ = log.SetFormat(c.logFormat)
+	startClients(newList)
+	pExp.ReloadDone(nil)
+	log.Info("Configuration reloaded...")
+	return newList
+}
+
+// credentialChanges returns the devices whose user or password differ in newCore, as long as these are
+// the only changes to the configuration. Otherwise, ok is false. Devices using a token_file are never returned,
+// since their user and password are not used.
+func (c *Core) credentialChanges(newCore *Core) (changed map[string]gnmiclient.Config, ok bool) {
+	oldCore, nextCore := *c, *newCore
+	oldCore.clientCfg = withoutCredentials(c.clientCfg)
+	nextCore.clientCfg = withoutCredentials(newCore.clientCfg)
+	if !reflect.DeepEqual(oldCore, nextCore) {
+		return nil, false
+	}
+	changed = make(map[string]gnmiclient.Config)
+	for name, cfg := range newCore.clientCfg {
+		old := c.clientCfg[name]
+		if cfg.TokenFile == "" && (cfg.User != old.User || cfg.Password != old.Password) {
+			changed[name] = cfg
+		}
+	}
+	return changed, true
+}
+
+// withoutCredentials returns a copy of the given device configurations, with user and password cleared.
+func withoutCredentials(clientCfg map[string]gnmiclient.Config) map[string]gnmiclient.Config {
+	out := make(map[string]gnmiclient.Config, len(clientCfg))
+	for name, cfg := range clientCfg {
+		cfg.User, cfg.Password = "", ""
+		out[name] = cfg
+	}
+	return out
+}
+
+// startClients starts the given devices.
+func startClients(clientList []*gnmiclient.GnmiClient) {
+	for _, dev := range clientList {
+		err := dev.Start()
+		if err != nil {
+			log.Error(err)
+		}
+	}
+}
+
+// closeClients stops the given devices.
+func closeClients(clientList []*gnmiclient.GnmiClient) {
+	for _, dev := range clientList {
+		dev.Close()
+	}
+}
+
+// RunOnce loads and starts the devices, waits for one scrape interval, writes the collected metrics
+// to w using the Prometheus text exposition format and exits. The http server is not started.
+func (c *Core) RunOnce(ctx context.Context, w io.Writer) error {
+	_ = log.SetFormat(c.logFormat)
+	pExp, clientList, err := c.load()
+	if err != nil {
+		return err
+	}
+
+	// Start devices
+	startClients(clientList)
+
+	// Wait for the devices to send their telemetries
+	select {
+	case <-ctx.Done():
+	case <-time.After(c.scrapeInterval):
+		err = pExp.WriteText(w)
+	}
+
+	// First stop the exporter
+	pExp.Close()
+	// Then unload all devices
+	closeClients(clientList)
+	return err
+}
+
+// load creates the Prometheus exporter and the devices, with their plugins.
+func (c *Core) load() (metricExporter, []*gnmiclient.GnmiClient, error) {
 	// Load Prometheus exporter
 	pExp, err := exporter.New(c.exporterCfg)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+	clientList, err := c.loadClients(pExp)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pExp, clientList, nil
+}
+
+// loadClients creates the devices, with their plugins, registering them to the exporter.
+// Previously registered metric sources are removed.
+func (c *Core) loadClients(pExp metricExporter) ([]*gnmiclient.GnmiClient, error) {
+	if err := pExp.Reconfigure(c.exporterCfg); err != nil {
+		return nil, err
 	}
 
-	// Load devices (gNMI Clients)
+	// Load devices (gNMI Clients), sorted by name to keep startup logs and registration order reproducible
 	clientList := make([]*gnmiclient.GnmiClient, 0, len(c.clientCfg))
 	clientCount := 0
 	plugCount := 0
-	for clientName, clientCfg := range c.clientCfg {
-		gClt, err := gnmiclient.New(clientCfg)
+	for _, clientName := range slices.Sorted(maps.Keys(c.clientCfg)) {
+		gClt, err := gnmiclient.New(c.clientCfg[clientName])
 		if err != nil {
-			return err
+			return nil, err
 		}
-		// Load and register plugins to the newly created device
+		// Load and register plugins to the newly created device. They share the device series budget
+		budget := plugins.NewSeriesBudget(c.maxSeries[clientName])
 		for _, plugCfg := range c.plugCfg[clientName] {
+			plugCfg.SeriesBudget = budget
 			newPlug, err := plugins.New(plugCfg)
 			if err != nil {
-				return err
+				return nil, err
 			}
-			err = gClt.RegisterPlugin(plugCfg.PlugName, newPlug)
+			err = gClt.RegisterPlugin(plugCfg.PlugId, newPlug)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			plugCount++
 		}
@@ -76,30 +277,8 @@ func (c *Core) Run(ctx context.Context) error {
 		clientCount++
 	}
 	if len(clientList) == 0 {
-		return fmt.Errorf("device list is empty")
+		return nil, fmt.Errorf("device list is empty")
 	}
 	log.Infof("%d gNMI client(s) loaded - %d plugin(s) loaded...", clientCount, plugCount)
-
-	// Start the exporter
-	if err := pExp.Start(); err != nil {
-		return err
-	}
-
-	// Start devices
-	for _, dev := range clientList {
-		err := dev.Start()
-		if err != nil {
-			log.Error(err)
-		}
-	}
-
-	// Wait for exiting
-	<-ctx.Done()
-	// First stop the exporter
-	pExp.Close()
-	// Then unload all devices
-	for _, dev := range clientList {
-		dev.Close()
-	}
-	return nil
+	return clientList, nil
 }
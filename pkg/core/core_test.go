@@ -0,0 +1,145 @@
+package core
+
+import (
+	"fmt"
+	"github.com/automixer/gtexporter/pkg/exporter"
+	"github.com/automixer/gtexporter/pkg/gnmiclient"
+	"io"
+	"maps"
+	"slices"
+	"testing"
+)
+
+// fakeExporter is a metricExporter doing nothing.
+type fakeExporter struct{}
+
+func (e *fakeExporter) Start() error                      { return nil }
+func (e *fakeExporter) Close()                            {}
+func (e *fakeExporter) WriteText(io.Writer) error         { return nil }
+func (e *fakeExporter) CheckConfig(exporter.Config) error { return nil }
+func (e *fakeExporter) Reconfigure(exporter.Config) error { return nil }
+func (e *fakeExporter) ReloadDone(error)                  {}
+
+func TestLoadClientsOrder(t *testing.T) {
+	// Devices are recorded in the order their self-monitoring metrics are registered
+	var registered []string
+	exporter.GroupRegistry = func(_ string, _ exporter.GMetricSource, metrics []exporter.GMetric) error {
+		registered = append(registered, exporter.Commons(metrics[0]).Device)
+		return nil
+	}
+
+	c := &Core{clientCfg: make(map[string]gnmiclient.Config)}
+	var want []string
+	for i := 0; i < 20; i++ {
+		name := fmt.Sprintf("router%02d", (i*7)%20) // Not inserted in order
+		c.clientCfg[name] = gnmiclient.Config{DevName: name}
+		want = append(want, name)
+	}
+	slices.Sort(want)
+
+	for run := 0; run < 5; run++ {
+		registered = nil
+		clients, err := c.loadClients(&fakeExporter{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(clients) != len(want) {
+			t.Fatalf("run %d: %d clients loaded, want %d", run, len(clients), len(want))
+		}
+		if !slices.Equal(registered, want) {
+			t.Fatalf("run %d: devices loaded in order %v, want %v", run, registered, want)
+		}
+	}
+}
+
+func TestLoadClientsEmpty(t *testing.T) {
+	c := &Core{clientCfg: make(map[string]gnmiclient.Config)}
+	if _, err := c.loadClients(&fakeExporter{}); err == nil {
+		t.Error("empty device list accepted")
+	}
+}
+
+func TestCredentialChanges(t *testing.T) {
+	base := func() *Core {
+		return &Core{clientCfg: map[string]gnmiclient.Config{
+			"router1": {DevName: "router1", Addresses: []string{"10.0.0.1"}, User: "user", Password: "pwd"},
+			"router2": {DevName: "router2", Addresses: []string{"10.0.0.2"}, User: "user", Password: "pwd"},
+			"router3": {DevName: "router3", Addresses: []string{"10.0.0.3"}, TokenFile: "/tmp/token"},
+		}}
+	}
+	tests := []struct {
+		name   string
+		change func(c *Core)
+		want   []string
+		wantOk bool
+	}{
+		{
+			name:   "no change",
+			change: func(c *Core) {},
+			wantOk: true,
+		},
+		{
+			name: "password",
+			change: func(c *Core) {
+				cfg := c.clientCfg["router2"]
+				cfg.Password = "new_pwd"
+				c.clientCfg["router2"] = cfg
+			},
+			want:   []string{"router2"},
+			wantOk: true,
+		},
+		{
+			name: "user and password",
+			change: func(c *Core) {
+				for _, name := range []string{"router1", "router2"} {
+					cfg := c.clientCfg[name]
+					cfg.User, cfg.Password = "new_user", "new_pwd"
+					c.clientCfg[name] = cfg
+				}
+			},
+			want:   []string{"router1", "router2"},
+			wantOk: true,
+		},
+		{
+			name: "token file device",
+			change: func(c *Core) {
+				cfg := c.clientCfg["router3"]
+				cfg.User, cfg.Password = "new_user", "new_pwd"
+				c.clientCfg["router3"] = cfg
+			},
+			wantOk: true,
+		},
+		{
+			name: "password and address",
+			change: func(c *Core) {
+				cfg := c.clientCfg["router1"]
+				cfg.Password = "new_pwd"
+				cfg.Addresses = []string{"10.0.1.1"}
+				c.clientCfg["router1"] = cfg
+			},
+		},
+		{
+			name: "device added",
+			change: func(c *Core) {
+				c.clientCfg["router4"] = gnmiclient.Config{DevName: "router4", User: "user", Password: "pwd"}
+			},
+		},
+		{
+			name:   "log format",
+			change: func(c *Core) { c.logFormat = "json" },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newCore := base()
+			tt.change(newCore)
+			changed, ok := base().credentialChanges(newCore)
+			if ok != tt.wantOk {
+				t.Fatalf("got ok %v, want %v", ok, tt.wantOk)
+			}
+			if got := slices.Sorted(maps.Keys(changed)); !slices.Equal(got, tt.want) {
+				t.Errorf("got changed devices %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,1018 @@
+/*
+Package ysocacl is a generated package which contains definitions
+of structs which represent a YANG schema. The generated schema can be
+compressed by a series of transformations (compression was true
+in this case).
+
+This package was generated by /Users/luca/go/pkg/mod/github.com/openconfig/ygot@v0.29.20/genutil/names.go
+using the following YANG input files:
+  - openconfig-acl.yang
+
+Imported modules were sourced from:
+  - yang/...
+*/
+package ysocacl
+
+import (
+	"fmt"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// Binary is a type that is used for fields that have a YANG type of
+// binary. It is used such that binary fields can be distinguished from
+// leaf-lists of uint8s (which are mapped to []uint8, equivalent to
+// []byte in reflection).
+type Binary []byte
+
+// YANGEmpty is a type that is used for fields that have a YANG type of
+// empty. It is used such that empty fields can be distinguished from boolean fields
+// in the generated code.
+type YANGEmpty bool
+
+// Acl represents the /openconfig-acl/acl YANG schema element.
+type Acl struct {
+	Interface map[string]*Acl_Interface `path:"interfaces/interface" module:"openconfig-acl/openconfig-acl"`
+}
+
+// IsYANGGoStruct ensures that Acl implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Acl) IsYANGGoStruct() {}
+
+// NewInterface creates a new entry in the Interface list of the
+// Acl struct. The keys of the list are populated from the input
+// arguments.
+func (t *Acl) NewInterface(Id string) (*Acl_Interface, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Interface == nil {
+		t.Interface = make(map[string]*Acl_Interface)
+	}
+
+	key := Id
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Interface[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Interface", key)
+	}
+
+	t.Interface[key] = &Acl_Interface{
+		Id: &Id,
+	}
+
+	return t.Interface[key], nil
+}
+
+// GetOrCreateInterfaceMap returns the list (map) from Acl.
+//
+// It initializes the field if not already initialized.
+func (t *Acl) GetOrCreateInterfaceMap() map[string]*Acl_Interface {
+	if t.Interface == nil {
+		t.Interface = make(map[string]*Acl_Interface)
+	}
+	return t.Interface
+}
+
+// GetOrCreateInterface retrieves the value with the specified keys from
+// the receiver Acl. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Acl) GetOrCreateInterface(Id string) *Acl_Interface {
+
+	key := Id
+
+	if v, ok := t.Interface[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewInterface(Id)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateInterface got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetInterface retrieves the value with the specified key from
+// the Interface map field of Acl. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Acl) GetInterface(Id string) *Acl_Interface {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Id
+
+	if lm, ok := t.Interface[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteInterface deletes the value with the specified keys from
+// the receiver Acl. If there is no such element, the function
+// is a no-op.
+func (t *Acl) DeleteInterface(Id string) {
+	key := Id
+
+	delete(t.Interface, key)
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Acl
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Acl) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	for _, e := range t.Interface {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Acl.
+func (*Acl) ΛBelongingModule() string {
+	return "openconfig-acl"
+}
+
+// Acl_Interface represents the /openconfig-acl/acl/interfaces/interface YANG schema element.
+type Acl_Interface struct {
+	EgressAclSet  map[Acl_Interface_EgressAclSet_Key]*Acl_Interface_EgressAclSet   `path:"egress-acl-sets/egress-acl-set" module:"openconfig-acl/openconfig-acl"`
+	Id            *string                                                          `path:"state/id|id" module:"openconfig-acl/openconfig-acl|openconfig-acl" shadow-path:"config/id|id" shadow-module:"openconfig-acl/openconfig-acl|openconfig-acl"`
+	IngressAclSet map[Acl_Interface_IngressAclSet_Key]*Acl_Interface_IngressAclSet `path:"ingress-acl-sets/ingress-acl-set" module:"openconfig-acl/openconfig-acl"`
+}
+
+// IsYANGGoStruct ensures that Acl_Interface implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Acl_Interface) IsYANGGoStruct() {}
+
+// Acl_Interface_EgressAclSet_Key represents the key for list EgressAclSet of element /openconfig-acl/acl/interfaces/interface.
+type Acl_Interface_EgressAclSet_Key struct {
+	SetName string                   `path:"set-name"`
+	Type    E_OpenconfigAcl_ACL_TYPE `path:"type"`
+}
+
+// IsYANGGoKeyStruct ensures that Acl_Interface_EgressAclSet_Key partially implements the
+// yang.GoKeyStruct interface. This allows functions that need to
+// handle this key struct to identify it as being generated by gogen.
+func (Acl_Interface_EgressAclSet_Key) IsYANGGoKeyStruct() {}
+
+// ΛListKeyMap returns the values of the Acl_Interface_EgressAclSet_Key key struct.
+func (t Acl_Interface_EgressAclSet_Key) ΛListKeyMap() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"set-name": t.SetName,
+		"type":     t.Type,
+	}, nil
+}
+
+// Acl_Interface_IngressAclSet_Key represents the key for list IngressAclSet of element /openconfig-acl/acl/interfaces/interface.
+type Acl_Interface_IngressAclSet_Key struct {
+	SetName string                   `path:"set-name"`
+	Type    E_OpenconfigAcl_ACL_TYPE `path:"type"`
+}
+
+// IsYANGGoKeyStruct ensures that Acl_Interface_IngressAclSet_Key partially implements the
+// yang.GoKeyStruct interface. This allows functions that need to
+// handle this key struct to identify it as being generated by gogen.
+func (Acl_Interface_IngressAclSet_Key) IsYANGGoKeyStruct() {}
+
+// ΛListKeyMap returns the values of the Acl_Interface_IngressAclSet_Key key struct.
+func (t Acl_Interface_IngressAclSet_Key) ΛListKeyMap() (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"set-name": t.SetName,
+		"type":     t.Type,
+	}, nil
+}
+
+// NewEgressAclSet creates a new entry in the EgressAclSet list of the
+// Acl_Interface struct. The keys of the list are populated from the input
+// arguments.
+func (t *Acl_Interface) NewEgressAclSet(SetName string, Type E_OpenconfigAcl_ACL_TYPE) (*Acl_Interface_EgressAclSet, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.EgressAclSet == nil {
+		t.EgressAclSet = make(map[Acl_Interface_EgressAclSet_Key]*Acl_Interface_EgressAclSet)
+	}
+
+	key := Acl_Interface_EgressAclSet_Key{
+		SetName: SetName,
+		Type:    Type,
+	}
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.EgressAclSet[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list EgressAclSet", key)
+	}
+
+	t.EgressAclSet[key] = &Acl_Interface_EgressAclSet{
+		SetName: &SetName,
+		Type:    Type,
+	}
+
+	return t.EgressAclSet[key], nil
+}
+
+// GetOrCreateEgressAclSetMap returns the list (map) from Acl_Interface.
+//
+// It initializes the field if not already initialized.
+func (t *Acl_Interface) GetOrCreateEgressAclSetMap() map[Acl_Interface_EgressAclSet_Key]*Acl_Interface_EgressAclSet {
+	if t.EgressAclSet == nil {
+		t.EgressAclSet = make(map[Acl_Interface_EgressAclSet_Key]*Acl_Interface_EgressAclSet)
+	}
+	return t.EgressAclSet
+}
+
+// GetOrCreateEgressAclSet retrieves the value with the specified keys from
+// the receiver Acl_Interface. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Acl_Interface) GetOrCreateEgressAclSet(SetName string, Type E_OpenconfigAcl_ACL_TYPE) *Acl_Interface_EgressAclSet {
+
+	key := Acl_Interface_EgressAclSet_Key{
+		SetName: SetName,
+		Type:    Type,
+	}
+
+	if v, ok := t.EgressAclSet[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewEgressAclSet(SetName, Type)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateEgressAclSet got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetEgressAclSet retrieves the value with the specified key from
+// the EgressAclSet map field of Acl_Interface. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Acl_Interface) GetEgressAclSet(SetName string, Type E_OpenconfigAcl_ACL_TYPE) *Acl_Interface_EgressAclSet {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Acl_Interface_EgressAclSet_Key{
+		SetName: SetName,
+		Type:    Type,
+	}
+
+	if lm, ok := t.EgressAclSet[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteEgressAclSet deletes the value with the specified keys from
+// the receiver Acl_Interface. If there is no such element, the function
+// is a no-op.
+func (t *Acl_Interface) DeleteEgressAclSet(SetName string, Type E_OpenconfigAcl_ACL_TYPE) {
+	key := Acl_Interface_EgressAclSet_Key{
+		SetName: SetName,
+		Type:    Type,
+	}
+
+	delete(t.EgressAclSet, key)
+}
+
+// NewIngressAclSet creates a new entry in the IngressAclSet list of the
+// Acl_Interface struct. The keys of the list are populated from the input
+// arguments.
+func (t *Acl_Interface) NewIngressAclSet(SetName string, Type E_OpenconfigAcl_ACL_TYPE) (*Acl_Interface_IngressAclSet, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.IngressAclSet == nil {
+		t.IngressAclSet = make(map[Acl_Interface_IngressAclSet_Key]*Acl_Interface_IngressAclSet)
+	}
+
+	key := Acl_Interface_IngressAclSet_Key{
+		SetName: SetName,
+		Type:    Type,
+	}
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.IngressAclSet[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list IngressAclSet", key)
+	}
+
+	t.IngressAclSet[key] = &Acl_Interface_IngressAclSet{
+		SetName: &SetName,
+		Type:    Type,
+	}
+
+	return t.IngressAclSet[key], nil
+}
+
+// GetOrCreateIngressAclSetMap returns the list (map) from Acl_Interface.
+//
+// It initializes the field if not already initialized.
+func (t *Acl_Interface) GetOrCreateIngressAclSetMap() map[Acl_Interface_IngressAclSet_Key]*Acl_Interface_IngressAclSet {
+	if t.IngressAclSet == nil {
+		t.IngressAclSet = make(map[Acl_Interface_IngressAclSet_Key]*Acl_Interface_IngressAclSet)
+	}
+	return t.IngressAclSet
+}
+
+// GetOrCreateIngressAclSet retrieves the value with the specified keys from
+// the receiver Acl_Interface. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Acl_Interface) GetOrCreateIngressAclSet(SetName string, Type E_OpenconfigAcl_ACL_TYPE) *Acl_Interface_IngressAclSet {
+
+	key := Acl_Interface_IngressAclSet_Key{
+		SetName: SetName,
+		Type:    Type,
+	}
+
+	if v, ok := t.IngressAclSet[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewIngressAclSet(SetName, Type)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateIngressAclSet got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetIngressAclSet retrieves the value with the specified key from
+// the IngressAclSet map field of Acl_Interface. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Acl_Interface) GetIngressAclSet(SetName string, Type E_OpenconfigAcl_ACL_TYPE) *Acl_Interface_IngressAclSet {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Acl_Interface_IngressAclSet_Key{
+		SetName: SetName,
+		Type:    Type,
+	}
+
+	if lm, ok := t.IngressAclSet[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteIngressAclSet deletes the value with the specified keys from
+// the receiver Acl_Interface. If there is no such element, the function
+// is a no-op.
+func (t *Acl_Interface) DeleteIngressAclSet(SetName string, Type E_OpenconfigAcl_ACL_TYPE) {
+	key := Acl_Interface_IngressAclSet_Key{
+		SetName: SetName,
+		Type:    Type,
+	}
+
+	delete(t.IngressAclSet, key)
+}
+
+// GetId retrieves the value of the leaf Id from the Acl_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Id is set, it can
+// safely use t.GetId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Id == nil' before retrieving the leaf's value.
+func (t *Acl_Interface) GetId() string {
+	if t == nil || t.Id == nil {
+		return ""
+	}
+	return *t.Id
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Acl_Interface
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Acl_Interface) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	for _, e := range t.EgressAclSet {
+		e.PopulateDefaults()
+	}
+	for _, e := range t.IngressAclSet {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛListKeyMap returns the keys of the Acl_Interface struct, which is a YANG list entry.
+func (t *Acl_Interface) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Id == nil {
+		return nil, fmt.Errorf("nil value for key Id")
+	}
+
+	return map[string]interface{}{
+		"id": *t.Id,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Acl_Interface.
+func (*Acl_Interface) ΛBelongingModule() string {
+	return "openconfig-acl"
+}
+
+// Acl_Interface_EgressAclSet represents the /openconfig-acl/acl/interfaces/interface/egress-acl-sets/egress-acl-set YANG schema element.
+type Acl_Interface_EgressAclSet struct {
+	AclEntry map[uint32]*Acl_Interface_EgressAclSet_AclEntry `path:"acl-entries/acl-entry" module:"openconfig-acl/openconfig-acl"`
+	SetName  *string                                         `path:"state/set-name|set-name" module:"openconfig-acl/openconfig-acl|openconfig-acl" shadow-path:"config/set-name|set-name" shadow-module:"openconfig-acl/openconfig-acl|openconfig-acl"`
+	Type     E_OpenconfigAcl_ACL_TYPE                        `path:"state/type|type" module:"openconfig-acl/openconfig-acl|openconfig-acl" shadow-path:"config/type|type" shadow-module:"openconfig-acl/openconfig-acl|openconfig-acl"`
+}
+
+// IsYANGGoStruct ensures that Acl_Interface_EgressAclSet implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Acl_Interface_EgressAclSet) IsYANGGoStruct() {}
+
+// NewAclEntry creates a new entry in the AclEntry list of the
+// Acl_Interface_EgressAclSet struct. The keys of the list are populated from the input
+// arguments.
+func (t *Acl_Interface_EgressAclSet) NewAclEntry(SequenceId uint32) (*Acl_Interface_EgressAclSet_AclEntry, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.AclEntry == nil {
+		t.AclEntry = make(map[uint32]*Acl_Interface_EgressAclSet_AclEntry)
+	}
+
+	key := SequenceId
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.AclEntry[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list AclEntry", key)
+	}
+
+	t.AclEntry[key] = &Acl_Interface_EgressAclSet_AclEntry{
+		SequenceId: &SequenceId,
+	}
+
+	return t.AclEntry[key], nil
+}
+
+// GetOrCreateAclEntryMap returns the list (map) from Acl_Interface_EgressAclSet.
+//
+// It initializes the field if not already initialized.
+func (t *Acl_Interface_EgressAclSet) GetOrCreateAclEntryMap() map[uint32]*Acl_Interface_EgressAclSet_AclEntry {
+	if t.AclEntry == nil {
+		t.AclEntry = make(map[uint32]*Acl_Interface_EgressAclSet_AclEntry)
+	}
+	return t.AclEntry
+}
+
+// GetOrCreateAclEntry retrieves the value with the specified keys from
+// the receiver Acl_Interface_EgressAclSet. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Acl_Interface_EgressAclSet) GetOrCreateAclEntry(SequenceId uint32) *Acl_Interface_EgressAclSet_AclEntry {
+
+	key := SequenceId
+
+	if v, ok := t.AclEntry[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewAclEntry(SequenceId)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateAclEntry got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetAclEntry retrieves the value with the specified key from
+// the AclEntry map field of Acl_Interface_EgressAclSet. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Acl_Interface_EgressAclSet) GetAclEntry(SequenceId uint32) *Acl_Interface_EgressAclSet_AclEntry {
+
+	if t == nil {
+		return nil
+	}
+
+	key := SequenceId
+
+	if lm, ok := t.AclEntry[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteAclEntry deletes the value with the specified keys from
+// the receiver Acl_Interface_EgressAclSet. If there is no such element, the function
+// is a no-op.
+func (t *Acl_Interface_EgressAclSet) DeleteAclEntry(SequenceId uint32) {
+	key := SequenceId
+
+	delete(t.AclEntry, key)
+}
+
+// GetSetName retrieves the value of the leaf SetName from the Acl_Interface_EgressAclSet
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if SetName is set, it can
+// safely use t.GetSetName() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.SetName == nil' before retrieving the leaf's value.
+func (t *Acl_Interface_EgressAclSet) GetSetName() string {
+	if t == nil || t.SetName == nil {
+		return ""
+	}
+	return *t.SetName
+}
+
+// GetType retrieves the value of the leaf Type from the Acl_Interface_EgressAclSet
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Type is set, it can
+// safely use t.GetType() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Type == nil' before retrieving the leaf's value.
+func (t *Acl_Interface_EgressAclSet) GetType() E_OpenconfigAcl_ACL_TYPE {
+	if t == nil || t.Type == 0 {
+		return 0
+	}
+	return t.Type
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Acl_Interface_EgressAclSet
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Acl_Interface_EgressAclSet) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	for _, e := range t.AclEntry {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛListKeyMap returns the keys of the Acl_Interface_EgressAclSet struct, which is a YANG list entry.
+func (t *Acl_Interface_EgressAclSet) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.SetName == nil {
+		return nil, fmt.Errorf("nil value for key SetName")
+	}
+
+	return map[string]interface{}{
+		"set-name": *t.SetName,
+		"type":     t.Type,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Acl_Interface_EgressAclSet.
+func (*Acl_Interface_EgressAclSet) ΛBelongingModule() string {
+	return "openconfig-acl"
+}
+
+// Acl_Interface_EgressAclSet_AclEntry represents the /openconfig-acl/acl/interfaces/interface/egress-acl-sets/egress-acl-set/acl-entries/acl-entry YANG schema element.
+type Acl_Interface_EgressAclSet_AclEntry struct {
+	MatchedOctets  *uint64 `path:"state/matched-octets" module:"openconfig-acl/openconfig-acl"`
+	MatchedPackets *uint64 `path:"state/matched-packets" module:"openconfig-acl/openconfig-acl"`
+	SequenceId     *uint32 `path:"state/sequence-id|sequence-id" module:"openconfig-acl/openconfig-acl|openconfig-acl" shadow-path:"sequence-id" shadow-module:"openconfig-acl"`
+}
+
+// IsYANGGoStruct ensures that Acl_Interface_EgressAclSet_AclEntry implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Acl_Interface_EgressAclSet_AclEntry) IsYANGGoStruct() {}
+
+// GetMatchedOctets retrieves the value of the leaf MatchedOctets from the Acl_Interface_EgressAclSet_AclEntry
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if MatchedOctets is set, it can
+// safely use t.GetMatchedOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.MatchedOctets == nil' before retrieving the leaf's value.
+func (t *Acl_Interface_EgressAclSet_AclEntry) GetMatchedOctets() uint64 {
+	if t == nil || t.MatchedOctets == nil {
+		return 0
+	}
+	return *t.MatchedOctets
+}
+
+// GetMatchedPackets retrieves the value of the leaf MatchedPackets from the Acl_Interface_EgressAclSet_AclEntry
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if MatchedPackets is set, it can
+// safely use t.GetMatchedPackets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.MatchedPackets == nil' before retrieving the leaf's value.
+func (t *Acl_Interface_EgressAclSet_AclEntry) GetMatchedPackets() uint64 {
+	if t == nil || t.MatchedPackets == nil {
+		return 0
+	}
+	return *t.MatchedPackets
+}
+
+// GetSequenceId retrieves the value of the leaf SequenceId from the Acl_Interface_EgressAclSet_AclEntry
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if SequenceId is set, it can
+// safely use t.GetSequenceId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.SequenceId == nil' before retrieving the leaf's value.
+func (t *Acl_Interface_EgressAclSet_AclEntry) GetSequenceId() uint32 {
+	if t == nil || t.SequenceId == nil {
+		return 0
+	}
+	return *t.SequenceId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Acl_Interface_EgressAclSet_AclEntry
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Acl_Interface_EgressAclSet_AclEntry) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛListKeyMap returns the keys of the Acl_Interface_EgressAclSet_AclEntry struct, which is a YANG list entry.
+func (t *Acl_Interface_EgressAclSet_AclEntry) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.SequenceId == nil {
+		return nil, fmt.Errorf("nil value for key SequenceId")
+	}
+
+	return map[string]interface{}{
+		"sequence-id": *t.SequenceId,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Acl_Interface_EgressAclSet_AclEntry.
+func (*Acl_Interface_EgressAclSet_AclEntry) ΛBelongingModule() string {
+	return "openconfig-acl"
+}
+
+// Acl_Interface_IngressAclSet represents the /openconfig-acl/acl/interfaces/interface/ingress-acl-sets/ingress-acl-set YANG schema element.
+type Acl_Interface_IngressAclSet struct {
+	AclEntry map[uint32]*Acl_Interface_IngressAclSet_AclEntry `path:"acl-entries/acl-entry" module:"openconfig-acl/openconfig-acl"`
+	SetName  *string                                          `path:"state/set-name|set-name" module:"openconfig-acl/openconfig-acl|openconfig-acl" shadow-path:"config/set-name|set-name" shadow-module:"openconfig-acl/openconfig-acl|openconfig-acl"`
+	Type     E_OpenconfigAcl_ACL_TYPE                         `path:"state/type|type" module:"openconfig-acl/openconfig-acl|openconfig-acl" shadow-path:"config/type|type" shadow-module:"openconfig-acl/openconfig-acl|openconfig-acl"`
+}
+
+// IsYANGGoStruct ensures that Acl_Interface_IngressAclSet implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Acl_Interface_IngressAclSet) IsYANGGoStruct() {}
+
+// NewAclEntry creates a new entry in the AclEntry list of the
+// Acl_Interface_IngressAclSet struct. The keys of the list are populated from the input
+// arguments.
+func (t *Acl_Interface_IngressAclSet) NewAclEntry(SequenceId uint32) (*Acl_Interface_IngressAclSet_AclEntry, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.AclEntry == nil {
+		t.AclEntry = make(map[uint32]*Acl_Interface_IngressAclSet_AclEntry)
+	}
+
+	key := SequenceId
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.AclEntry[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list AclEntry", key)
+	}
+
+	t.AclEntry[key] = &Acl_Interface_IngressAclSet_AclEntry{
+		SequenceId: &SequenceId,
+	}
+
+	return t.AclEntry[key], nil
+}
+
+// GetOrCreateAclEntryMap returns the list (map) from Acl_Interface_IngressAclSet.
+//
+// It initializes the field if not already initialized.
+func (t *Acl_Interface_IngressAclSet) GetOrCreateAclEntryMap() map[uint32]*Acl_Interface_IngressAclSet_AclEntry {
+	if t.AclEntry == nil {
+		t.AclEntry = make(map[uint32]*Acl_Interface_IngressAclSet_AclEntry)
+	}
+	return t.AclEntry
+}
+
+// GetOrCreateAclEntry retrieves the value with the specified keys from
+// the receiver Acl_Interface_IngressAclSet. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Acl_Interface_IngressAclSet) GetOrCreateAclEntry(SequenceId uint32) *Acl_Interface_IngressAclSet_AclEntry {
+
+	key := SequenceId
+
+	if v, ok := t.AclEntry[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewAclEntry(SequenceId)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateAclEntry got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetAclEntry retrieves the value with the specified key from
+// the AclEntry map field of Acl_Interface_IngressAclSet. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Acl_Interface_IngressAclSet) GetAclEntry(SequenceId uint32) *Acl_Interface_IngressAclSet_AclEntry {
+
+	if t == nil {
+		return nil
+	}
+
+	key := SequenceId
+
+	if lm, ok := t.AclEntry[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteAclEntry deletes the value with the specified keys from
+// the receiver Acl_Interface_IngressAclSet. If there is no such element, the function
+// is a no-op.
+func (t *Acl_Interface_IngressAclSet) DeleteAclEntry(SequenceId uint32) {
+	key := SequenceId
+
+	delete(t.AclEntry, key)
+}
+
+// GetSetName retrieves the value of the leaf SetName from the Acl_Interface_IngressAclSet
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if SetName is set, it can
+// safely use t.GetSetName() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.SetName == nil' before retrieving the leaf's value.
+func (t *Acl_Interface_IngressAclSet) GetSetName() string {
+	if t == nil || t.SetName == nil {
+		return ""
+	}
+	return *t.SetName
+}
+
+// GetType retrieves the value of the leaf Type from the Acl_Interface_IngressAclSet
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Type is set, it can
+// safely use t.GetType() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Type == nil' before retrieving the leaf's value.
+func (t *Acl_Interface_IngressAclSet) GetType() E_OpenconfigAcl_ACL_TYPE {
+	if t == nil || t.Type == 0 {
+		return 0
+	}
+	return t.Type
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Acl_Interface_IngressAclSet
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Acl_Interface_IngressAclSet) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	for _, e := range t.AclEntry {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛListKeyMap returns the keys of the Acl_Interface_IngressAclSet struct, which is a YANG list entry.
+func (t *Acl_Interface_IngressAclSet) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.SetName == nil {
+		return nil, fmt.Errorf("nil value for key SetName")
+	}
+
+	return map[string]interface{}{
+		"set-name": *t.SetName,
+		"type":     t.Type,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Acl_Interface_IngressAclSet.
+func (*Acl_Interface_IngressAclSet) ΛBelongingModule() string {
+	return "openconfig-acl"
+}
+
+// Acl_Interface_IngressAclSet_AclEntry represents the /openconfig-acl/acl/interfaces/interface/ingress-acl-sets/ingress-acl-set/acl-entries/acl-entry YANG schema element.
+type Acl_Interface_IngressAclSet_AclEntry struct {
+	MatchedOctets  *uint64 `path:"state/matched-octets" module:"openconfig-acl/openconfig-acl"`
+	MatchedPackets *uint64 `path:"state/matched-packets" module:"openconfig-acl/openconfig-acl"`
+	SequenceId     *uint32 `path:"state/sequence-id|sequence-id" module:"openconfig-acl/openconfig-acl|openconfig-acl" shadow-path:"sequence-id" shadow-module:"openconfig-acl"`
+}
+
+// IsYANGGoStruct ensures that Acl_Interface_IngressAclSet_AclEntry implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Acl_Interface_IngressAclSet_AclEntry) IsYANGGoStruct() {}
+
+// GetMatchedOctets retrieves the value of the leaf MatchedOctets from the Acl_Interface_IngressAclSet_AclEntry
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if MatchedOctets is set, it can
+// safely use t.GetMatchedOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.MatchedOctets == nil' before retrieving the leaf's value.
+func (t *Acl_Interface_IngressAclSet_AclEntry) GetMatchedOctets() uint64 {
+	if t == nil || t.MatchedOctets == nil {
+		return 0
+	}
+	return *t.MatchedOctets
+}
+
+// GetMatchedPackets retrieves the value of the leaf MatchedPackets from the Acl_Interface_IngressAclSet_AclEntry
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if MatchedPackets is set, it can
+// safely use t.GetMatchedPackets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.MatchedPackets == nil' before retrieving the leaf's value.
+func (t *Acl_Interface_IngressAclSet_AclEntry) GetMatchedPackets() uint64 {
+	if t == nil || t.MatchedPackets == nil {
+		return 0
+	}
+	return *t.MatchedPackets
+}
+
+// GetSequenceId retrieves the value of the leaf SequenceId from the Acl_Interface_IngressAclSet_AclEntry
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if SequenceId is set, it can
+// safely use t.GetSequenceId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.SequenceId == nil' before retrieving the leaf's value.
+func (t *Acl_Interface_IngressAclSet_AclEntry) GetSequenceId() uint32 {
+	if t == nil || t.SequenceId == nil {
+		return 0
+	}
+	return *t.SequenceId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Acl_Interface_IngressAclSet_AclEntry
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Acl_Interface_IngressAclSet_AclEntry) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛListKeyMap returns the keys of the Acl_Interface_IngressAclSet_AclEntry struct, which is a YANG list entry.
+func (t *Acl_Interface_IngressAclSet_AclEntry) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.SequenceId == nil {
+		return nil, fmt.Errorf("nil value for key SequenceId")
+	}
+
+	return map[string]interface{}{
+		"sequence-id": *t.SequenceId,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Acl_Interface_IngressAclSet_AclEntry.
+func (*Acl_Interface_IngressAclSet_AclEntry) ΛBelongingModule() string {
+	return "openconfig-acl"
+}
+
+// Root represents the /root YANG schema element.
+type Root struct {
+	Acl *Acl `path:"acl" module:"openconfig-acl"`
+}
+
+// IsYANGGoStruct ensures that Root implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Root) IsYANGGoStruct() {}
+
+// GetOrCreateAcl retrieves the value of the Acl field
+// or returns the existing field if it already exists.
+func (t *Root) GetOrCreateAcl() *Acl {
+	if t.Acl != nil {
+		return t.Acl
+	}
+	t.Acl = &Acl{}
+	return t.Acl
+}
+
+// GetAcl returns the value of the Acl struct pointer
+// from Root. If the receiver or the field Acl is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Root) GetAcl() *Acl {
+	if t != nil && t.Acl != nil {
+		return t.Acl
+	}
+	return nil
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Root
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Root) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	t.Acl.PopulateDefaults()
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Root.
+func (*Root) ΛBelongingModule() string {
+	return ""
+}
+
+// E_OpenconfigAcl_ACL_TYPE is a derived int64 type which is used to represent
+// the enumerated node OpenconfigAcl_ACL_TYPE. An additional value named
+// OpenconfigAcl_ACL_TYPE_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigAcl_ACL_TYPE int64
+
+// IsYANGGoEnum ensures that OpenconfigAcl_ACL_TYPE implements the yang.GoEnum
+// interface. This ensures that OpenconfigAcl_ACL_TYPE can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigAcl_ACL_TYPE) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigAcl_ACL_TYPE.
+func (E_OpenconfigAcl_ACL_TYPE) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_OpenconfigAcl_ACL_TYPE.
+func (e E_OpenconfigAcl_ACL_TYPE) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OpenconfigAcl_ACL_TYPE")
+}
+
+const (
+	// OpenconfigAcl_ACL_TYPE_UNSET corresponds to the value UNSET of OpenconfigAcl_ACL_TYPE
+	OpenconfigAcl_ACL_TYPE_UNSET E_OpenconfigAcl_ACL_TYPE = 0
+	// OpenconfigAcl_ACL_TYPE_ACL_IPV4 corresponds to the value ACL_IPV4 of OpenconfigAcl_ACL_TYPE
+	OpenconfigAcl_ACL_TYPE_ACL_IPV4 E_OpenconfigAcl_ACL_TYPE = 1
+	// OpenconfigAcl_ACL_TYPE_ACL_IPV6 corresponds to the value ACL_IPV6 of OpenconfigAcl_ACL_TYPE
+	OpenconfigAcl_ACL_TYPE_ACL_IPV6 E_OpenconfigAcl_ACL_TYPE = 2
+	// OpenconfigAcl_ACL_TYPE_ACL_L2 corresponds to the value ACL_L2 of OpenconfigAcl_ACL_TYPE
+	OpenconfigAcl_ACL_TYPE_ACL_L2 E_OpenconfigAcl_ACL_TYPE = 3
+	// OpenconfigAcl_ACL_TYPE_ACL_MIXED corresponds to the value ACL_MIXED of OpenconfigAcl_ACL_TYPE
+	OpenconfigAcl_ACL_TYPE_ACL_MIXED E_OpenconfigAcl_ACL_TYPE = 4
+)
+
+// ΛEnum is a map, keyed by the name of the type defined for each enum in the
+// generated Go code, which provides a mapping between the constant int64 value
+// of each value of the enumeration, and the string that is used to represent it
+// in the YANG schema. The map is named ΛEnum in order to avoid clash with any
+// valid YANG identifier.
+var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
+	"E_OpenconfigAcl_ACL_TYPE": {
+		1: {Name: "ACL_IPV4", DefiningModule: "openconfig-acl"},
+		2: {Name: "ACL_IPV6", DefiningModule: "openconfig-acl"},
+		3: {Name: "ACL_L2", DefiningModule: "openconfig-acl"},
+		4: {Name: "ACL_MIXED", DefiningModule: "openconfig-acl"},
+	},
+}
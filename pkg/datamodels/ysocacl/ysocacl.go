@@ -0,0 +1,65 @@
+package ysocacl
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// Generate OpenConfig acl GoStruct code
+//go:generate generator -output_file=gen.go -compress_paths=true -path=yang -package_name=ysocacl -fakeroot_name=root -prefer_operational_state=true -ignore_shadow_schema_paths=true -shorten_enum_leaf_names=true -generate_fakeroot=true -include_schema=false -generate_getters=true -generate_leaf_getters=true -generate_delete=true -generate_populate_defaults=true openconfig-acl.yang
+
+// EnumMapper is a struct that maps enum names and their values.
+type EnumMapper struct {
+	eMap map[string]map[string]int64 // Outer key: Enum name - Inner key: enum element name - Value: enum element value
+}
+
+func NewEnumMapper() *EnumMapper {
+	em := &EnumMapper{make(map[string]map[string]int64)}
+
+	for enumType, enum := range ΛEnum {
+		em.eMap[enumType] = make(map[string]int64)
+		for enumValue, enumName := range enum {
+			em.eMap[enumType][enumName.Name] = enumValue
+		}
+	}
+	return em
+}
+
+// GetEnumFromString retrieves the enum value corresponding to the given string representation.
+// If the string representation does not match any enum value, it returns 0.
+func (m EnumMapper) GetEnumFromString(s string, e ygot.GoEnum) int64 {
+	// Sometimes enum names are prefixed with yang source
+	_, after, found := strings.Cut(s, ":")
+	if found {
+		s = after
+	}
+
+	// Get the enum name
+	rType := reflect.TypeOf(e).Name()
+	if _, ok := m.eMap[rType]; !ok {
+		// 0 means unset (ygot)
+		return 0
+	}
+	if _, ok := m.eMap[rType][s]; !ok {
+		return 0
+	}
+	return m.eMap[rType][s]
+}
+
+// GoStructToOcAcl converts a GoStruct interface to a pointer of a Root struct.
+func GoStructToOcAcl(ys ygot.GoStruct) *Root {
+	if root, ok := ys.(*Root); ok {
+		return root
+	}
+	panic("not an ygot acl GoStruct")
+}
+
+// ShortString returns a short string representation of the E_OpenconfigAcl_ACL_TYPE enum value.
+func (e E_OpenconfigAcl_ACL_TYPE) ShortString() string {
+	if e == OpenconfigAcl_ACL_TYPE_UNSET {
+		return ""
+	}
+	return ygot.EnumLogString(e, int64(e), "E_OpenconfigAcl_ACL_TYPE")
+}
@@ -9,6 +9,7 @@ using the following YANG input files:
   - openconfig-interfaces.yang
   - openconfig-if-aggregate.yang
   - openconfig-if-ethernet
+  - openconfig-platform-transceiver.yang
 
 Imported modules were sourced from:
   - yang/...
@@ -34,24 +35,25 @@ type YANGEmpty bool
 
 // Interface represents the /openconfig-interfaces/interfaces/interface YANG schema element.
 type Interface struct {
-	AdminStatus  E_Interface_AdminStatus                 `path:"state/admin-status" module:"openconfig-interfaces/openconfig-interfaces"`
-	Aggregation  *Interface_Aggregation                  `path:"aggregation" module:"openconfig-if-aggregate"`
-	Counters     *Interface_Counters                     `path:"state/counters" module:"openconfig-interfaces/openconfig-interfaces"`
-	Cpu          *bool                                   `path:"state/cpu" module:"openconfig-interfaces/openconfig-interfaces"`
-	Description  *string                                 `path:"state/description" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/description" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
-	Enabled      *bool                                   `path:"state/enabled" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/enabled" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
-	Ethernet     *Interface_Ethernet                     `path:"ethernet" module:"openconfig-if-ethernet"`
-	HoldTime     *Interface_HoldTime                     `path:"hold-time" module:"openconfig-interfaces"`
-	Ifindex      *uint32                                 `path:"state/ifindex" module:"openconfig-interfaces/openconfig-interfaces"`
-	LastChange   *uint64                                 `path:"state/last-change" module:"openconfig-interfaces/openconfig-interfaces"`
-	Logical      *bool                                   `path:"state/logical" module:"openconfig-interfaces/openconfig-interfaces"`
-	LoopbackMode E_OpenconfigInterfaces_LoopbackModeType `path:"state/loopback-mode" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/loopback-mode" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
-	Management   *bool                                   `path:"state/management" module:"openconfig-interfaces/openconfig-interfaces"`
-	Mtu          *uint16                                 `path:"state/mtu" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/mtu" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
-	Name         *string                                 `path:"state/name|name" module:"openconfig-interfaces/openconfig-interfaces|openconfig-interfaces" shadow-path:"config/name|name" shadow-module:"openconfig-interfaces/openconfig-interfaces|openconfig-interfaces"`
-	OperStatus   E_Interface_OperStatus                  `path:"state/oper-status" module:"openconfig-interfaces/openconfig-interfaces"`
-	Subinterface map[uint32]*Interface_Subinterface      `path:"subinterfaces/subinterface" module:"openconfig-interfaces/openconfig-interfaces"`
-	Type         E_IETFInterfaces_InterfaceType          `path:"state/type" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/type" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
+	AdminStatus     E_Interface_AdminStatus                 `path:"state/admin-status" module:"openconfig-interfaces/openconfig-interfaces"`
+	Aggregation     *Interface_Aggregation                  `path:"aggregation" module:"openconfig-if-aggregate"`
+	Counters        *Interface_Counters                     `path:"state/counters" module:"openconfig-interfaces/openconfig-interfaces"`
+	Cpu             *bool                                   `path:"state/cpu" module:"openconfig-interfaces/openconfig-interfaces"`
+	Description     *string                                 `path:"state/description" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/description" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
+	Enabled         *bool                                   `path:"state/enabled" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/enabled" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
+	Ethernet        *Interface_Ethernet                     `path:"ethernet" module:"openconfig-if-ethernet"`
+	HoldTime        *Interface_HoldTime                     `path:"hold-time" module:"openconfig-interfaces"`
+	Ifindex         *uint32                                 `path:"state/ifindex" module:"openconfig-interfaces/openconfig-interfaces"`
+	LastChange      *uint64                                 `path:"state/last-change" module:"openconfig-interfaces/openconfig-interfaces"`
+	Logical         *bool                                   `path:"state/logical" module:"openconfig-interfaces/openconfig-interfaces"`
+	LoopbackMode    E_OpenconfigInterfaces_LoopbackModeType `path:"state/loopback-mode" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/loopback-mode" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
+	Management      *bool                                   `path:"state/management" module:"openconfig-interfaces/openconfig-interfaces"`
+	Mtu             *uint16                                 `path:"state/mtu" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/mtu" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
+	Name            *string                                 `path:"state/name|name" module:"openconfig-interfaces/openconfig-interfaces|openconfig-interfaces" shadow-path:"config/name|name" shadow-module:"openconfig-interfaces/openconfig-interfaces|openconfig-interfaces"`
+	OperStatus      E_Interface_OperStatus                  `path:"state/oper-status" module:"openconfig-interfaces/openconfig-interfaces"`
+	PhysicalChannel []uint16                                `path:"state/physical-channel" module:"openconfig-interfaces/openconfig-platform-transceiver"`
+	Subinterface    map[uint32]*Interface_Subinterface      `path:"subinterfaces/subinterface" module:"openconfig-interfaces/openconfig-interfaces"`
+	Type            E_IETFInterfaces_InterfaceType          `path:"state/type" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/type" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
 }
 
 // IsYANGGoStruct ensures that Interface implements the yang.GoStruct
@@ -414,6 +416,22 @@ func (t *Interface) GetOperStatus() E_Interface_OperStatus {
 	return t.OperStatus
 }
 
+// GetPhysicalChannel retrieves the value of the leaf PhysicalChannel from the Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PhysicalChannel is set, it can
+// safely use t.GetPhysicalChannel() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PhysicalChannel == nil' before retrieving the leaf's value.
+func (t *Interface) GetPhysicalChannel() []uint16 {
+	if t == nil || t.PhysicalChannel == nil {
+		return nil
+	}
+	return t.PhysicalChannel
+}
+
 // GetType retrieves the value of the leaf Type from the Interface
 // struct. If the field is unset but has a default value in the YANG schema,
 // then the default value will be returned.
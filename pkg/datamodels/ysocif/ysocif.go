@@ -2,13 +2,14 @@ package ysocif
 
 import (
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/openconfig/ygot/ygot"
 )
 
 // Generate OpenConfig Interfaces GoStruct code
-//go:generate generator -output_file=gen.go -compress_paths=true -path=yang -exclude_modules=ietf-interfaces -package_name=ysocif -fakeroot_name=root -prefer_operational_state=true -ignore_shadow_schema_paths=true -shorten_enum_leaf_names=true -generate_fakeroot=true -include_schema=false -generate_getters=true -generate_leaf_getters=true -generate_delete=true -generate_populate_defaults=true openconfig-interfaces.yang openconfig-if-aggregate.yang openconfig-if-ethernet
+//go:generate generator -output_file=gen.go -compress_paths=true -path=yang -exclude_modules=ietf-interfaces -package_name=ysocif -fakeroot_name=root -prefer_operational_state=true -ignore_shadow_schema_paths=true -shorten_enum_leaf_names=true -generate_fakeroot=true -include_schema=false -generate_getters=true -generate_leaf_getters=true -generate_delete=true -generate_populate_defaults=true openconfig-interfaces.yang openconfig-if-aggregate.yang openconfig-if-ethernet openconfig-platform-transceiver.yang
 
 // EnumMapper is a struct that maps enum names and their values.
 type EnumMapper struct {
@@ -48,10 +49,36 @@ func (m EnumMapper) GetEnumFromString(s string, e ygot.GoEnum) int64 {
 	return m.eMap[rType][s]
 }
 
+// RawEnumKey identifies an enum leaf of an interface or subinterface.
+type RawEnumKey struct {
+	IfName  string
+	Index   uint32
+	IsSubIf bool
+	Leaf    string
+}
+
+// RawEnumRoot is a Root that also carries the raw strings of the received enum leaves whose value is not
+// known by the generated code. Parsers can check it out in place of Root to let formatters expose them.
+type RawEnumRoot struct {
+	*Root
+	RawEnums map[RawEnumKey]string
+}
+
+// GoStructToRawEnums returns the raw enum strings carried by a RawEnumRoot, or nil for a plain Root.
+func GoStructToRawEnums(ys ygot.GoStruct) map[RawEnumKey]string {
+	if root, ok := ys.(*RawEnumRoot); ok {
+		return root.RawEnums
+	}
+	return nil
+}
+
 // GoStructToOcIf converts a GoStruct interface to a pointer of a Root struct.
 func GoStructToOcIf(ys ygot.GoStruct) *Root {
-	if root, ok := ys.(*Root); ok {
+	switch root := ys.(type) {
+	case *Root:
 		return root
+	case *RawEnumRoot:
+		return root.Root
 	}
 	panic("not an ygot interfaces GoStruct")
 }
@@ -62,6 +89,7 @@ const (
 	Normal CntMode = iota
 	UseGoDefault
 	ForceToZero
+	ZeroFill
 )
 
 // GetCountersFromStruct extract a map of counters from a yang container of counters
@@ -79,7 +107,11 @@ func GetCountersFromStruct(s any, mode CntMode) map[string]float64 {
 			// last clear is not a counter
 			continue
 		}
-		valPtr := sValue.Field(i).Interface().(*uint64)
+		valPtr, ok := sValue.Field(i).Interface().(*uint64)
+		if !ok {
+			// Not a counter (e.g.: key leaves)
+			continue
+		}
 		switch mode {
 		case UseGoDefault:
 			if valPtr != nil {
@@ -87,6 +119,13 @@ func GetCountersFromStruct(s any, mode CntMode) map[string]float64 {
 			} else {
 				out[fieldName] = 0.0
 			}
+		case ZeroFill:
+			if valPtr != nil {
+				out[fieldName] = float64(*valPtr)
+			} else if strings.HasPrefix(fieldName, "in-") || strings.HasPrefix(fieldName, "out-") {
+				// Fill missing counters only
+				out[fieldName] = 0.0
+			}
 		case ForceToZero:
 			if strings.HasPrefix(fieldName, "in-") || strings.HasPrefix(fieldName, "out-") {
 				// Wipe counters only
@@ -137,3 +176,27 @@ func (e E_IETFInterfaces_InterfaceType) ShortString() string {
 	}
 	return ygot.EnumLogString(e, int64(e), "E_IETFInterfaces_InterfaceType")
 }
+
+// Bps returns the E_OpenconfigIfEthernet_ETHERNET_SPEED enum value in bits per second.
+// It returns 0 if the speed is unset or unknown.
+func (e E_OpenconfigIfEthernet_ETHERNET_SPEED) Bps() float64 {
+	if e == OpenconfigIfEthernet_ETHERNET_SPEED_UNSET || e == OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_UNKNOWN {
+		return 0
+	}
+	// Enum names are in the form SPEED_<n>MB or SPEED_<n>GB
+	name := strings.TrimPrefix(ygot.EnumLogString(e, int64(e), "E_OpenconfigIfEthernet_ETHERNET_SPEED"), "SPEED_")
+	var unit float64
+	switch {
+	case strings.HasSuffix(name, "MB"):
+		unit = 1e6
+	case strings.HasSuffix(name, "GB"):
+		unit = 1e9
+	default:
+		return 0
+	}
+	value, err := strconv.ParseFloat(name[:len(name)-2], 64)
+	if err != nil {
+		return 0
+	}
+	return value * unit
+}
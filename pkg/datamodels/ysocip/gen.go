@@ -0,0 +1,11857 @@
+/*
+Package ysocip is a generated package which contains definitions
+of structs which represent a YANG schema. The generated schema can be
+compressed by a series of transformations (compression was true
+in this case).
+
+This package was generated by /Users/luca/go/pkg/mod/github.com/openconfig/ygot@v0.29.20/genutil/names.go
+using the following YANG input files:
+  - openconfig-interfaces.yang
+  - openconfig-if-ip.yang
+
+Imported modules were sourced from:
+  - yang/...
+*/
+package ysocip
+
+import (
+	"fmt"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// Binary is a type that is used for fields that have a YANG type of
+// binary. It is used such that binary fields can be distinguished from
+// leaf-lists of uint8s (which are mapped to []uint8, equivalent to
+// []byte in reflection).
+type Binary []byte
+
+// YANGEmpty is a type that is used for fields that have a YANG type of
+// empty. It is used such that empty fields can be distinguished from boolean fields
+// in the generated code.
+type YANGEmpty bool
+
+// Interface represents the /openconfig-interfaces/interfaces/interface YANG schema element.
+type Interface struct {
+	AdminStatus  E_Interface_AdminStatus                 `path:"state/admin-status" module:"openconfig-interfaces/openconfig-interfaces"`
+	Aggregation  *Interface_Aggregation                  `path:"aggregation" module:"openconfig-if-aggregate"`
+	Counters     *Interface_Counters                     `path:"state/counters" module:"openconfig-interfaces/openconfig-interfaces"`
+	Cpu          *bool                                   `path:"state/cpu" module:"openconfig-interfaces/openconfig-interfaces"`
+	Description  *string                                 `path:"state/description" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/description" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
+	Enabled      *bool                                   `path:"state/enabled" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/enabled" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
+	Ethernet     *Interface_Ethernet                     `path:"ethernet" module:"openconfig-if-ethernet"`
+	HoldTime     *Interface_HoldTime                     `path:"hold-time" module:"openconfig-interfaces"`
+	Ifindex      *uint32                                 `path:"state/ifindex" module:"openconfig-interfaces/openconfig-interfaces"`
+	LastChange   *uint64                                 `path:"state/last-change" module:"openconfig-interfaces/openconfig-interfaces"`
+	Logical      *bool                                   `path:"state/logical" module:"openconfig-interfaces/openconfig-interfaces"`
+	LoopbackMode E_OpenconfigInterfaces_LoopbackModeType `path:"state/loopback-mode" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/loopback-mode" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
+	Management   *bool                                   `path:"state/management" module:"openconfig-interfaces/openconfig-interfaces"`
+	Mtu          *uint16                                 `path:"state/mtu" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/mtu" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
+	Name         *string                                 `path:"state/name|name" module:"openconfig-interfaces/openconfig-interfaces|openconfig-interfaces" shadow-path:"config/name|name" shadow-module:"openconfig-interfaces/openconfig-interfaces|openconfig-interfaces"`
+	OperStatus   E_Interface_OperStatus                  `path:"state/oper-status" module:"openconfig-interfaces/openconfig-interfaces"`
+	RoutedVlan   *Interface_RoutedVlan                   `path:"routed-vlan" module:"openconfig-vlan"`
+	Subinterface map[uint32]*Interface_Subinterface      `path:"subinterfaces/subinterface" module:"openconfig-interfaces/openconfig-interfaces"`
+	Tpid         E_OpenconfigVlanTypes_TPID_TYPES        `path:"state/tpid" module:"openconfig-interfaces/openconfig-vlan" shadow-path:"config/tpid" shadow-module:"openconfig-interfaces/openconfig-vlan"`
+	Type         E_IETFInterfaces_InterfaceType          `path:"state/type" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/type" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
+}
+
+// IsYANGGoStruct ensures that Interface implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface) IsYANGGoStruct() {}
+
+// NewSubinterface creates a new entry in the Subinterface list of the
+// Interface struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface) NewSubinterface(Index uint32) (*Interface_Subinterface, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Subinterface == nil {
+		t.Subinterface = make(map[uint32]*Interface_Subinterface)
+	}
+
+	key := Index
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Subinterface[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Subinterface", key)
+	}
+
+	t.Subinterface[key] = &Interface_Subinterface{
+		Index: &Index,
+	}
+
+	return t.Subinterface[key], nil
+}
+
+// GetOrCreateSubinterfaceMap returns the list (map) from Interface.
+//
+// It initializes the field if not already initialized.
+func (t *Interface) GetOrCreateSubinterfaceMap() map[uint32]*Interface_Subinterface {
+	if t.Subinterface == nil {
+		t.Subinterface = make(map[uint32]*Interface_Subinterface)
+	}
+	return t.Subinterface
+}
+
+// GetOrCreateSubinterface retrieves the value with the specified keys from
+// the receiver Interface. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Interface) GetOrCreateSubinterface(Index uint32) *Interface_Subinterface {
+
+	key := Index
+
+	if v, ok := t.Subinterface[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewSubinterface(Index)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateSubinterface got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetSubinterface retrieves the value with the specified key from
+// the Subinterface map field of Interface. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Interface) GetSubinterface(Index uint32) *Interface_Subinterface {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Index
+
+	if lm, ok := t.Subinterface[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteSubinterface deletes the value with the specified keys from
+// the receiver Interface. If there is no such element, the function
+// is a no-op.
+func (t *Interface) DeleteSubinterface(Index uint32) {
+	key := Index
+
+	delete(t.Subinterface, key)
+}
+
+// GetOrCreateAggregation retrieves the value of the Aggregation field
+// or returns the existing field if it already exists.
+func (t *Interface) GetOrCreateAggregation() *Interface_Aggregation {
+	if t.Aggregation != nil {
+		return t.Aggregation
+	}
+	t.Aggregation = &Interface_Aggregation{}
+	return t.Aggregation
+}
+
+// GetOrCreateCounters retrieves the value of the Counters field
+// or returns the existing field if it already exists.
+func (t *Interface) GetOrCreateCounters() *Interface_Counters {
+	if t.Counters != nil {
+		return t.Counters
+	}
+	t.Counters = &Interface_Counters{}
+	return t.Counters
+}
+
+// GetOrCreateEthernet retrieves the value of the Ethernet field
+// or returns the existing field if it already exists.
+func (t *Interface) GetOrCreateEthernet() *Interface_Ethernet {
+	if t.Ethernet != nil {
+		return t.Ethernet
+	}
+	t.Ethernet = &Interface_Ethernet{}
+	return t.Ethernet
+}
+
+// GetOrCreateHoldTime retrieves the value of the HoldTime field
+// or returns the existing field if it already exists.
+func (t *Interface) GetOrCreateHoldTime() *Interface_HoldTime {
+	if t.HoldTime != nil {
+		return t.HoldTime
+	}
+	t.HoldTime = &Interface_HoldTime{}
+	return t.HoldTime
+}
+
+// GetOrCreateRoutedVlan retrieves the value of the RoutedVlan field
+// or returns the existing field if it already exists.
+func (t *Interface) GetOrCreateRoutedVlan() *Interface_RoutedVlan {
+	if t.RoutedVlan != nil {
+		return t.RoutedVlan
+	}
+	t.RoutedVlan = &Interface_RoutedVlan{}
+	return t.RoutedVlan
+}
+
+// GetAggregation returns the value of the Aggregation struct pointer
+// from Interface. If the receiver or the field Aggregation is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface) GetAggregation() *Interface_Aggregation {
+	if t != nil && t.Aggregation != nil {
+		return t.Aggregation
+	}
+	return nil
+}
+
+// GetCounters returns the value of the Counters struct pointer
+// from Interface. If the receiver or the field Counters is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface) GetCounters() *Interface_Counters {
+	if t != nil && t.Counters != nil {
+		return t.Counters
+	}
+	return nil
+}
+
+// GetEthernet returns the value of the Ethernet struct pointer
+// from Interface. If the receiver or the field Ethernet is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface) GetEthernet() *Interface_Ethernet {
+	if t != nil && t.Ethernet != nil {
+		return t.Ethernet
+	}
+	return nil
+}
+
+// GetHoldTime returns the value of the HoldTime struct pointer
+// from Interface. If the receiver or the field HoldTime is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface) GetHoldTime() *Interface_HoldTime {
+	if t != nil && t.HoldTime != nil {
+		return t.HoldTime
+	}
+	return nil
+}
+
+// GetRoutedVlan returns the value of the RoutedVlan struct pointer
+// from Interface. If the receiver or the field RoutedVlan is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface) GetRoutedVlan() *Interface_RoutedVlan {
+	if t != nil && t.RoutedVlan != nil {
+		return t.RoutedVlan
+	}
+	return nil
+}
+
+// GetAdminStatus retrieves the value of the leaf AdminStatus from the Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AdminStatus is set, it can
+// safely use t.GetAdminStatus() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AdminStatus == nil' before retrieving the leaf's value.
+func (t *Interface) GetAdminStatus() E_Interface_AdminStatus {
+	if t == nil || t.AdminStatus == 0 {
+		return 0
+	}
+	return t.AdminStatus
+}
+
+// GetCpu retrieves the value of the leaf Cpu from the Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Cpu is set, it can
+// safely use t.GetCpu() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Cpu == nil' before retrieving the leaf's value.
+func (t *Interface) GetCpu() bool {
+	if t == nil || t.Cpu == nil {
+		return false
+	}
+	return *t.Cpu
+}
+
+// GetDescription retrieves the value of the leaf Description from the Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Description is set, it can
+// safely use t.GetDescription() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Description == nil' before retrieving the leaf's value.
+func (t *Interface) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetEnabled retrieves the value of the leaf Enabled from the Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Enabled is set, it can
+// safely use t.GetEnabled() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Enabled == nil' before retrieving the leaf's value.
+func (t *Interface) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return true
+	}
+	return *t.Enabled
+}
+
+// GetIfindex retrieves the value of the leaf Ifindex from the Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Ifindex is set, it can
+// safely use t.GetIfindex() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Ifindex == nil' before retrieving the leaf's value.
+func (t *Interface) GetIfindex() uint32 {
+	if t == nil || t.Ifindex == nil {
+		return 0
+	}
+	return *t.Ifindex
+}
+
+// GetLastChange retrieves the value of the leaf LastChange from the Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if LastChange is set, it can
+// safely use t.GetLastChange() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.LastChange == nil' before retrieving the leaf's value.
+func (t *Interface) GetLastChange() uint64 {
+	if t == nil || t.LastChange == nil {
+		return 0
+	}
+	return *t.LastChange
+}
+
+// GetLogical retrieves the value of the leaf Logical from the Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Logical is set, it can
+// safely use t.GetLogical() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Logical == nil' before retrieving the leaf's value.
+func (t *Interface) GetLogical() bool {
+	if t == nil || t.Logical == nil {
+		return false
+	}
+	return *t.Logical
+}
+
+// GetLoopbackMode retrieves the value of the leaf LoopbackMode from the Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if LoopbackMode is set, it can
+// safely use t.GetLoopbackMode() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.LoopbackMode == nil' before retrieving the leaf's value.
+func (t *Interface) GetLoopbackMode() E_OpenconfigInterfaces_LoopbackModeType {
+	if t == nil || t.LoopbackMode == 0 {
+		return OpenconfigInterfaces_LoopbackModeType_NONE
+	}
+	return t.LoopbackMode
+}
+
+// GetManagement retrieves the value of the leaf Management from the Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Management is set, it can
+// safely use t.GetManagement() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Management == nil' before retrieving the leaf's value.
+func (t *Interface) GetManagement() bool {
+	if t == nil || t.Management == nil {
+		return false
+	}
+	return *t.Management
+}
+
+// GetMtu retrieves the value of the leaf Mtu from the Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Mtu is set, it can
+// safely use t.GetMtu() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Mtu == nil' before retrieving the leaf's value.
+func (t *Interface) GetMtu() uint16 {
+	if t == nil || t.Mtu == nil {
+		return 0
+	}
+	return *t.Mtu
+}
+
+// GetName retrieves the value of the leaf Name from the Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Name is set, it can
+// safely use t.GetName() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Name == nil' before retrieving the leaf's value.
+func (t *Interface) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+	return *t.Name
+}
+
+// GetOperStatus retrieves the value of the leaf OperStatus from the Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OperStatus is set, it can
+// safely use t.GetOperStatus() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OperStatus == nil' before retrieving the leaf's value.
+func (t *Interface) GetOperStatus() E_Interface_OperStatus {
+	if t == nil || t.OperStatus == 0 {
+		return 0
+	}
+	return t.OperStatus
+}
+
+// GetTpid retrieves the value of the leaf Tpid from the Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Tpid is set, it can
+// safely use t.GetTpid() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Tpid == nil' before retrieving the leaf's value.
+func (t *Interface) GetTpid() E_OpenconfigVlanTypes_TPID_TYPES {
+	if t == nil || t.Tpid == 0 {
+		return OpenconfigVlanTypes_TPID_TYPES_TPID_0X8100
+	}
+	return t.Tpid
+}
+
+// GetType retrieves the value of the leaf Type from the Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Type is set, it can
+// safely use t.GetType() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Type == nil' before retrieving the leaf's value.
+func (t *Interface) GetType() E_IETFInterfaces_InterfaceType {
+	if t == nil || t.Type == 0 {
+		return 0
+	}
+	return t.Type
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Enabled == nil {
+		var v bool = true
+		t.Enabled = &v
+	}
+	if t.LoopbackMode == 0 {
+		t.LoopbackMode = OpenconfigInterfaces_LoopbackModeType_NONE
+	}
+	if t.Tpid == 0 {
+		t.Tpid = OpenconfigVlanTypes_TPID_TYPES_TPID_0X8100
+	}
+	t.Aggregation.PopulateDefaults()
+	t.Counters.PopulateDefaults()
+	t.Ethernet.PopulateDefaults()
+	t.HoldTime.PopulateDefaults()
+	t.RoutedVlan.PopulateDefaults()
+	for _, e := range t.Subinterface {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛListKeyMap returns the keys of the Interface struct, which is a YANG list entry.
+func (t *Interface) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface.
+func (*Interface) ΛBelongingModule() string {
+	return "openconfig-interfaces"
+}
+
+// Interface_Aggregation represents the /openconfig-interfaces/interfaces/interface/aggregation YANG schema element.
+type Interface_Aggregation struct {
+	LagSpeed     *uint32                                 `path:"state/lag-speed" module:"openconfig-if-aggregate/openconfig-if-aggregate"`
+	LagType      E_OpenconfigIfAggregate_AggregationType `path:"state/lag-type" module:"openconfig-if-aggregate/openconfig-if-aggregate" shadow-path:"config/lag-type" shadow-module:"openconfig-if-aggregate/openconfig-if-aggregate"`
+	Member       []string                                `path:"state/member" module:"openconfig-if-aggregate/openconfig-if-aggregate"`
+	MinLinks     *uint16                                 `path:"state/min-links" module:"openconfig-if-aggregate/openconfig-if-aggregate" shadow-path:"config/min-links" shadow-module:"openconfig-if-aggregate/openconfig-if-aggregate"`
+	SwitchedVlan *Interface_Aggregation_SwitchedVlan     `path:"switched-vlan" module:"openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Aggregation implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Aggregation) IsYANGGoStruct() {}
+
+// GetOrCreateSwitchedVlan retrieves the value of the SwitchedVlan field
+// or returns the existing field if it already exists.
+func (t *Interface_Aggregation) GetOrCreateSwitchedVlan() *Interface_Aggregation_SwitchedVlan {
+	if t.SwitchedVlan != nil {
+		return t.SwitchedVlan
+	}
+	t.SwitchedVlan = &Interface_Aggregation_SwitchedVlan{}
+	return t.SwitchedVlan
+}
+
+// GetSwitchedVlan returns the value of the SwitchedVlan struct pointer
+// from Interface_Aggregation. If the receiver or the field SwitchedVlan is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Aggregation) GetSwitchedVlan() *Interface_Aggregation_SwitchedVlan {
+	if t != nil && t.SwitchedVlan != nil {
+		return t.SwitchedVlan
+	}
+	return nil
+}
+
+// GetLagSpeed retrieves the value of the leaf LagSpeed from the Interface_Aggregation
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if LagSpeed is set, it can
+// safely use t.GetLagSpeed() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.LagSpeed == nil' before retrieving the leaf's value.
+func (t *Interface_Aggregation) GetLagSpeed() uint32 {
+	if t == nil || t.LagSpeed == nil {
+		return 0
+	}
+	return *t.LagSpeed
+}
+
+// GetLagType retrieves the value of the leaf LagType from the Interface_Aggregation
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if LagType is set, it can
+// safely use t.GetLagType() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.LagType == nil' before retrieving the leaf's value.
+func (t *Interface_Aggregation) GetLagType() E_OpenconfigIfAggregate_AggregationType {
+	if t == nil || t.LagType == 0 {
+		return 0
+	}
+	return t.LagType
+}
+
+// GetMember retrieves the value of the leaf Member from the Interface_Aggregation
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Member is set, it can
+// safely use t.GetMember() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Member == nil' before retrieving the leaf's value.
+func (t *Interface_Aggregation) GetMember() []string {
+	if t == nil || t.Member == nil {
+		return nil
+	}
+	return t.Member
+}
+
+// GetMinLinks retrieves the value of the leaf MinLinks from the Interface_Aggregation
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if MinLinks is set, it can
+// safely use t.GetMinLinks() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.MinLinks == nil' before retrieving the leaf's value.
+func (t *Interface_Aggregation) GetMinLinks() uint16 {
+	if t == nil || t.MinLinks == nil {
+		return 0
+	}
+	return *t.MinLinks
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Aggregation
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Aggregation) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	t.SwitchedVlan.PopulateDefaults()
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Aggregation.
+func (*Interface_Aggregation) ΛBelongingModule() string {
+	return "openconfig-if-aggregate"
+}
+
+// Interface_Aggregation_SwitchedVlan represents the /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan YANG schema element.
+type Interface_Aggregation_SwitchedVlan struct {
+	AccessVlan    *uint16                                               `path:"state/access-vlan" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/access-vlan" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	InterfaceMode E_OpenconfigVlan_VlanModeType                         `path:"state/interface-mode" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/interface-mode" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	NativeVlan    *uint16                                               `path:"state/native-vlan" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/native-vlan" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	TrunkVlans    []Interface_Aggregation_SwitchedVlan_TrunkVlans_Union `path:"state/trunk-vlans" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/trunk-vlans" shadow-module:"openconfig-vlan/openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Aggregation_SwitchedVlan implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Aggregation_SwitchedVlan) IsYANGGoStruct() {}
+
+// GetAccessVlan retrieves the value of the leaf AccessVlan from the Interface_Aggregation_SwitchedVlan
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AccessVlan is set, it can
+// safely use t.GetAccessVlan() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AccessVlan == nil' before retrieving the leaf's value.
+func (t *Interface_Aggregation_SwitchedVlan) GetAccessVlan() uint16 {
+	if t == nil || t.AccessVlan == nil {
+		return 0
+	}
+	return *t.AccessVlan
+}
+
+// GetInterfaceMode retrieves the value of the leaf InterfaceMode from the Interface_Aggregation_SwitchedVlan
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InterfaceMode is set, it can
+// safely use t.GetInterfaceMode() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InterfaceMode == nil' before retrieving the leaf's value.
+func (t *Interface_Aggregation_SwitchedVlan) GetInterfaceMode() E_OpenconfigVlan_VlanModeType {
+	if t == nil || t.InterfaceMode == 0 {
+		return 0
+	}
+	return t.InterfaceMode
+}
+
+// GetNativeVlan retrieves the value of the leaf NativeVlan from the Interface_Aggregation_SwitchedVlan
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if NativeVlan is set, it can
+// safely use t.GetNativeVlan() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.NativeVlan == nil' before retrieving the leaf's value.
+func (t *Interface_Aggregation_SwitchedVlan) GetNativeVlan() uint16 {
+	if t == nil || t.NativeVlan == nil {
+		return 0
+	}
+	return *t.NativeVlan
+}
+
+// GetTrunkVlans retrieves the value of the leaf TrunkVlans from the Interface_Aggregation_SwitchedVlan
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if TrunkVlans is set, it can
+// safely use t.GetTrunkVlans() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.TrunkVlans == nil' before retrieving the leaf's value.
+func (t *Interface_Aggregation_SwitchedVlan) GetTrunkVlans() []Interface_Aggregation_SwitchedVlan_TrunkVlans_Union {
+	if t == nil || t.TrunkVlans == nil {
+		return nil
+	}
+	return t.TrunkVlans
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Aggregation_SwitchedVlan
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Aggregation_SwitchedVlan) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Aggregation_SwitchedVlan.
+func (*Interface_Aggregation_SwitchedVlan) ΛBelongingModule() string {
+	return "openconfig-vlan"
+}
+
+// Interface_Aggregation_SwitchedVlan_TrunkVlans_Union is an interface that is implemented by valid types for the union
+// for the leaf /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan/state/trunk-vlans within the YANG schema.
+type Interface_Aggregation_SwitchedVlan_TrunkVlans_Union interface {
+	Is_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union()
+}
+
+// Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_String is used when /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan/state/trunk-vlans
+// is to be set to a string value.
+type Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_String struct {
+	String string
+}
+
+// Is_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union ensures that Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_String
+// implements the Interface_Aggregation_SwitchedVlan_TrunkVlans_Union interface.
+func (*Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_String) Is_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union() {
+}
+
+// Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_Uint16 is used when /openconfig-interfaces/interfaces/interface/aggregation/switched-vlan/state/trunk-vlans
+// is to be set to a uint16 value.
+type Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_Uint16 struct {
+	Uint16 uint16
+}
+
+// Is_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union ensures that Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_Uint16
+// implements the Interface_Aggregation_SwitchedVlan_TrunkVlans_Union interface.
+func (*Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_Uint16) Is_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union() {
+}
+
+// To_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the Interface_Aggregation_SwitchedVlan_TrunkVlans_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *Interface_Aggregation_SwitchedVlan) To_Interface_Aggregation_SwitchedVlan_TrunkVlans_Union(i interface{}) (Interface_Aggregation_SwitchedVlan_TrunkVlans_Union, error) {
+	switch v := i.(type) {
+	case string:
+		return &Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_String{v}, nil
+	case uint16:
+		return &Interface_Aggregation_SwitchedVlan_TrunkVlans_Union_Uint16{v}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %v to Interface_Aggregation_SwitchedVlan_TrunkVlans_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
+	}
+}
+
+// Interface_Counters represents the /openconfig-interfaces/interfaces/interface/state/counters YANG schema element.
+type Interface_Counters struct {
+	CarrierTransitions *uint64 `path:"carrier-transitions" module:"openconfig-interfaces"`
+	InBroadcastPkts    *uint64 `path:"in-broadcast-pkts" module:"openconfig-interfaces"`
+	InDiscards         *uint64 `path:"in-discards" module:"openconfig-interfaces"`
+	InErrors           *uint64 `path:"in-errors" module:"openconfig-interfaces"`
+	InFcsErrors        *uint64 `path:"in-fcs-errors" module:"openconfig-interfaces"`
+	InMulticastPkts    *uint64 `path:"in-multicast-pkts" module:"openconfig-interfaces"`
+	InOctets           *uint64 `path:"in-octets" module:"openconfig-interfaces"`
+	InPkts             *uint64 `path:"in-pkts" module:"openconfig-interfaces"`
+	InUnicastPkts      *uint64 `path:"in-unicast-pkts" module:"openconfig-interfaces"`
+	InUnknownProtos    *uint64 `path:"in-unknown-protos" module:"openconfig-interfaces"`
+	LastClear          *uint64 `path:"last-clear" module:"openconfig-interfaces"`
+	OutBroadcastPkts   *uint64 `path:"out-broadcast-pkts" module:"openconfig-interfaces"`
+	OutDiscards        *uint64 `path:"out-discards" module:"openconfig-interfaces"`
+	OutErrors          *uint64 `path:"out-errors" module:"openconfig-interfaces"`
+	OutMulticastPkts   *uint64 `path:"out-multicast-pkts" module:"openconfig-interfaces"`
+	OutOctets          *uint64 `path:"out-octets" module:"openconfig-interfaces"`
+	OutPkts            *uint64 `path:"out-pkts" module:"openconfig-interfaces"`
+	OutUnicastPkts     *uint64 `path:"out-unicast-pkts" module:"openconfig-interfaces"`
+	Resets             *uint64 `path:"resets" module:"openconfig-interfaces"`
+}
+
+// IsYANGGoStruct ensures that Interface_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Counters) IsYANGGoStruct() {}
+
+// GetCarrierTransitions retrieves the value of the leaf CarrierTransitions from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if CarrierTransitions is set, it can
+// safely use t.GetCarrierTransitions() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.CarrierTransitions == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetCarrierTransitions() uint64 {
+	if t == nil || t.CarrierTransitions == nil {
+		return 0
+	}
+	return *t.CarrierTransitions
+}
+
+// GetInBroadcastPkts retrieves the value of the leaf InBroadcastPkts from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InBroadcastPkts is set, it can
+// safely use t.GetInBroadcastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InBroadcastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetInBroadcastPkts() uint64 {
+	if t == nil || t.InBroadcastPkts == nil {
+		return 0
+	}
+	return *t.InBroadcastPkts
+}
+
+// GetInDiscards retrieves the value of the leaf InDiscards from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InDiscards is set, it can
+// safely use t.GetInDiscards() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InDiscards == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetInDiscards() uint64 {
+	if t == nil || t.InDiscards == nil {
+		return 0
+	}
+	return *t.InDiscards
+}
+
+// GetInErrors retrieves the value of the leaf InErrors from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InErrors is set, it can
+// safely use t.GetInErrors() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InErrors == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetInErrors() uint64 {
+	if t == nil || t.InErrors == nil {
+		return 0
+	}
+	return *t.InErrors
+}
+
+// GetInFcsErrors retrieves the value of the leaf InFcsErrors from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InFcsErrors is set, it can
+// safely use t.GetInFcsErrors() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InFcsErrors == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetInFcsErrors() uint64 {
+	if t == nil || t.InFcsErrors == nil {
+		return 0
+	}
+	return *t.InFcsErrors
+}
+
+// GetInMulticastPkts retrieves the value of the leaf InMulticastPkts from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InMulticastPkts is set, it can
+// safely use t.GetInMulticastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InMulticastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetInMulticastPkts() uint64 {
+	if t == nil || t.InMulticastPkts == nil {
+		return 0
+	}
+	return *t.InMulticastPkts
+}
+
+// GetInOctets retrieves the value of the leaf InOctets from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InOctets is set, it can
+// safely use t.GetInOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InOctets == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetInOctets() uint64 {
+	if t == nil || t.InOctets == nil {
+		return 0
+	}
+	return *t.InOctets
+}
+
+// GetInPkts retrieves the value of the leaf InPkts from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InPkts is set, it can
+// safely use t.GetInPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetInPkts() uint64 {
+	if t == nil || t.InPkts == nil {
+		return 0
+	}
+	return *t.InPkts
+}
+
+// GetInUnicastPkts retrieves the value of the leaf InUnicastPkts from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InUnicastPkts is set, it can
+// safely use t.GetInUnicastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InUnicastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetInUnicastPkts() uint64 {
+	if t == nil || t.InUnicastPkts == nil {
+		return 0
+	}
+	return *t.InUnicastPkts
+}
+
+// GetInUnknownProtos retrieves the value of the leaf InUnknownProtos from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InUnknownProtos is set, it can
+// safely use t.GetInUnknownProtos() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InUnknownProtos == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetInUnknownProtos() uint64 {
+	if t == nil || t.InUnknownProtos == nil {
+		return 0
+	}
+	return *t.InUnknownProtos
+}
+
+// GetLastClear retrieves the value of the leaf LastClear from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if LastClear is set, it can
+// safely use t.GetLastClear() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.LastClear == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetLastClear() uint64 {
+	if t == nil || t.LastClear == nil {
+		return 0
+	}
+	return *t.LastClear
+}
+
+// GetOutBroadcastPkts retrieves the value of the leaf OutBroadcastPkts from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutBroadcastPkts is set, it can
+// safely use t.GetOutBroadcastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutBroadcastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetOutBroadcastPkts() uint64 {
+	if t == nil || t.OutBroadcastPkts == nil {
+		return 0
+	}
+	return *t.OutBroadcastPkts
+}
+
+// GetOutDiscards retrieves the value of the leaf OutDiscards from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutDiscards is set, it can
+// safely use t.GetOutDiscards() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutDiscards == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetOutDiscards() uint64 {
+	if t == nil || t.OutDiscards == nil {
+		return 0
+	}
+	return *t.OutDiscards
+}
+
+// GetOutErrors retrieves the value of the leaf OutErrors from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutErrors is set, it can
+// safely use t.GetOutErrors() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutErrors == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetOutErrors() uint64 {
+	if t == nil || t.OutErrors == nil {
+		return 0
+	}
+	return *t.OutErrors
+}
+
+// GetOutMulticastPkts retrieves the value of the leaf OutMulticastPkts from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutMulticastPkts is set, it can
+// safely use t.GetOutMulticastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutMulticastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetOutMulticastPkts() uint64 {
+	if t == nil || t.OutMulticastPkts == nil {
+		return 0
+	}
+	return *t.OutMulticastPkts
+}
+
+// GetOutOctets retrieves the value of the leaf OutOctets from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutOctets is set, it can
+// safely use t.GetOutOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutOctets == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetOutOctets() uint64 {
+	if t == nil || t.OutOctets == nil {
+		return 0
+	}
+	return *t.OutOctets
+}
+
+// GetOutPkts retrieves the value of the leaf OutPkts from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutPkts is set, it can
+// safely use t.GetOutPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetOutPkts() uint64 {
+	if t == nil || t.OutPkts == nil {
+		return 0
+	}
+	return *t.OutPkts
+}
+
+// GetOutUnicastPkts retrieves the value of the leaf OutUnicastPkts from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutUnicastPkts is set, it can
+// safely use t.GetOutUnicastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutUnicastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetOutUnicastPkts() uint64 {
+	if t == nil || t.OutUnicastPkts == nil {
+		return 0
+	}
+	return *t.OutUnicastPkts
+}
+
+// GetResets retrieves the value of the leaf Resets from the Interface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Resets is set, it can
+// safely use t.GetResets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Resets == nil' before retrieving the leaf's value.
+func (t *Interface_Counters) GetResets() uint64 {
+	if t == nil || t.Resets == nil {
+		return 0
+	}
+	return *t.Resets
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Counters
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Counters) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Counters.
+func (*Interface_Counters) ΛBelongingModule() string {
+	return "openconfig-interfaces"
+}
+
+// Interface_Ethernet represents the /openconfig-interfaces/interfaces/interface/ethernet YANG schema element.
+type Interface_Ethernet struct {
+	AggregateId            *string                               `path:"state/aggregate-id" module:"openconfig-if-ethernet/openconfig-if-aggregate" shadow-path:"config/aggregate-id" shadow-module:"openconfig-if-ethernet/openconfig-if-aggregate"`
+	AutoNegotiate          *bool                                 `path:"state/auto-negotiate" module:"openconfig-if-ethernet/openconfig-if-ethernet" shadow-path:"config/auto-negotiate" shadow-module:"openconfig-if-ethernet/openconfig-if-ethernet"`
+	Counters               *Interface_Ethernet_Counters          `path:"state/counters" module:"openconfig-if-ethernet/openconfig-if-ethernet"`
+	DuplexMode             E_Ethernet_DuplexMode                 `path:"state/duplex-mode" module:"openconfig-if-ethernet/openconfig-if-ethernet" shadow-path:"config/duplex-mode" shadow-module:"openconfig-if-ethernet/openconfig-if-ethernet"`
+	EnableFlowControl      *bool                                 `path:"state/enable-flow-control" module:"openconfig-if-ethernet/openconfig-if-ethernet" shadow-path:"config/enable-flow-control" shadow-module:"openconfig-if-ethernet/openconfig-if-ethernet"`
+	FecMode                E_OpenconfigIfEthernet_INTERFACE_FEC  `path:"state/fec-mode" module:"openconfig-if-ethernet/openconfig-if-ethernet" shadow-path:"config/fec-mode" shadow-module:"openconfig-if-ethernet/openconfig-if-ethernet"`
+	HwMacAddress           *string                               `path:"state/hw-mac-address" module:"openconfig-if-ethernet/openconfig-if-ethernet"`
+	MacAddress             *string                               `path:"state/mac-address" module:"openconfig-if-ethernet/openconfig-if-ethernet" shadow-path:"config/mac-address" shadow-module:"openconfig-if-ethernet/openconfig-if-ethernet"`
+	NegotiatedDuplexMode   E_Ethernet_NegotiatedDuplexMode       `path:"state/negotiated-duplex-mode" module:"openconfig-if-ethernet/openconfig-if-ethernet"`
+	NegotiatedPortSpeed    E_OpenconfigIfEthernet_ETHERNET_SPEED `path:"state/negotiated-port-speed" module:"openconfig-if-ethernet/openconfig-if-ethernet"`
+	PortSpeed              E_OpenconfigIfEthernet_ETHERNET_SPEED `path:"state/port-speed" module:"openconfig-if-ethernet/openconfig-if-ethernet" shadow-path:"config/port-speed" shadow-module:"openconfig-if-ethernet/openconfig-if-ethernet"`
+	StandaloneLinkTraining *bool                                 `path:"state/standalone-link-training" module:"openconfig-if-ethernet/openconfig-if-ethernet" shadow-path:"config/standalone-link-training" shadow-module:"openconfig-if-ethernet/openconfig-if-ethernet"`
+	SwitchedVlan           *Interface_Ethernet_SwitchedVlan      `path:"switched-vlan" module:"openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Ethernet implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Ethernet) IsYANGGoStruct() {}
+
+// GetOrCreateCounters retrieves the value of the Counters field
+// or returns the existing field if it already exists.
+func (t *Interface_Ethernet) GetOrCreateCounters() *Interface_Ethernet_Counters {
+	if t.Counters != nil {
+		return t.Counters
+	}
+	t.Counters = &Interface_Ethernet_Counters{}
+	return t.Counters
+}
+
+// GetOrCreateSwitchedVlan retrieves the value of the SwitchedVlan field
+// or returns the existing field if it already exists.
+func (t *Interface_Ethernet) GetOrCreateSwitchedVlan() *Interface_Ethernet_SwitchedVlan {
+	if t.SwitchedVlan != nil {
+		return t.SwitchedVlan
+	}
+	t.SwitchedVlan = &Interface_Ethernet_SwitchedVlan{}
+	return t.SwitchedVlan
+}
+
+// GetCounters returns the value of the Counters struct pointer
+// from Interface_Ethernet. If the receiver or the field Counters is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Ethernet) GetCounters() *Interface_Ethernet_Counters {
+	if t != nil && t.Counters != nil {
+		return t.Counters
+	}
+	return nil
+}
+
+// GetSwitchedVlan returns the value of the SwitchedVlan struct pointer
+// from Interface_Ethernet. If the receiver or the field SwitchedVlan is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Ethernet) GetSwitchedVlan() *Interface_Ethernet_SwitchedVlan {
+	if t != nil && t.SwitchedVlan != nil {
+		return t.SwitchedVlan
+	}
+	return nil
+}
+
+// GetAggregateId retrieves the value of the leaf AggregateId from the Interface_Ethernet
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AggregateId is set, it can
+// safely use t.GetAggregateId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AggregateId == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet) GetAggregateId() string {
+	if t == nil || t.AggregateId == nil {
+		return ""
+	}
+	return *t.AggregateId
+}
+
+// GetAutoNegotiate retrieves the value of the leaf AutoNegotiate from the Interface_Ethernet
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AutoNegotiate is set, it can
+// safely use t.GetAutoNegotiate() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AutoNegotiate == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet) GetAutoNegotiate() bool {
+	if t == nil || t.AutoNegotiate == nil {
+		return true
+	}
+	return *t.AutoNegotiate
+}
+
+// GetDuplexMode retrieves the value of the leaf DuplexMode from the Interface_Ethernet
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DuplexMode is set, it can
+// safely use t.GetDuplexMode() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DuplexMode == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet) GetDuplexMode() E_Ethernet_DuplexMode {
+	if t == nil || t.DuplexMode == 0 {
+		return 0
+	}
+	return t.DuplexMode
+}
+
+// GetEnableFlowControl retrieves the value of the leaf EnableFlowControl from the Interface_Ethernet
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if EnableFlowControl is set, it can
+// safely use t.GetEnableFlowControl() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.EnableFlowControl == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet) GetEnableFlowControl() bool {
+	if t == nil || t.EnableFlowControl == nil {
+		return false
+	}
+	return *t.EnableFlowControl
+}
+
+// GetFecMode retrieves the value of the leaf FecMode from the Interface_Ethernet
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if FecMode is set, it can
+// safely use t.GetFecMode() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.FecMode == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet) GetFecMode() E_OpenconfigIfEthernet_INTERFACE_FEC {
+	if t == nil || t.FecMode == 0 {
+		return 0
+	}
+	return t.FecMode
+}
+
+// GetHwMacAddress retrieves the value of the leaf HwMacAddress from the Interface_Ethernet
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if HwMacAddress is set, it can
+// safely use t.GetHwMacAddress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.HwMacAddress == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet) GetHwMacAddress() string {
+	if t == nil || t.HwMacAddress == nil {
+		return ""
+	}
+	return *t.HwMacAddress
+}
+
+// GetMacAddress retrieves the value of the leaf MacAddress from the Interface_Ethernet
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if MacAddress is set, it can
+// safely use t.GetMacAddress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.MacAddress == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet) GetMacAddress() string {
+	if t == nil || t.MacAddress == nil {
+		return ""
+	}
+	return *t.MacAddress
+}
+
+// GetNegotiatedDuplexMode retrieves the value of the leaf NegotiatedDuplexMode from the Interface_Ethernet
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if NegotiatedDuplexMode is set, it can
+// safely use t.GetNegotiatedDuplexMode() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.NegotiatedDuplexMode == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet) GetNegotiatedDuplexMode() E_Ethernet_NegotiatedDuplexMode {
+	if t == nil || t.NegotiatedDuplexMode == 0 {
+		return 0
+	}
+	return t.NegotiatedDuplexMode
+}
+
+// GetNegotiatedPortSpeed retrieves the value of the leaf NegotiatedPortSpeed from the Interface_Ethernet
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if NegotiatedPortSpeed is set, it can
+// safely use t.GetNegotiatedPortSpeed() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.NegotiatedPortSpeed == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet) GetNegotiatedPortSpeed() E_OpenconfigIfEthernet_ETHERNET_SPEED {
+	if t == nil || t.NegotiatedPortSpeed == 0 {
+		return 0
+	}
+	return t.NegotiatedPortSpeed
+}
+
+// GetPortSpeed retrieves the value of the leaf PortSpeed from the Interface_Ethernet
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PortSpeed is set, it can
+// safely use t.GetPortSpeed() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PortSpeed == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet) GetPortSpeed() E_OpenconfigIfEthernet_ETHERNET_SPEED {
+	if t == nil || t.PortSpeed == 0 {
+		return 0
+	}
+	return t.PortSpeed
+}
+
+// GetStandaloneLinkTraining retrieves the value of the leaf StandaloneLinkTraining from the Interface_Ethernet
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if StandaloneLinkTraining is set, it can
+// safely use t.GetStandaloneLinkTraining() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.StandaloneLinkTraining == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet) GetStandaloneLinkTraining() bool {
+	if t == nil || t.StandaloneLinkTraining == nil {
+		return false
+	}
+	return *t.StandaloneLinkTraining
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Ethernet
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Ethernet) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.AutoNegotiate == nil {
+		var v bool = true
+		t.AutoNegotiate = &v
+	}
+	if t.EnableFlowControl == nil {
+		var v bool = false
+		t.EnableFlowControl = &v
+	}
+	if t.StandaloneLinkTraining == nil {
+		var v bool = false
+		t.StandaloneLinkTraining = &v
+	}
+	t.Counters.PopulateDefaults()
+	t.SwitchedVlan.PopulateDefaults()
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Ethernet.
+func (*Interface_Ethernet) ΛBelongingModule() string {
+	return "openconfig-if-ethernet"
+}
+
+// Interface_Ethernet_Counters represents the /openconfig-interfaces/interfaces/interface/ethernet/state/counters YANG schema element.
+type Interface_Ethernet_Counters struct {
+	In_8021QFrames      *uint64 `path:"in-8021q-frames" module:"openconfig-if-ethernet"`
+	InBlockErrors       *uint64 `path:"in-block-errors" module:"openconfig-if-ethernet"`
+	InCarrierErrors     *uint64 `path:"in-carrier-errors" module:"openconfig-if-ethernet"`
+	InCrcErrors         *uint64 `path:"in-crc-errors" module:"openconfig-if-ethernet"`
+	InFragmentFrames    *uint64 `path:"in-fragment-frames" module:"openconfig-if-ethernet"`
+	InInterruptedTx     *uint64 `path:"in-interrupted-tx" module:"openconfig-if-ethernet"`
+	InJabberFrames      *uint64 `path:"in-jabber-frames" module:"openconfig-if-ethernet"`
+	InLateCollision     *uint64 `path:"in-late-collision" module:"openconfig-if-ethernet"`
+	InMacControlFrames  *uint64 `path:"in-mac-control-frames" module:"openconfig-if-ethernet"`
+	InMacErrorsRx       *uint64 `path:"in-mac-errors-rx" module:"openconfig-if-ethernet"`
+	InMacPauseFrames    *uint64 `path:"in-mac-pause-frames" module:"openconfig-if-ethernet"`
+	InMaxsizeExceeded   *uint64 `path:"in-maxsize-exceeded" module:"openconfig-if-ethernet"`
+	InOversizeFrames    *uint64 `path:"in-oversize-frames" module:"openconfig-if-ethernet"`
+	InSingleCollision   *uint64 `path:"in-single-collision" module:"openconfig-if-ethernet"`
+	InSymbolError       *uint64 `path:"in-symbol-error" module:"openconfig-if-ethernet"`
+	InUndersizeFrames   *uint64 `path:"in-undersize-frames" module:"openconfig-if-ethernet"`
+	Out_8021QFrames     *uint64 `path:"out-8021q-frames" module:"openconfig-if-ethernet"`
+	OutMacControlFrames *uint64 `path:"out-mac-control-frames" module:"openconfig-if-ethernet"`
+	OutMacErrorsTx      *uint64 `path:"out-mac-errors-tx" module:"openconfig-if-ethernet"`
+	OutMacPauseFrames   *uint64 `path:"out-mac-pause-frames" module:"openconfig-if-ethernet"`
+}
+
+// IsYANGGoStruct ensures that Interface_Ethernet_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Ethernet_Counters) IsYANGGoStruct() {}
+
+// GetIn_8021QFrames retrieves the value of the leaf In_8021QFrames from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if In_8021QFrames is set, it can
+// safely use t.GetIn_8021QFrames() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.In_8021QFrames == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetIn_8021QFrames() uint64 {
+	if t == nil || t.In_8021QFrames == nil {
+		return 0
+	}
+	return *t.In_8021QFrames
+}
+
+// GetInBlockErrors retrieves the value of the leaf InBlockErrors from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InBlockErrors is set, it can
+// safely use t.GetInBlockErrors() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InBlockErrors == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetInBlockErrors() uint64 {
+	if t == nil || t.InBlockErrors == nil {
+		return 0
+	}
+	return *t.InBlockErrors
+}
+
+// GetInCarrierErrors retrieves the value of the leaf InCarrierErrors from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InCarrierErrors is set, it can
+// safely use t.GetInCarrierErrors() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InCarrierErrors == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetInCarrierErrors() uint64 {
+	if t == nil || t.InCarrierErrors == nil {
+		return 0
+	}
+	return *t.InCarrierErrors
+}
+
+// GetInCrcErrors retrieves the value of the leaf InCrcErrors from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InCrcErrors is set, it can
+// safely use t.GetInCrcErrors() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InCrcErrors == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetInCrcErrors() uint64 {
+	if t == nil || t.InCrcErrors == nil {
+		return 0
+	}
+	return *t.InCrcErrors
+}
+
+// GetInFragmentFrames retrieves the value of the leaf InFragmentFrames from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InFragmentFrames is set, it can
+// safely use t.GetInFragmentFrames() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InFragmentFrames == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetInFragmentFrames() uint64 {
+	if t == nil || t.InFragmentFrames == nil {
+		return 0
+	}
+	return *t.InFragmentFrames
+}
+
+// GetInInterruptedTx retrieves the value of the leaf InInterruptedTx from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InInterruptedTx is set, it can
+// safely use t.GetInInterruptedTx() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InInterruptedTx == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetInInterruptedTx() uint64 {
+	if t == nil || t.InInterruptedTx == nil {
+		return 0
+	}
+	return *t.InInterruptedTx
+}
+
+// GetInJabberFrames retrieves the value of the leaf InJabberFrames from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InJabberFrames is set, it can
+// safely use t.GetInJabberFrames() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InJabberFrames == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetInJabberFrames() uint64 {
+	if t == nil || t.InJabberFrames == nil {
+		return 0
+	}
+	return *t.InJabberFrames
+}
+
+// GetInLateCollision retrieves the value of the leaf InLateCollision from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InLateCollision is set, it can
+// safely use t.GetInLateCollision() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InLateCollision == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetInLateCollision() uint64 {
+	if t == nil || t.InLateCollision == nil {
+		return 0
+	}
+	return *t.InLateCollision
+}
+
+// GetInMacControlFrames retrieves the value of the leaf InMacControlFrames from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InMacControlFrames is set, it can
+// safely use t.GetInMacControlFrames() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InMacControlFrames == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetInMacControlFrames() uint64 {
+	if t == nil || t.InMacControlFrames == nil {
+		return 0
+	}
+	return *t.InMacControlFrames
+}
+
+// GetInMacErrorsRx retrieves the value of the leaf InMacErrorsRx from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InMacErrorsRx is set, it can
+// safely use t.GetInMacErrorsRx() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InMacErrorsRx == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetInMacErrorsRx() uint64 {
+	if t == nil || t.InMacErrorsRx == nil {
+		return 0
+	}
+	return *t.InMacErrorsRx
+}
+
+// GetInMacPauseFrames retrieves the value of the leaf InMacPauseFrames from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InMacPauseFrames is set, it can
+// safely use t.GetInMacPauseFrames() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InMacPauseFrames == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetInMacPauseFrames() uint64 {
+	if t == nil || t.InMacPauseFrames == nil {
+		return 0
+	}
+	return *t.InMacPauseFrames
+}
+
+// GetInMaxsizeExceeded retrieves the value of the leaf InMaxsizeExceeded from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InMaxsizeExceeded is set, it can
+// safely use t.GetInMaxsizeExceeded() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InMaxsizeExceeded == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetInMaxsizeExceeded() uint64 {
+	if t == nil || t.InMaxsizeExceeded == nil {
+		return 0
+	}
+	return *t.InMaxsizeExceeded
+}
+
+// GetInOversizeFrames retrieves the value of the leaf InOversizeFrames from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InOversizeFrames is set, it can
+// safely use t.GetInOversizeFrames() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InOversizeFrames == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetInOversizeFrames() uint64 {
+	if t == nil || t.InOversizeFrames == nil {
+		return 0
+	}
+	return *t.InOversizeFrames
+}
+
+// GetInSingleCollision retrieves the value of the leaf InSingleCollision from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InSingleCollision is set, it can
+// safely use t.GetInSingleCollision() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InSingleCollision == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetInSingleCollision() uint64 {
+	if t == nil || t.InSingleCollision == nil {
+		return 0
+	}
+	return *t.InSingleCollision
+}
+
+// GetInSymbolError retrieves the value of the leaf InSymbolError from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InSymbolError is set, it can
+// safely use t.GetInSymbolError() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InSymbolError == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetInSymbolError() uint64 {
+	if t == nil || t.InSymbolError == nil {
+		return 0
+	}
+	return *t.InSymbolError
+}
+
+// GetInUndersizeFrames retrieves the value of the leaf InUndersizeFrames from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InUndersizeFrames is set, it can
+// safely use t.GetInUndersizeFrames() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InUndersizeFrames == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetInUndersizeFrames() uint64 {
+	if t == nil || t.InUndersizeFrames == nil {
+		return 0
+	}
+	return *t.InUndersizeFrames
+}
+
+// GetOut_8021QFrames retrieves the value of the leaf Out_8021QFrames from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Out_8021QFrames is set, it can
+// safely use t.GetOut_8021QFrames() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Out_8021QFrames == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetOut_8021QFrames() uint64 {
+	if t == nil || t.Out_8021QFrames == nil {
+		return 0
+	}
+	return *t.Out_8021QFrames
+}
+
+// GetOutMacControlFrames retrieves the value of the leaf OutMacControlFrames from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutMacControlFrames is set, it can
+// safely use t.GetOutMacControlFrames() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutMacControlFrames == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetOutMacControlFrames() uint64 {
+	if t == nil || t.OutMacControlFrames == nil {
+		return 0
+	}
+	return *t.OutMacControlFrames
+}
+
+// GetOutMacErrorsTx retrieves the value of the leaf OutMacErrorsTx from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutMacErrorsTx is set, it can
+// safely use t.GetOutMacErrorsTx() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutMacErrorsTx == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetOutMacErrorsTx() uint64 {
+	if t == nil || t.OutMacErrorsTx == nil {
+		return 0
+	}
+	return *t.OutMacErrorsTx
+}
+
+// GetOutMacPauseFrames retrieves the value of the leaf OutMacPauseFrames from the Interface_Ethernet_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutMacPauseFrames is set, it can
+// safely use t.GetOutMacPauseFrames() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutMacPauseFrames == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_Counters) GetOutMacPauseFrames() uint64 {
+	if t == nil || t.OutMacPauseFrames == nil {
+		return 0
+	}
+	return *t.OutMacPauseFrames
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Ethernet_Counters
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Ethernet_Counters) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Ethernet_Counters.
+func (*Interface_Ethernet_Counters) ΛBelongingModule() string {
+	return "openconfig-if-ethernet"
+}
+
+// Interface_Ethernet_SwitchedVlan represents the /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan YANG schema element.
+type Interface_Ethernet_SwitchedVlan struct {
+	AccessVlan    *uint16                                            `path:"state/access-vlan" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/access-vlan" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	InterfaceMode E_OpenconfigVlan_VlanModeType                      `path:"state/interface-mode" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/interface-mode" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	NativeVlan    *uint16                                            `path:"state/native-vlan" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/native-vlan" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	TrunkVlans    []Interface_Ethernet_SwitchedVlan_TrunkVlans_Union `path:"state/trunk-vlans" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/trunk-vlans" shadow-module:"openconfig-vlan/openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Ethernet_SwitchedVlan implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Ethernet_SwitchedVlan) IsYANGGoStruct() {}
+
+// GetAccessVlan retrieves the value of the leaf AccessVlan from the Interface_Ethernet_SwitchedVlan
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AccessVlan is set, it can
+// safely use t.GetAccessVlan() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AccessVlan == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_SwitchedVlan) GetAccessVlan() uint16 {
+	if t == nil || t.AccessVlan == nil {
+		return 0
+	}
+	return *t.AccessVlan
+}
+
+// GetInterfaceMode retrieves the value of the leaf InterfaceMode from the Interface_Ethernet_SwitchedVlan
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InterfaceMode is set, it can
+// safely use t.GetInterfaceMode() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InterfaceMode == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_SwitchedVlan) GetInterfaceMode() E_OpenconfigVlan_VlanModeType {
+	if t == nil || t.InterfaceMode == 0 {
+		return 0
+	}
+	return t.InterfaceMode
+}
+
+// GetNativeVlan retrieves the value of the leaf NativeVlan from the Interface_Ethernet_SwitchedVlan
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if NativeVlan is set, it can
+// safely use t.GetNativeVlan() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.NativeVlan == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_SwitchedVlan) GetNativeVlan() uint16 {
+	if t == nil || t.NativeVlan == nil {
+		return 0
+	}
+	return *t.NativeVlan
+}
+
+// GetTrunkVlans retrieves the value of the leaf TrunkVlans from the Interface_Ethernet_SwitchedVlan
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if TrunkVlans is set, it can
+// safely use t.GetTrunkVlans() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.TrunkVlans == nil' before retrieving the leaf's value.
+func (t *Interface_Ethernet_SwitchedVlan) GetTrunkVlans() []Interface_Ethernet_SwitchedVlan_TrunkVlans_Union {
+	if t == nil || t.TrunkVlans == nil {
+		return nil
+	}
+	return t.TrunkVlans
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Ethernet_SwitchedVlan
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Ethernet_SwitchedVlan) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Ethernet_SwitchedVlan.
+func (*Interface_Ethernet_SwitchedVlan) ΛBelongingModule() string {
+	return "openconfig-vlan"
+}
+
+// Interface_Ethernet_SwitchedVlan_TrunkVlans_Union is an interface that is implemented by valid types for the union
+// for the leaf /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan/state/trunk-vlans within the YANG schema.
+type Interface_Ethernet_SwitchedVlan_TrunkVlans_Union interface {
+	Is_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union()
+}
+
+// Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_String is used when /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan/state/trunk-vlans
+// is to be set to a string value.
+type Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_String struct {
+	String string
+}
+
+// Is_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union ensures that Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_String
+// implements the Interface_Ethernet_SwitchedVlan_TrunkVlans_Union interface.
+func (*Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_String) Is_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union() {
+}
+
+// Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_Uint16 is used when /openconfig-interfaces/interfaces/interface/ethernet/switched-vlan/state/trunk-vlans
+// is to be set to a uint16 value.
+type Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_Uint16 struct {
+	Uint16 uint16
+}
+
+// Is_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union ensures that Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_Uint16
+// implements the Interface_Ethernet_SwitchedVlan_TrunkVlans_Union interface.
+func (*Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_Uint16) Is_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union() {
+}
+
+// To_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the Interface_Ethernet_SwitchedVlan_TrunkVlans_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *Interface_Ethernet_SwitchedVlan) To_Interface_Ethernet_SwitchedVlan_TrunkVlans_Union(i interface{}) (Interface_Ethernet_SwitchedVlan_TrunkVlans_Union, error) {
+	switch v := i.(type) {
+	case string:
+		return &Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_String{v}, nil
+	case uint16:
+		return &Interface_Ethernet_SwitchedVlan_TrunkVlans_Union_Uint16{v}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %v to Interface_Ethernet_SwitchedVlan_TrunkVlans_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
+	}
+}
+
+// Interface_HoldTime represents the /openconfig-interfaces/interfaces/interface/hold-time YANG schema element.
+type Interface_HoldTime struct {
+	Down *uint32 `path:"state/down" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/down" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
+	Up   *uint32 `path:"state/up" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/up" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
+}
+
+// IsYANGGoStruct ensures that Interface_HoldTime implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_HoldTime) IsYANGGoStruct() {}
+
+// GetDown retrieves the value of the leaf Down from the Interface_HoldTime
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Down is set, it can
+// safely use t.GetDown() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Down == nil' before retrieving the leaf's value.
+func (t *Interface_HoldTime) GetDown() uint32 {
+	if t == nil || t.Down == nil {
+		return 0
+	}
+	return *t.Down
+}
+
+// GetUp retrieves the value of the leaf Up from the Interface_HoldTime
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Up is set, it can
+// safely use t.GetUp() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Up == nil' before retrieving the leaf's value.
+func (t *Interface_HoldTime) GetUp() uint32 {
+	if t == nil || t.Up == nil {
+		return 0
+	}
+	return *t.Up
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_HoldTime
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_HoldTime) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Down == nil {
+		var v uint32 = 0
+		t.Down = &v
+	}
+	if t.Up == nil {
+		var v uint32 = 0
+		t.Up = &v
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_HoldTime.
+func (*Interface_HoldTime) ΛBelongingModule() string {
+	return "openconfig-interfaces"
+}
+
+// Interface_RoutedVlan represents the /openconfig-interfaces/interfaces/interface/routed-vlan YANG schema element.
+type Interface_RoutedVlan struct {
+	Ipv4 *Interface_RoutedVlan_Ipv4      `path:"ipv4" module:"openconfig-if-ip"`
+	Ipv6 *Interface_RoutedVlan_Ipv6      `path:"ipv6" module:"openconfig-if-ip"`
+	Vlan Interface_RoutedVlan_Vlan_Union `path:"state/vlan" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/vlan" shadow-module:"openconfig-vlan/openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan) IsYANGGoStruct() {}
+
+// GetOrCreateIpv4 retrieves the value of the Ipv4 field
+// or returns the existing field if it already exists.
+func (t *Interface_RoutedVlan) GetOrCreateIpv4() *Interface_RoutedVlan_Ipv4 {
+	if t.Ipv4 != nil {
+		return t.Ipv4
+	}
+	t.Ipv4 = &Interface_RoutedVlan_Ipv4{}
+	return t.Ipv4
+}
+
+// GetOrCreateIpv6 retrieves the value of the Ipv6 field
+// or returns the existing field if it already exists.
+func (t *Interface_RoutedVlan) GetOrCreateIpv6() *Interface_RoutedVlan_Ipv6 {
+	if t.Ipv6 != nil {
+		return t.Ipv6
+	}
+	t.Ipv6 = &Interface_RoutedVlan_Ipv6{}
+	return t.Ipv6
+}
+
+// GetIpv4 returns the value of the Ipv4 struct pointer
+// from Interface_RoutedVlan. If the receiver or the field Ipv4 is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_RoutedVlan) GetIpv4() *Interface_RoutedVlan_Ipv4 {
+	if t != nil && t.Ipv4 != nil {
+		return t.Ipv4
+	}
+	return nil
+}
+
+// GetIpv6 returns the value of the Ipv6 struct pointer
+// from Interface_RoutedVlan. If the receiver or the field Ipv6 is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_RoutedVlan) GetIpv6() *Interface_RoutedVlan_Ipv6 {
+	if t != nil && t.Ipv6 != nil {
+		return t.Ipv6
+	}
+	return nil
+}
+
+// GetVlan retrieves the value of the leaf Vlan from the Interface_RoutedVlan
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Vlan is set, it can
+// safely use t.GetVlan() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Vlan == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan) GetVlan() Interface_RoutedVlan_Vlan_Union {
+	if t == nil || t.Vlan == nil {
+		return nil
+	}
+	return t.Vlan
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	t.Ipv4.PopulateDefaults()
+	t.Ipv6.PopulateDefaults()
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan.
+func (*Interface_RoutedVlan) ΛBelongingModule() string {
+	return "openconfig-vlan"
+}
+
+// Interface_RoutedVlan_Vlan_Union is an interface that is implemented by valid types for the union
+// for the leaf /openconfig-interfaces/interfaces/interface/routed-vlan/state/vlan within the YANG schema.
+type Interface_RoutedVlan_Vlan_Union interface {
+	Is_Interface_RoutedVlan_Vlan_Union()
+}
+
+// Interface_RoutedVlan_Vlan_Union_String is used when /openconfig-interfaces/interfaces/interface/routed-vlan/state/vlan
+// is to be set to a string value.
+type Interface_RoutedVlan_Vlan_Union_String struct {
+	String string
+}
+
+// Is_Interface_RoutedVlan_Vlan_Union ensures that Interface_RoutedVlan_Vlan_Union_String
+// implements the Interface_RoutedVlan_Vlan_Union interface.
+func (*Interface_RoutedVlan_Vlan_Union_String) Is_Interface_RoutedVlan_Vlan_Union() {}
+
+// Interface_RoutedVlan_Vlan_Union_Uint16 is used when /openconfig-interfaces/interfaces/interface/routed-vlan/state/vlan
+// is to be set to a uint16 value.
+type Interface_RoutedVlan_Vlan_Union_Uint16 struct {
+	Uint16 uint16
+}
+
+// Is_Interface_RoutedVlan_Vlan_Union ensures that Interface_RoutedVlan_Vlan_Union_Uint16
+// implements the Interface_RoutedVlan_Vlan_Union interface.
+func (*Interface_RoutedVlan_Vlan_Union_Uint16) Is_Interface_RoutedVlan_Vlan_Union() {}
+
+// To_Interface_RoutedVlan_Vlan_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the Interface_RoutedVlan_Vlan_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *Interface_RoutedVlan) To_Interface_RoutedVlan_Vlan_Union(i interface{}) (Interface_RoutedVlan_Vlan_Union, error) {
+	switch v := i.(type) {
+	case string:
+		return &Interface_RoutedVlan_Vlan_Union_String{v}, nil
+	case uint16:
+		return &Interface_RoutedVlan_Vlan_Union_Uint16{v}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %v to Interface_RoutedVlan_Vlan_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
+	}
+}
+
+// Interface_RoutedVlan_Ipv4 represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv4 YANG schema element.
+type Interface_RoutedVlan_Ipv4 struct {
+	Address    map[string]*Interface_RoutedVlan_Ipv4_Address  `path:"addresses/address" module:"openconfig-if-ip/openconfig-if-ip"`
+	Counters   *Interface_RoutedVlan_Ipv4_Counters            `path:"state/counters" module:"openconfig-if-ip/openconfig-if-ip"`
+	DhcpClient *bool                                          `path:"state/dhcp-client" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/dhcp-client" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Enabled    *bool                                          `path:"state/enabled" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/enabled" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Mtu        *uint16                                        `path:"state/mtu" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/mtu" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Neighbor   map[string]*Interface_RoutedVlan_Ipv4_Neighbor `path:"neighbors/neighbor" module:"openconfig-if-ip/openconfig-if-ip"`
+	ProxyArp   *Interface_RoutedVlan_Ipv4_ProxyArp            `path:"proxy-arp" module:"openconfig-if-ip"`
+	Unnumbered *Interface_RoutedVlan_Ipv4_Unnumbered          `path:"unnumbered" module:"openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv4 implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv4) IsYANGGoStruct() {}
+
+// NewAddress creates a new entry in the Address list of the
+// Interface_RoutedVlan_Ipv4 struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface_RoutedVlan_Ipv4) NewAddress(Ip string) (*Interface_RoutedVlan_Ipv4_Address, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Address == nil {
+		t.Address = make(map[string]*Interface_RoutedVlan_Ipv4_Address)
+	}
+
+	key := Ip
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Address[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Address", key)
+	}
+
+	t.Address[key] = &Interface_RoutedVlan_Ipv4_Address{
+		Ip: &Ip,
+	}
+
+	return t.Address[key], nil
+}
+
+// GetOrCreateAddressMap returns the list (map) from Interface_RoutedVlan_Ipv4.
+//
+// It initializes the field if not already initialized.
+func (t *Interface_RoutedVlan_Ipv4) GetOrCreateAddressMap() map[string]*Interface_RoutedVlan_Ipv4_Address {
+	if t.Address == nil {
+		t.Address = make(map[string]*Interface_RoutedVlan_Ipv4_Address)
+	}
+	return t.Address
+}
+
+// GetOrCreateAddress retrieves the value with the specified keys from
+// the receiver Interface_RoutedVlan_Ipv4. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Interface_RoutedVlan_Ipv4) GetOrCreateAddress(Ip string) *Interface_RoutedVlan_Ipv4_Address {
+
+	key := Ip
+
+	if v, ok := t.Address[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewAddress(Ip)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateAddress got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetAddress retrieves the value with the specified key from
+// the Address map field of Interface_RoutedVlan_Ipv4. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Interface_RoutedVlan_Ipv4) GetAddress(Ip string) *Interface_RoutedVlan_Ipv4_Address {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Ip
+
+	if lm, ok := t.Address[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteAddress deletes the value with the specified keys from
+// the receiver Interface_RoutedVlan_Ipv4. If there is no such element, the function
+// is a no-op.
+func (t *Interface_RoutedVlan_Ipv4) DeleteAddress(Ip string) {
+	key := Ip
+
+	delete(t.Address, key)
+}
+
+// NewNeighbor creates a new entry in the Neighbor list of the
+// Interface_RoutedVlan_Ipv4 struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface_RoutedVlan_Ipv4) NewNeighbor(Ip string) (*Interface_RoutedVlan_Ipv4_Neighbor, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Neighbor == nil {
+		t.Neighbor = make(map[string]*Interface_RoutedVlan_Ipv4_Neighbor)
+	}
+
+	key := Ip
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Neighbor[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Neighbor", key)
+	}
+
+	t.Neighbor[key] = &Interface_RoutedVlan_Ipv4_Neighbor{
+		Ip: &Ip,
+	}
+
+	return t.Neighbor[key], nil
+}
+
+// GetOrCreateNeighborMap returns the list (map) from Interface_RoutedVlan_Ipv4.
+//
+// It initializes the field if not already initialized.
+func (t *Interface_RoutedVlan_Ipv4) GetOrCreateNeighborMap() map[string]*Interface_RoutedVlan_Ipv4_Neighbor {
+	if t.Neighbor == nil {
+		t.Neighbor = make(map[string]*Interface_RoutedVlan_Ipv4_Neighbor)
+	}
+	return t.Neighbor
+}
+
+// GetOrCreateNeighbor retrieves the value with the specified keys from
+// the receiver Interface_RoutedVlan_Ipv4. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Interface_RoutedVlan_Ipv4) GetOrCreateNeighbor(Ip string) *Interface_RoutedVlan_Ipv4_Neighbor {
+
+	key := Ip
+
+	if v, ok := t.Neighbor[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewNeighbor(Ip)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateNeighbor got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetNeighbor retrieves the value with the specified key from
+// the Neighbor map field of Interface_RoutedVlan_Ipv4. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Interface_RoutedVlan_Ipv4) GetNeighbor(Ip string) *Interface_RoutedVlan_Ipv4_Neighbor {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Ip
+
+	if lm, ok := t.Neighbor[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteNeighbor deletes the value with the specified keys from
+// the receiver Interface_RoutedVlan_Ipv4. If there is no such element, the function
+// is a no-op.
+func (t *Interface_RoutedVlan_Ipv4) DeleteNeighbor(Ip string) {
+	key := Ip
+
+	delete(t.Neighbor, key)
+}
+
+// GetOrCreateCounters retrieves the value of the Counters field
+// or returns the existing field if it already exists.
+func (t *Interface_RoutedVlan_Ipv4) GetOrCreateCounters() *Interface_RoutedVlan_Ipv4_Counters {
+	if t.Counters != nil {
+		return t.Counters
+	}
+	t.Counters = &Interface_RoutedVlan_Ipv4_Counters{}
+	return t.Counters
+}
+
+// GetOrCreateProxyArp retrieves the value of the ProxyArp field
+// or returns the existing field if it already exists.
+func (t *Interface_RoutedVlan_Ipv4) GetOrCreateProxyArp() *Interface_RoutedVlan_Ipv4_ProxyArp {
+	if t.ProxyArp != nil {
+		return t.ProxyArp
+	}
+	t.ProxyArp = &Interface_RoutedVlan_Ipv4_ProxyArp{}
+	return t.ProxyArp
+}
+
+// GetOrCreateUnnumbered retrieves the value of the Unnumbered field
+// or returns the existing field if it already exists.
+func (t *Interface_RoutedVlan_Ipv4) GetOrCreateUnnumbered() *Interface_RoutedVlan_Ipv4_Unnumbered {
+	if t.Unnumbered != nil {
+		return t.Unnumbered
+	}
+	t.Unnumbered = &Interface_RoutedVlan_Ipv4_Unnumbered{}
+	return t.Unnumbered
+}
+
+// GetCounters returns the value of the Counters struct pointer
+// from Interface_RoutedVlan_Ipv4. If the receiver or the field Counters is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_RoutedVlan_Ipv4) GetCounters() *Interface_RoutedVlan_Ipv4_Counters {
+	if t != nil && t.Counters != nil {
+		return t.Counters
+	}
+	return nil
+}
+
+// GetProxyArp returns the value of the ProxyArp struct pointer
+// from Interface_RoutedVlan_Ipv4. If the receiver or the field ProxyArp is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_RoutedVlan_Ipv4) GetProxyArp() *Interface_RoutedVlan_Ipv4_ProxyArp {
+	if t != nil && t.ProxyArp != nil {
+		return t.ProxyArp
+	}
+	return nil
+}
+
+// GetUnnumbered returns the value of the Unnumbered struct pointer
+// from Interface_RoutedVlan_Ipv4. If the receiver or the field Unnumbered is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_RoutedVlan_Ipv4) GetUnnumbered() *Interface_RoutedVlan_Ipv4_Unnumbered {
+	if t != nil && t.Unnumbered != nil {
+		return t.Unnumbered
+	}
+	return nil
+}
+
+// GetDhcpClient retrieves the value of the leaf DhcpClient from the Interface_RoutedVlan_Ipv4
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DhcpClient is set, it can
+// safely use t.GetDhcpClient() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DhcpClient == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4) GetDhcpClient() bool {
+	if t == nil || t.DhcpClient == nil {
+		return false
+	}
+	return *t.DhcpClient
+}
+
+// GetEnabled retrieves the value of the leaf Enabled from the Interface_RoutedVlan_Ipv4
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Enabled is set, it can
+// safely use t.GetEnabled() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Enabled == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return true
+	}
+	return *t.Enabled
+}
+
+// GetMtu retrieves the value of the leaf Mtu from the Interface_RoutedVlan_Ipv4
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Mtu is set, it can
+// safely use t.GetMtu() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Mtu == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4) GetMtu() uint16 {
+	if t == nil || t.Mtu == nil {
+		return 0
+	}
+	return *t.Mtu
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv4
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv4) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.DhcpClient == nil {
+		var v bool = false
+		t.DhcpClient = &v
+	}
+	if t.Enabled == nil {
+		var v bool = true
+		t.Enabled = &v
+	}
+	t.Counters.PopulateDefaults()
+	t.ProxyArp.PopulateDefaults()
+	t.Unnumbered.PopulateDefaults()
+	for _, e := range t.Address {
+		e.PopulateDefaults()
+	}
+	for _, e := range t.Neighbor {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv4.
+func (*Interface_RoutedVlan_Ipv4) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv4_Address represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv4/addresses/address YANG schema element.
+type Interface_RoutedVlan_Ipv4_Address struct {
+	Ip           *string                                                `path:"state/ip|ip" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip" shadow-path:"config/ip|ip" shadow-module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
+	Origin       E_OpenconfigIfIp_IpAddressOrigin                       `path:"state/origin" module:"openconfig-if-ip/openconfig-if-ip"`
+	PrefixLength *uint8                                                 `path:"state/prefix-length" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/prefix-length" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Type         E_OpenconfigIfIp_Ipv4AddressType                       `path:"state/type" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/type" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	VrrpGroup    map[uint8]*Interface_RoutedVlan_Ipv4_Address_VrrpGroup `path:"vrrp/vrrp-group" module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv4_Address implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv4_Address) IsYANGGoStruct() {}
+
+// NewVrrpGroup creates a new entry in the VrrpGroup list of the
+// Interface_RoutedVlan_Ipv4_Address struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface_RoutedVlan_Ipv4_Address) NewVrrpGroup(VirtualRouterId uint8) (*Interface_RoutedVlan_Ipv4_Address_VrrpGroup, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.VrrpGroup == nil {
+		t.VrrpGroup = make(map[uint8]*Interface_RoutedVlan_Ipv4_Address_VrrpGroup)
+	}
+
+	key := VirtualRouterId
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.VrrpGroup[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list VrrpGroup", key)
+	}
+
+	t.VrrpGroup[key] = &Interface_RoutedVlan_Ipv4_Address_VrrpGroup{
+		VirtualRouterId: &VirtualRouterId,
+	}
+
+	return t.VrrpGroup[key], nil
+}
+
+// GetOrCreateVrrpGroupMap returns the list (map) from Interface_RoutedVlan_Ipv4_Address.
+//
+// It initializes the field if not already initialized.
+func (t *Interface_RoutedVlan_Ipv4_Address) GetOrCreateVrrpGroupMap() map[uint8]*Interface_RoutedVlan_Ipv4_Address_VrrpGroup {
+	if t.VrrpGroup == nil {
+		t.VrrpGroup = make(map[uint8]*Interface_RoutedVlan_Ipv4_Address_VrrpGroup)
+	}
+	return t.VrrpGroup
+}
+
+// GetOrCreateVrrpGroup retrieves the value with the specified keys from
+// the receiver Interface_RoutedVlan_Ipv4_Address. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Interface_RoutedVlan_Ipv4_Address) GetOrCreateVrrpGroup(VirtualRouterId uint8) *Interface_RoutedVlan_Ipv4_Address_VrrpGroup {
+
+	key := VirtualRouterId
+
+	if v, ok := t.VrrpGroup[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewVrrpGroup(VirtualRouterId)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateVrrpGroup got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetVrrpGroup retrieves the value with the specified key from
+// the VrrpGroup map field of Interface_RoutedVlan_Ipv4_Address. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Interface_RoutedVlan_Ipv4_Address) GetVrrpGroup(VirtualRouterId uint8) *Interface_RoutedVlan_Ipv4_Address_VrrpGroup {
+
+	if t == nil {
+		return nil
+	}
+
+	key := VirtualRouterId
+
+	if lm, ok := t.VrrpGroup[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteVrrpGroup deletes the value with the specified keys from
+// the receiver Interface_RoutedVlan_Ipv4_Address. If there is no such element, the function
+// is a no-op.
+func (t *Interface_RoutedVlan_Ipv4_Address) DeleteVrrpGroup(VirtualRouterId uint8) {
+	key := VirtualRouterId
+
+	delete(t.VrrpGroup, key)
+}
+
+// GetIp retrieves the value of the leaf Ip from the Interface_RoutedVlan_Ipv4_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Ip is set, it can
+// safely use t.GetIp() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Ip == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Address) GetIp() string {
+	if t == nil || t.Ip == nil {
+		return ""
+	}
+	return *t.Ip
+}
+
+// GetOrigin retrieves the value of the leaf Origin from the Interface_RoutedVlan_Ipv4_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Origin is set, it can
+// safely use t.GetOrigin() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Origin == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Address) GetOrigin() E_OpenconfigIfIp_IpAddressOrigin {
+	if t == nil || t.Origin == 0 {
+		return 0
+	}
+	return t.Origin
+}
+
+// GetPrefixLength retrieves the value of the leaf PrefixLength from the Interface_RoutedVlan_Ipv4_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PrefixLength is set, it can
+// safely use t.GetPrefixLength() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PrefixLength == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Address) GetPrefixLength() uint8 {
+	if t == nil || t.PrefixLength == nil {
+		return 0
+	}
+	return *t.PrefixLength
+}
+
+// GetType retrieves the value of the leaf Type from the Interface_RoutedVlan_Ipv4_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Type is set, it can
+// safely use t.GetType() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Type == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Address) GetType() E_OpenconfigIfIp_Ipv4AddressType {
+	if t == nil || t.Type == 0 {
+		return OpenconfigIfIp_Ipv4AddressType_PRIMARY
+	}
+	return t.Type
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv4_Address
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv4_Address) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Type == 0 {
+		t.Type = OpenconfigIfIp_Ipv4AddressType_PRIMARY
+	}
+	for _, e := range t.VrrpGroup {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛListKeyMap returns the keys of the Interface_RoutedVlan_Ipv4_Address struct, which is a YANG list entry.
+func (t *Interface_RoutedVlan_Ipv4_Address) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Ip == nil {
+		return nil, fmt.Errorf("nil value for key Ip")
+	}
+
+	return map[string]interface{}{
+		"ip": *t.Ip,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv4_Address.
+func (*Interface_RoutedVlan_Ipv4_Address) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv4_Address_VrrpGroup represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv4/addresses/address/vrrp/vrrp-group YANG schema element.
+type Interface_RoutedVlan_Ipv4_Address_VrrpGroup struct {
+	AcceptMode            *bool                                                          `path:"state/accept-mode" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/accept-mode" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	AdvertisementInterval *uint16                                                        `path:"state/advertisement-interval" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/advertisement-interval" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	CurrentPriority       *uint8                                                         `path:"state/current-priority" module:"openconfig-if-ip/openconfig-if-ip"`
+	InterfaceTracking     *Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking `path:"interface-tracking" module:"openconfig-if-ip"`
+	Preempt               *bool                                                          `path:"state/preempt" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/preempt" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	PreemptDelay          *uint16                                                        `path:"state/preempt-delay" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/preempt-delay" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Priority              *uint8                                                         `path:"state/priority" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/priority" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	VirtualAddress        []string                                                       `path:"state/virtual-address" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/virtual-address" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	VirtualRouterId       *uint8                                                         `path:"state/virtual-router-id|virtual-router-id" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip" shadow-path:"config/virtual-router-id|virtual-router-id" shadow-module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv4_Address_VrrpGroup implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv4_Address_VrrpGroup) IsYANGGoStruct() {}
+
+// GetOrCreateInterfaceTracking retrieves the value of the InterfaceTracking field
+// or returns the existing field if it already exists.
+func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) GetOrCreateInterfaceTracking() *Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking {
+	if t.InterfaceTracking != nil {
+		return t.InterfaceTracking
+	}
+	t.InterfaceTracking = &Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking{}
+	return t.InterfaceTracking
+}
+
+// GetInterfaceTracking returns the value of the InterfaceTracking struct pointer
+// from Interface_RoutedVlan_Ipv4_Address_VrrpGroup. If the receiver or the field InterfaceTracking is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) GetInterfaceTracking() *Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking {
+	if t != nil && t.InterfaceTracking != nil {
+		return t.InterfaceTracking
+	}
+	return nil
+}
+
+// GetAcceptMode retrieves the value of the leaf AcceptMode from the Interface_RoutedVlan_Ipv4_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AcceptMode is set, it can
+// safely use t.GetAcceptMode() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AcceptMode == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) GetAcceptMode() bool {
+	if t == nil || t.AcceptMode == nil {
+		return false
+	}
+	return *t.AcceptMode
+}
+
+// GetAdvertisementInterval retrieves the value of the leaf AdvertisementInterval from the Interface_RoutedVlan_Ipv4_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AdvertisementInterval is set, it can
+// safely use t.GetAdvertisementInterval() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AdvertisementInterval == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) GetAdvertisementInterval() uint16 {
+	if t == nil || t.AdvertisementInterval == nil {
+		return 100
+	}
+	return *t.AdvertisementInterval
+}
+
+// GetCurrentPriority retrieves the value of the leaf CurrentPriority from the Interface_RoutedVlan_Ipv4_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if CurrentPriority is set, it can
+// safely use t.GetCurrentPriority() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.CurrentPriority == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) GetCurrentPriority() uint8 {
+	if t == nil || t.CurrentPriority == nil {
+		return 0
+	}
+	return *t.CurrentPriority
+}
+
+// GetPreempt retrieves the value of the leaf Preempt from the Interface_RoutedVlan_Ipv4_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Preempt is set, it can
+// safely use t.GetPreempt() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Preempt == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) GetPreempt() bool {
+	if t == nil || t.Preempt == nil {
+		return true
+	}
+	return *t.Preempt
+}
+
+// GetPreemptDelay retrieves the value of the leaf PreemptDelay from the Interface_RoutedVlan_Ipv4_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PreemptDelay is set, it can
+// safely use t.GetPreemptDelay() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PreemptDelay == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) GetPreemptDelay() uint16 {
+	if t == nil || t.PreemptDelay == nil {
+		return 0
+	}
+	return *t.PreemptDelay
+}
+
+// GetPriority retrieves the value of the leaf Priority from the Interface_RoutedVlan_Ipv4_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Priority is set, it can
+// safely use t.GetPriority() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Priority == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) GetPriority() uint8 {
+	if t == nil || t.Priority == nil {
+		return 100
+	}
+	return *t.Priority
+}
+
+// GetVirtualAddress retrieves the value of the leaf VirtualAddress from the Interface_RoutedVlan_Ipv4_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VirtualAddress is set, it can
+// safely use t.GetVirtualAddress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VirtualAddress == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) GetVirtualAddress() []string {
+	if t == nil || t.VirtualAddress == nil {
+		return nil
+	}
+	return t.VirtualAddress
+}
+
+// GetVirtualRouterId retrieves the value of the leaf VirtualRouterId from the Interface_RoutedVlan_Ipv4_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VirtualRouterId is set, it can
+// safely use t.GetVirtualRouterId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VirtualRouterId == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) GetVirtualRouterId() uint8 {
+	if t == nil || t.VirtualRouterId == nil {
+		return 0
+	}
+	return *t.VirtualRouterId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv4_Address_VrrpGroup
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.AcceptMode == nil {
+		var v bool = false
+		t.AcceptMode = &v
+	}
+	if t.AdvertisementInterval == nil {
+		var v uint16 = 100
+		t.AdvertisementInterval = &v
+	}
+	if t.Preempt == nil {
+		var v bool = true
+		t.Preempt = &v
+	}
+	if t.PreemptDelay == nil {
+		var v uint16 = 0
+		t.PreemptDelay = &v
+	}
+	if t.Priority == nil {
+		var v uint8 = 100
+		t.Priority = &v
+	}
+	t.InterfaceTracking.PopulateDefaults()
+}
+
+// ΛListKeyMap returns the keys of the Interface_RoutedVlan_Ipv4_Address_VrrpGroup struct, which is a YANG list entry.
+func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.VirtualRouterId == nil {
+		return nil, fmt.Errorf("nil value for key VirtualRouterId")
+	}
+
+	return map[string]interface{}{
+		"virtual-router-id": *t.VirtualRouterId,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv4_Address_VrrpGroup.
+func (*Interface_RoutedVlan_Ipv4_Address_VrrpGroup) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv4/addresses/address/vrrp/vrrp-group/interface-tracking YANG schema element.
+type Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking struct {
+	PriorityDecrement *uint8   `path:"state/priority-decrement" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/priority-decrement" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	TrackInterface    []string `path:"state/track-interface" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/track-interface" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking) IsYANGGoStruct() {}
+
+// GetPriorityDecrement retrieves the value of the leaf PriorityDecrement from the Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PriorityDecrement is set, it can
+// safely use t.GetPriorityDecrement() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PriorityDecrement == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking) GetPriorityDecrement() uint8 {
+	if t == nil || t.PriorityDecrement == nil {
+		return 0
+	}
+	return *t.PriorityDecrement
+}
+
+// GetTrackInterface retrieves the value of the leaf TrackInterface from the Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if TrackInterface is set, it can
+// safely use t.GetTrackInterface() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.TrackInterface == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking) GetTrackInterface() []string {
+	if t == nil || t.TrackInterface == nil {
+		return nil
+	}
+	return t.TrackInterface
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.PriorityDecrement == nil {
+		var v uint8 = 0
+		t.PriorityDecrement = &v
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking.
+func (*Interface_RoutedVlan_Ipv4_Address_VrrpGroup_InterfaceTracking) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv4_Counters represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv4/state/counters YANG schema element.
+type Interface_RoutedVlan_Ipv4_Counters struct {
+	InDiscardedPkts    *uint64 `path:"in-discarded-pkts" module:"openconfig-if-ip"`
+	InErrorPkts        *uint64 `path:"in-error-pkts" module:"openconfig-if-ip"`
+	InForwardedOctets  *uint64 `path:"in-forwarded-octets" module:"openconfig-if-ip"`
+	InForwardedPkts    *uint64 `path:"in-forwarded-pkts" module:"openconfig-if-ip"`
+	InMulticastOctets  *uint64 `path:"in-multicast-octets" module:"openconfig-if-ip"`
+	InMulticastPkts    *uint64 `path:"in-multicast-pkts" module:"openconfig-if-ip"`
+	InOctets           *uint64 `path:"in-octets" module:"openconfig-if-ip"`
+	InPkts             *uint64 `path:"in-pkts" module:"openconfig-if-ip"`
+	OutDiscardedPkts   *uint64 `path:"out-discarded-pkts" module:"openconfig-if-ip"`
+	OutErrorPkts       *uint64 `path:"out-error-pkts" module:"openconfig-if-ip"`
+	OutForwardedOctets *uint64 `path:"out-forwarded-octets" module:"openconfig-if-ip"`
+	OutForwardedPkts   *uint64 `path:"out-forwarded-pkts" module:"openconfig-if-ip"`
+	OutMulticastOctets *uint64 `path:"out-multicast-octets" module:"openconfig-if-ip"`
+	OutMulticastPkts   *uint64 `path:"out-multicast-pkts" module:"openconfig-if-ip"`
+	OutOctets          *uint64 `path:"out-octets" module:"openconfig-if-ip"`
+	OutPkts            *uint64 `path:"out-pkts" module:"openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv4_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv4_Counters) IsYANGGoStruct() {}
+
+// GetInDiscardedPkts retrieves the value of the leaf InDiscardedPkts from the Interface_RoutedVlan_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InDiscardedPkts is set, it can
+// safely use t.GetInDiscardedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InDiscardedPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Counters) GetInDiscardedPkts() uint64 {
+	if t == nil || t.InDiscardedPkts == nil {
+		return 0
+	}
+	return *t.InDiscardedPkts
+}
+
+// GetInErrorPkts retrieves the value of the leaf InErrorPkts from the Interface_RoutedVlan_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InErrorPkts is set, it can
+// safely use t.GetInErrorPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InErrorPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Counters) GetInErrorPkts() uint64 {
+	if t == nil || t.InErrorPkts == nil {
+		return 0
+	}
+	return *t.InErrorPkts
+}
+
+// GetInForwardedOctets retrieves the value of the leaf InForwardedOctets from the Interface_RoutedVlan_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InForwardedOctets is set, it can
+// safely use t.GetInForwardedOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InForwardedOctets == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Counters) GetInForwardedOctets() uint64 {
+	if t == nil || t.InForwardedOctets == nil {
+		return 0
+	}
+	return *t.InForwardedOctets
+}
+
+// GetInForwardedPkts retrieves the value of the leaf InForwardedPkts from the Interface_RoutedVlan_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InForwardedPkts is set, it can
+// safely use t.GetInForwardedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InForwardedPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Counters) GetInForwardedPkts() uint64 {
+	if t == nil || t.InForwardedPkts == nil {
+		return 0
+	}
+	return *t.InForwardedPkts
+}
+
+// GetInMulticastOctets retrieves the value of the leaf InMulticastOctets from the Interface_RoutedVlan_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InMulticastOctets is set, it can
+// safely use t.GetInMulticastOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InMulticastOctets == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Counters) GetInMulticastOctets() uint64 {
+	if t == nil || t.InMulticastOctets == nil {
+		return 0
+	}
+	return *t.InMulticastOctets
+}
+
+// GetInMulticastPkts retrieves the value of the leaf InMulticastPkts from the Interface_RoutedVlan_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InMulticastPkts is set, it can
+// safely use t.GetInMulticastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InMulticastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Counters) GetInMulticastPkts() uint64 {
+	if t == nil || t.InMulticastPkts == nil {
+		return 0
+	}
+	return *t.InMulticastPkts
+}
+
+// GetInOctets retrieves the value of the leaf InOctets from the Interface_RoutedVlan_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InOctets is set, it can
+// safely use t.GetInOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InOctets == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Counters) GetInOctets() uint64 {
+	if t == nil || t.InOctets == nil {
+		return 0
+	}
+	return *t.InOctets
+}
+
+// GetInPkts retrieves the value of the leaf InPkts from the Interface_RoutedVlan_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InPkts is set, it can
+// safely use t.GetInPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Counters) GetInPkts() uint64 {
+	if t == nil || t.InPkts == nil {
+		return 0
+	}
+	return *t.InPkts
+}
+
+// GetOutDiscardedPkts retrieves the value of the leaf OutDiscardedPkts from the Interface_RoutedVlan_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutDiscardedPkts is set, it can
+// safely use t.GetOutDiscardedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutDiscardedPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Counters) GetOutDiscardedPkts() uint64 {
+	if t == nil || t.OutDiscardedPkts == nil {
+		return 0
+	}
+	return *t.OutDiscardedPkts
+}
+
+// GetOutErrorPkts retrieves the value of the leaf OutErrorPkts from the Interface_RoutedVlan_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutErrorPkts is set, it can
+// safely use t.GetOutErrorPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutErrorPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Counters) GetOutErrorPkts() uint64 {
+	if t == nil || t.OutErrorPkts == nil {
+		return 0
+	}
+	return *t.OutErrorPkts
+}
+
+// GetOutForwardedOctets retrieves the value of the leaf OutForwardedOctets from the Interface_RoutedVlan_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutForwardedOctets is set, it can
+// safely use t.GetOutForwardedOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutForwardedOctets == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Counters) GetOutForwardedOctets() uint64 {
+	if t == nil || t.OutForwardedOctets == nil {
+		return 0
+	}
+	return *t.OutForwardedOctets
+}
+
+// GetOutForwardedPkts retrieves the value of the leaf OutForwardedPkts from the Interface_RoutedVlan_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutForwardedPkts is set, it can
+// safely use t.GetOutForwardedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutForwardedPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Counters) GetOutForwardedPkts() uint64 {
+	if t == nil || t.OutForwardedPkts == nil {
+		return 0
+	}
+	return *t.OutForwardedPkts
+}
+
+// GetOutMulticastOctets retrieves the value of the leaf OutMulticastOctets from the Interface_RoutedVlan_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutMulticastOctets is set, it can
+// safely use t.GetOutMulticastOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutMulticastOctets == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Counters) GetOutMulticastOctets() uint64 {
+	if t == nil || t.OutMulticastOctets == nil {
+		return 0
+	}
+	return *t.OutMulticastOctets
+}
+
+// GetOutMulticastPkts retrieves the value of the leaf OutMulticastPkts from the Interface_RoutedVlan_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutMulticastPkts is set, it can
+// safely use t.GetOutMulticastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutMulticastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Counters) GetOutMulticastPkts() uint64 {
+	if t == nil || t.OutMulticastPkts == nil {
+		return 0
+	}
+	return *t.OutMulticastPkts
+}
+
+// GetOutOctets retrieves the value of the leaf OutOctets from the Interface_RoutedVlan_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutOctets is set, it can
+// safely use t.GetOutOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutOctets == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Counters) GetOutOctets() uint64 {
+	if t == nil || t.OutOctets == nil {
+		return 0
+	}
+	return *t.OutOctets
+}
+
+// GetOutPkts retrieves the value of the leaf OutPkts from the Interface_RoutedVlan_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutPkts is set, it can
+// safely use t.GetOutPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Counters) GetOutPkts() uint64 {
+	if t == nil || t.OutPkts == nil {
+		return 0
+	}
+	return *t.OutPkts
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv4_Counters
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv4_Counters) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv4_Counters.
+func (*Interface_RoutedVlan_Ipv4_Counters) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv4_Neighbor represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv4/neighbors/neighbor YANG schema element.
+type Interface_RoutedVlan_Ipv4_Neighbor struct {
+	Ip               *string                         `path:"state/ip|ip" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip" shadow-path:"config/ip|ip" shadow-module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
+	LinkLayerAddress *string                         `path:"state/link-layer-address" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/link-layer-address" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Origin           E_OpenconfigIfIp_NeighborOrigin `path:"state/origin" module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv4_Neighbor implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv4_Neighbor) IsYANGGoStruct() {}
+
+// GetIp retrieves the value of the leaf Ip from the Interface_RoutedVlan_Ipv4_Neighbor
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Ip is set, it can
+// safely use t.GetIp() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Ip == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Neighbor) GetIp() string {
+	if t == nil || t.Ip == nil {
+		return ""
+	}
+	return *t.Ip
+}
+
+// GetLinkLayerAddress retrieves the value of the leaf LinkLayerAddress from the Interface_RoutedVlan_Ipv4_Neighbor
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if LinkLayerAddress is set, it can
+// safely use t.GetLinkLayerAddress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.LinkLayerAddress == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Neighbor) GetLinkLayerAddress() string {
+	if t == nil || t.LinkLayerAddress == nil {
+		return ""
+	}
+	return *t.LinkLayerAddress
+}
+
+// GetOrigin retrieves the value of the leaf Origin from the Interface_RoutedVlan_Ipv4_Neighbor
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Origin is set, it can
+// safely use t.GetOrigin() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Origin == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Neighbor) GetOrigin() E_OpenconfigIfIp_NeighborOrigin {
+	if t == nil || t.Origin == 0 {
+		return 0
+	}
+	return t.Origin
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv4_Neighbor
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv4_Neighbor) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛListKeyMap returns the keys of the Interface_RoutedVlan_Ipv4_Neighbor struct, which is a YANG list entry.
+func (t *Interface_RoutedVlan_Ipv4_Neighbor) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Ip == nil {
+		return nil, fmt.Errorf("nil value for key Ip")
+	}
+
+	return map[string]interface{}{
+		"ip": *t.Ip,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv4_Neighbor.
+func (*Interface_RoutedVlan_Ipv4_Neighbor) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv4_ProxyArp represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv4/proxy-arp YANG schema element.
+type Interface_RoutedVlan_Ipv4_ProxyArp struct {
+	Mode E_ProxyArp_Mode `path:"state/mode" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/mode" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv4_ProxyArp implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv4_ProxyArp) IsYANGGoStruct() {}
+
+// GetMode retrieves the value of the leaf Mode from the Interface_RoutedVlan_Ipv4_ProxyArp
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Mode is set, it can
+// safely use t.GetMode() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Mode == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_ProxyArp) GetMode() E_ProxyArp_Mode {
+	if t == nil || t.Mode == 0 {
+		return ProxyArp_Mode_DISABLE
+	}
+	return t.Mode
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv4_ProxyArp
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv4_ProxyArp) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Mode == 0 {
+		t.Mode = ProxyArp_Mode_DISABLE
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv4_ProxyArp.
+func (*Interface_RoutedVlan_Ipv4_ProxyArp) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv4_Unnumbered represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv4/unnumbered YANG schema element.
+type Interface_RoutedVlan_Ipv4_Unnumbered struct {
+	Enabled      *bool                                              `path:"state/enabled" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/enabled" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	InterfaceRef *Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef `path:"interface-ref" module:"openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv4_Unnumbered implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv4_Unnumbered) IsYANGGoStruct() {}
+
+// GetOrCreateInterfaceRef retrieves the value of the InterfaceRef field
+// or returns the existing field if it already exists.
+func (t *Interface_RoutedVlan_Ipv4_Unnumbered) GetOrCreateInterfaceRef() *Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef {
+	if t.InterfaceRef != nil {
+		return t.InterfaceRef
+	}
+	t.InterfaceRef = &Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef{}
+	return t.InterfaceRef
+}
+
+// GetInterfaceRef returns the value of the InterfaceRef struct pointer
+// from Interface_RoutedVlan_Ipv4_Unnumbered. If the receiver or the field InterfaceRef is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_RoutedVlan_Ipv4_Unnumbered) GetInterfaceRef() *Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef {
+	if t != nil && t.InterfaceRef != nil {
+		return t.InterfaceRef
+	}
+	return nil
+}
+
+// GetEnabled retrieves the value of the leaf Enabled from the Interface_RoutedVlan_Ipv4_Unnumbered
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Enabled is set, it can
+// safely use t.GetEnabled() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Enabled == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Unnumbered) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv4_Unnumbered
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv4_Unnumbered) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Enabled == nil {
+		var v bool = false
+		t.Enabled = &v
+	}
+	t.InterfaceRef.PopulateDefaults()
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv4_Unnumbered.
+func (*Interface_RoutedVlan_Ipv4_Unnumbered) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv4/unnumbered/interface-ref YANG schema element.
+type Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef struct {
+	Interface    *string `path:"state/interface" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/interface" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Subinterface *uint32 `path:"state/subinterface" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/subinterface" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef) IsYANGGoStruct() {}
+
+// GetInterface retrieves the value of the leaf Interface from the Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Interface is set, it can
+// safely use t.GetInterface() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Interface == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef) GetInterface() string {
+	if t == nil || t.Interface == nil {
+		return ""
+	}
+	return *t.Interface
+}
+
+// GetSubinterface retrieves the value of the leaf Subinterface from the Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Subinterface is set, it can
+// safely use t.GetSubinterface() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Subinterface == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef) GetSubinterface() uint32 {
+	if t == nil || t.Subinterface == nil {
+		return 0
+	}
+	return *t.Subinterface
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef.
+func (*Interface_RoutedVlan_Ipv4_Unnumbered_InterfaceRef) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv6 represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6 YANG schema element.
+type Interface_RoutedVlan_Ipv6 struct {
+	Address                map[string]*Interface_RoutedVlan_Ipv6_Address  `path:"addresses/address" module:"openconfig-if-ip/openconfig-if-ip"`
+	Counters               *Interface_RoutedVlan_Ipv6_Counters            `path:"state/counters" module:"openconfig-if-ip/openconfig-if-ip"`
+	DhcpClient             *bool                                          `path:"state/dhcp-client" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/dhcp-client" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	DupAddrDetectTransmits *uint32                                        `path:"state/dup-addr-detect-transmits" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/dup-addr-detect-transmits" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Enabled                *bool                                          `path:"state/enabled" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/enabled" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Mtu                    *uint32                                        `path:"state/mtu" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/mtu" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Neighbor               map[string]*Interface_RoutedVlan_Ipv6_Neighbor `path:"neighbors/neighbor" module:"openconfig-if-ip/openconfig-if-ip"`
+	RouterAdvertisement    *Interface_RoutedVlan_Ipv6_RouterAdvertisement `path:"router-advertisement" module:"openconfig-if-ip"`
+	Unnumbered             *Interface_RoutedVlan_Ipv6_Unnumbered          `path:"unnumbered" module:"openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6 implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv6) IsYANGGoStruct() {}
+
+// NewAddress creates a new entry in the Address list of the
+// Interface_RoutedVlan_Ipv6 struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface_RoutedVlan_Ipv6) NewAddress(Ip string) (*Interface_RoutedVlan_Ipv6_Address, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Address == nil {
+		t.Address = make(map[string]*Interface_RoutedVlan_Ipv6_Address)
+	}
+
+	key := Ip
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Address[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Address", key)
+	}
+
+	t.Address[key] = &Interface_RoutedVlan_Ipv6_Address{
+		Ip: &Ip,
+	}
+
+	return t.Address[key], nil
+}
+
+// GetOrCreateAddressMap returns the list (map) from Interface_RoutedVlan_Ipv6.
+//
+// It initializes the field if not already initialized.
+func (t *Interface_RoutedVlan_Ipv6) GetOrCreateAddressMap() map[string]*Interface_RoutedVlan_Ipv6_Address {
+	if t.Address == nil {
+		t.Address = make(map[string]*Interface_RoutedVlan_Ipv6_Address)
+	}
+	return t.Address
+}
+
+// GetOrCreateAddress retrieves the value with the specified keys from
+// the receiver Interface_RoutedVlan_Ipv6. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Interface_RoutedVlan_Ipv6) GetOrCreateAddress(Ip string) *Interface_RoutedVlan_Ipv6_Address {
+
+	key := Ip
+
+	if v, ok := t.Address[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewAddress(Ip)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateAddress got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetAddress retrieves the value with the specified key from
+// the Address map field of Interface_RoutedVlan_Ipv6. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Interface_RoutedVlan_Ipv6) GetAddress(Ip string) *Interface_RoutedVlan_Ipv6_Address {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Ip
+
+	if lm, ok := t.Address[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteAddress deletes the value with the specified keys from
+// the receiver Interface_RoutedVlan_Ipv6. If there is no such element, the function
+// is a no-op.
+func (t *Interface_RoutedVlan_Ipv6) DeleteAddress(Ip string) {
+	key := Ip
+
+	delete(t.Address, key)
+}
+
+// NewNeighbor creates a new entry in the Neighbor list of the
+// Interface_RoutedVlan_Ipv6 struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface_RoutedVlan_Ipv6) NewNeighbor(Ip string) (*Interface_RoutedVlan_Ipv6_Neighbor, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Neighbor == nil {
+		t.Neighbor = make(map[string]*Interface_RoutedVlan_Ipv6_Neighbor)
+	}
+
+	key := Ip
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Neighbor[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Neighbor", key)
+	}
+
+	t.Neighbor[key] = &Interface_RoutedVlan_Ipv6_Neighbor{
+		Ip: &Ip,
+	}
+
+	return t.Neighbor[key], nil
+}
+
+// GetOrCreateNeighborMap returns the list (map) from Interface_RoutedVlan_Ipv6.
+//
+// It initializes the field if not already initialized.
+func (t *Interface_RoutedVlan_Ipv6) GetOrCreateNeighborMap() map[string]*Interface_RoutedVlan_Ipv6_Neighbor {
+	if t.Neighbor == nil {
+		t.Neighbor = make(map[string]*Interface_RoutedVlan_Ipv6_Neighbor)
+	}
+	return t.Neighbor
+}
+
+// GetOrCreateNeighbor retrieves the value with the specified keys from
+// the receiver Interface_RoutedVlan_Ipv6. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Interface_RoutedVlan_Ipv6) GetOrCreateNeighbor(Ip string) *Interface_RoutedVlan_Ipv6_Neighbor {
+
+	key := Ip
+
+	if v, ok := t.Neighbor[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewNeighbor(Ip)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateNeighbor got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetNeighbor retrieves the value with the specified key from
+// the Neighbor map field of Interface_RoutedVlan_Ipv6. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Interface_RoutedVlan_Ipv6) GetNeighbor(Ip string) *Interface_RoutedVlan_Ipv6_Neighbor {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Ip
+
+	if lm, ok := t.Neighbor[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteNeighbor deletes the value with the specified keys from
+// the receiver Interface_RoutedVlan_Ipv6. If there is no such element, the function
+// is a no-op.
+func (t *Interface_RoutedVlan_Ipv6) DeleteNeighbor(Ip string) {
+	key := Ip
+
+	delete(t.Neighbor, key)
+}
+
+// GetOrCreateCounters retrieves the value of the Counters field
+// or returns the existing field if it already exists.
+func (t *Interface_RoutedVlan_Ipv6) GetOrCreateCounters() *Interface_RoutedVlan_Ipv6_Counters {
+	if t.Counters != nil {
+		return t.Counters
+	}
+	t.Counters = &Interface_RoutedVlan_Ipv6_Counters{}
+	return t.Counters
+}
+
+// GetOrCreateRouterAdvertisement retrieves the value of the RouterAdvertisement field
+// or returns the existing field if it already exists.
+func (t *Interface_RoutedVlan_Ipv6) GetOrCreateRouterAdvertisement() *Interface_RoutedVlan_Ipv6_RouterAdvertisement {
+	if t.RouterAdvertisement != nil {
+		return t.RouterAdvertisement
+	}
+	t.RouterAdvertisement = &Interface_RoutedVlan_Ipv6_RouterAdvertisement{}
+	return t.RouterAdvertisement
+}
+
+// GetOrCreateUnnumbered retrieves the value of the Unnumbered field
+// or returns the existing field if it already exists.
+func (t *Interface_RoutedVlan_Ipv6) GetOrCreateUnnumbered() *Interface_RoutedVlan_Ipv6_Unnumbered {
+	if t.Unnumbered != nil {
+		return t.Unnumbered
+	}
+	t.Unnumbered = &Interface_RoutedVlan_Ipv6_Unnumbered{}
+	return t.Unnumbered
+}
+
+// GetCounters returns the value of the Counters struct pointer
+// from Interface_RoutedVlan_Ipv6. If the receiver or the field Counters is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_RoutedVlan_Ipv6) GetCounters() *Interface_RoutedVlan_Ipv6_Counters {
+	if t != nil && t.Counters != nil {
+		return t.Counters
+	}
+	return nil
+}
+
+// GetRouterAdvertisement returns the value of the RouterAdvertisement struct pointer
+// from Interface_RoutedVlan_Ipv6. If the receiver or the field RouterAdvertisement is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_RoutedVlan_Ipv6) GetRouterAdvertisement() *Interface_RoutedVlan_Ipv6_RouterAdvertisement {
+	if t != nil && t.RouterAdvertisement != nil {
+		return t.RouterAdvertisement
+	}
+	return nil
+}
+
+// GetUnnumbered returns the value of the Unnumbered struct pointer
+// from Interface_RoutedVlan_Ipv6. If the receiver or the field Unnumbered is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_RoutedVlan_Ipv6) GetUnnumbered() *Interface_RoutedVlan_Ipv6_Unnumbered {
+	if t != nil && t.Unnumbered != nil {
+		return t.Unnumbered
+	}
+	return nil
+}
+
+// GetDhcpClient retrieves the value of the leaf DhcpClient from the Interface_RoutedVlan_Ipv6
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DhcpClient is set, it can
+// safely use t.GetDhcpClient() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DhcpClient == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6) GetDhcpClient() bool {
+	if t == nil || t.DhcpClient == nil {
+		return false
+	}
+	return *t.DhcpClient
+}
+
+// GetDupAddrDetectTransmits retrieves the value of the leaf DupAddrDetectTransmits from the Interface_RoutedVlan_Ipv6
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DupAddrDetectTransmits is set, it can
+// safely use t.GetDupAddrDetectTransmits() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DupAddrDetectTransmits == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6) GetDupAddrDetectTransmits() uint32 {
+	if t == nil || t.DupAddrDetectTransmits == nil {
+		return 1
+	}
+	return *t.DupAddrDetectTransmits
+}
+
+// GetEnabled retrieves the value of the leaf Enabled from the Interface_RoutedVlan_Ipv6
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Enabled is set, it can
+// safely use t.GetEnabled() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Enabled == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return true
+	}
+	return *t.Enabled
+}
+
+// GetMtu retrieves the value of the leaf Mtu from the Interface_RoutedVlan_Ipv6
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Mtu is set, it can
+// safely use t.GetMtu() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Mtu == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6) GetMtu() uint32 {
+	if t == nil || t.Mtu == nil {
+		return 0
+	}
+	return *t.Mtu
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv6
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv6) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.DhcpClient == nil {
+		var v bool = false
+		t.DhcpClient = &v
+	}
+	if t.DupAddrDetectTransmits == nil {
+		var v uint32 = 1
+		t.DupAddrDetectTransmits = &v
+	}
+	if t.Enabled == nil {
+		var v bool = true
+		t.Enabled = &v
+	}
+	t.Counters.PopulateDefaults()
+	t.RouterAdvertisement.PopulateDefaults()
+	t.Unnumbered.PopulateDefaults()
+	for _, e := range t.Address {
+		e.PopulateDefaults()
+	}
+	for _, e := range t.Neighbor {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv6.
+func (*Interface_RoutedVlan_Ipv6) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv6_Address represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6/addresses/address YANG schema element.
+type Interface_RoutedVlan_Ipv6_Address struct {
+	Ip           *string                                                `path:"state/ip|ip" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip" shadow-path:"config/ip|ip" shadow-module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
+	Origin       E_OpenconfigIfIp_IpAddressOrigin                       `path:"state/origin" module:"openconfig-if-ip/openconfig-if-ip"`
+	PrefixLength *uint8                                                 `path:"state/prefix-length" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/prefix-length" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Status       E_Address_Status                                       `path:"state/status" module:"openconfig-if-ip/openconfig-if-ip"`
+	Type         E_OpenconfigIfIp_Ipv6AddressType                       `path:"state/type" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/type" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	VrrpGroup    map[uint8]*Interface_RoutedVlan_Ipv6_Address_VrrpGroup `path:"vrrp/vrrp-group" module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6_Address implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv6_Address) IsYANGGoStruct() {}
+
+// NewVrrpGroup creates a new entry in the VrrpGroup list of the
+// Interface_RoutedVlan_Ipv6_Address struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface_RoutedVlan_Ipv6_Address) NewVrrpGroup(VirtualRouterId uint8) (*Interface_RoutedVlan_Ipv6_Address_VrrpGroup, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.VrrpGroup == nil {
+		t.VrrpGroup = make(map[uint8]*Interface_RoutedVlan_Ipv6_Address_VrrpGroup)
+	}
+
+	key := VirtualRouterId
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.VrrpGroup[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list VrrpGroup", key)
+	}
+
+	t.VrrpGroup[key] = &Interface_RoutedVlan_Ipv6_Address_VrrpGroup{
+		VirtualRouterId: &VirtualRouterId,
+	}
+
+	return t.VrrpGroup[key], nil
+}
+
+// GetOrCreateVrrpGroupMap returns the list (map) from Interface_RoutedVlan_Ipv6_Address.
+//
+// It initializes the field if not already initialized.
+func (t *Interface_RoutedVlan_Ipv6_Address) GetOrCreateVrrpGroupMap() map[uint8]*Interface_RoutedVlan_Ipv6_Address_VrrpGroup {
+	if t.VrrpGroup == nil {
+		t.VrrpGroup = make(map[uint8]*Interface_RoutedVlan_Ipv6_Address_VrrpGroup)
+	}
+	return t.VrrpGroup
+}
+
+// GetOrCreateVrrpGroup retrieves the value with the specified keys from
+// the receiver Interface_RoutedVlan_Ipv6_Address. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Interface_RoutedVlan_Ipv6_Address) GetOrCreateVrrpGroup(VirtualRouterId uint8) *Interface_RoutedVlan_Ipv6_Address_VrrpGroup {
+
+	key := VirtualRouterId
+
+	if v, ok := t.VrrpGroup[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewVrrpGroup(VirtualRouterId)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateVrrpGroup got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetVrrpGroup retrieves the value with the specified key from
+// the VrrpGroup map field of Interface_RoutedVlan_Ipv6_Address. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Interface_RoutedVlan_Ipv6_Address) GetVrrpGroup(VirtualRouterId uint8) *Interface_RoutedVlan_Ipv6_Address_VrrpGroup {
+
+	if t == nil {
+		return nil
+	}
+
+	key := VirtualRouterId
+
+	if lm, ok := t.VrrpGroup[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteVrrpGroup deletes the value with the specified keys from
+// the receiver Interface_RoutedVlan_Ipv6_Address. If there is no such element, the function
+// is a no-op.
+func (t *Interface_RoutedVlan_Ipv6_Address) DeleteVrrpGroup(VirtualRouterId uint8) {
+	key := VirtualRouterId
+
+	delete(t.VrrpGroup, key)
+}
+
+// GetIp retrieves the value of the leaf Ip from the Interface_RoutedVlan_Ipv6_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Ip is set, it can
+// safely use t.GetIp() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Ip == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Address) GetIp() string {
+	if t == nil || t.Ip == nil {
+		return ""
+	}
+	return *t.Ip
+}
+
+// GetOrigin retrieves the value of the leaf Origin from the Interface_RoutedVlan_Ipv6_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Origin is set, it can
+// safely use t.GetOrigin() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Origin == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Address) GetOrigin() E_OpenconfigIfIp_IpAddressOrigin {
+	if t == nil || t.Origin == 0 {
+		return 0
+	}
+	return t.Origin
+}
+
+// GetPrefixLength retrieves the value of the leaf PrefixLength from the Interface_RoutedVlan_Ipv6_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PrefixLength is set, it can
+// safely use t.GetPrefixLength() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PrefixLength == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Address) GetPrefixLength() uint8 {
+	if t == nil || t.PrefixLength == nil {
+		return 0
+	}
+	return *t.PrefixLength
+}
+
+// GetStatus retrieves the value of the leaf Status from the Interface_RoutedVlan_Ipv6_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Status is set, it can
+// safely use t.GetStatus() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Status == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Address) GetStatus() E_Address_Status {
+	if t == nil || t.Status == 0 {
+		return 0
+	}
+	return t.Status
+}
+
+// GetType retrieves the value of the leaf Type from the Interface_RoutedVlan_Ipv6_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Type is set, it can
+// safely use t.GetType() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Type == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Address) GetType() E_OpenconfigIfIp_Ipv6AddressType {
+	if t == nil || t.Type == 0 {
+		return OpenconfigIfIp_Ipv6AddressType_GLOBAL_UNICAST
+	}
+	return t.Type
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv6_Address
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv6_Address) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Type == 0 {
+		t.Type = OpenconfigIfIp_Ipv6AddressType_GLOBAL_UNICAST
+	}
+	for _, e := range t.VrrpGroup {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛListKeyMap returns the keys of the Interface_RoutedVlan_Ipv6_Address struct, which is a YANG list entry.
+func (t *Interface_RoutedVlan_Ipv6_Address) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Ip == nil {
+		return nil, fmt.Errorf("nil value for key Ip")
+	}
+
+	return map[string]interface{}{
+		"ip": *t.Ip,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv6_Address.
+func (*Interface_RoutedVlan_Ipv6_Address) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv6_Address_VrrpGroup represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6/addresses/address/vrrp/vrrp-group YANG schema element.
+type Interface_RoutedVlan_Ipv6_Address_VrrpGroup struct {
+	AcceptMode            *bool                                                          `path:"state/accept-mode" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/accept-mode" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	AdvertisementInterval *uint16                                                        `path:"state/advertisement-interval" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/advertisement-interval" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	CurrentPriority       *uint8                                                         `path:"state/current-priority" module:"openconfig-if-ip/openconfig-if-ip"`
+	InterfaceTracking     *Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking `path:"interface-tracking" module:"openconfig-if-ip"`
+	Preempt               *bool                                                          `path:"state/preempt" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/preempt" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	PreemptDelay          *uint16                                                        `path:"state/preempt-delay" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/preempt-delay" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Priority              *uint8                                                         `path:"state/priority" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/priority" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	VirtualAddress        []string                                                       `path:"state/virtual-address" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/virtual-address" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	VirtualLinkLocal      *string                                                        `path:"state/virtual-link-local" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/virtual-link-local" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	VirtualRouterId       *uint8                                                         `path:"state/virtual-router-id|virtual-router-id" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip" shadow-path:"config/virtual-router-id|virtual-router-id" shadow-module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6_Address_VrrpGroup implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv6_Address_VrrpGroup) IsYANGGoStruct() {}
+
+// GetOrCreateInterfaceTracking retrieves the value of the InterfaceTracking field
+// or returns the existing field if it already exists.
+func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) GetOrCreateInterfaceTracking() *Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking {
+	if t.InterfaceTracking != nil {
+		return t.InterfaceTracking
+	}
+	t.InterfaceTracking = &Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking{}
+	return t.InterfaceTracking
+}
+
+// GetInterfaceTracking returns the value of the InterfaceTracking struct pointer
+// from Interface_RoutedVlan_Ipv6_Address_VrrpGroup. If the receiver or the field InterfaceTracking is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) GetInterfaceTracking() *Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking {
+	if t != nil && t.InterfaceTracking != nil {
+		return t.InterfaceTracking
+	}
+	return nil
+}
+
+// GetAcceptMode retrieves the value of the leaf AcceptMode from the Interface_RoutedVlan_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AcceptMode is set, it can
+// safely use t.GetAcceptMode() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AcceptMode == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) GetAcceptMode() bool {
+	if t == nil || t.AcceptMode == nil {
+		return false
+	}
+	return *t.AcceptMode
+}
+
+// GetAdvertisementInterval retrieves the value of the leaf AdvertisementInterval from the Interface_RoutedVlan_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AdvertisementInterval is set, it can
+// safely use t.GetAdvertisementInterval() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AdvertisementInterval == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) GetAdvertisementInterval() uint16 {
+	if t == nil || t.AdvertisementInterval == nil {
+		return 100
+	}
+	return *t.AdvertisementInterval
+}
+
+// GetCurrentPriority retrieves the value of the leaf CurrentPriority from the Interface_RoutedVlan_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if CurrentPriority is set, it can
+// safely use t.GetCurrentPriority() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.CurrentPriority == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) GetCurrentPriority() uint8 {
+	if t == nil || t.CurrentPriority == nil {
+		return 0
+	}
+	return *t.CurrentPriority
+}
+
+// GetPreempt retrieves the value of the leaf Preempt from the Interface_RoutedVlan_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Preempt is set, it can
+// safely use t.GetPreempt() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Preempt == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) GetPreempt() bool {
+	if t == nil || t.Preempt == nil {
+		return true
+	}
+	return *t.Preempt
+}
+
+// GetPreemptDelay retrieves the value of the leaf PreemptDelay from the Interface_RoutedVlan_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PreemptDelay is set, it can
+// safely use t.GetPreemptDelay() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PreemptDelay == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) GetPreemptDelay() uint16 {
+	if t == nil || t.PreemptDelay == nil {
+		return 0
+	}
+	return *t.PreemptDelay
+}
+
+// GetPriority retrieves the value of the leaf Priority from the Interface_RoutedVlan_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Priority is set, it can
+// safely use t.GetPriority() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Priority == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) GetPriority() uint8 {
+	if t == nil || t.Priority == nil {
+		return 100
+	}
+	return *t.Priority
+}
+
+// GetVirtualAddress retrieves the value of the leaf VirtualAddress from the Interface_RoutedVlan_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VirtualAddress is set, it can
+// safely use t.GetVirtualAddress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VirtualAddress == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) GetVirtualAddress() []string {
+	if t == nil || t.VirtualAddress == nil {
+		return nil
+	}
+	return t.VirtualAddress
+}
+
+// GetVirtualLinkLocal retrieves the value of the leaf VirtualLinkLocal from the Interface_RoutedVlan_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VirtualLinkLocal is set, it can
+// safely use t.GetVirtualLinkLocal() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VirtualLinkLocal == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) GetVirtualLinkLocal() string {
+	if t == nil || t.VirtualLinkLocal == nil {
+		return ""
+	}
+	return *t.VirtualLinkLocal
+}
+
+// GetVirtualRouterId retrieves the value of the leaf VirtualRouterId from the Interface_RoutedVlan_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VirtualRouterId is set, it can
+// safely use t.GetVirtualRouterId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VirtualRouterId == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) GetVirtualRouterId() uint8 {
+	if t == nil || t.VirtualRouterId == nil {
+		return 0
+	}
+	return *t.VirtualRouterId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv6_Address_VrrpGroup
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.AcceptMode == nil {
+		var v bool = false
+		t.AcceptMode = &v
+	}
+	if t.AdvertisementInterval == nil {
+		var v uint16 = 100
+		t.AdvertisementInterval = &v
+	}
+	if t.Preempt == nil {
+		var v bool = true
+		t.Preempt = &v
+	}
+	if t.PreemptDelay == nil {
+		var v uint16 = 0
+		t.PreemptDelay = &v
+	}
+	if t.Priority == nil {
+		var v uint8 = 100
+		t.Priority = &v
+	}
+	t.InterfaceTracking.PopulateDefaults()
+}
+
+// ΛListKeyMap returns the keys of the Interface_RoutedVlan_Ipv6_Address_VrrpGroup struct, which is a YANG list entry.
+func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.VirtualRouterId == nil {
+		return nil, fmt.Errorf("nil value for key VirtualRouterId")
+	}
+
+	return map[string]interface{}{
+		"virtual-router-id": *t.VirtualRouterId,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv6_Address_VrrpGroup.
+func (*Interface_RoutedVlan_Ipv6_Address_VrrpGroup) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6/addresses/address/vrrp/vrrp-group/interface-tracking YANG schema element.
+type Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking struct {
+	PriorityDecrement *uint8   `path:"state/priority-decrement" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/priority-decrement" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	TrackInterface    []string `path:"state/track-interface" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/track-interface" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking) IsYANGGoStruct() {}
+
+// GetPriorityDecrement retrieves the value of the leaf PriorityDecrement from the Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PriorityDecrement is set, it can
+// safely use t.GetPriorityDecrement() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PriorityDecrement == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking) GetPriorityDecrement() uint8 {
+	if t == nil || t.PriorityDecrement == nil {
+		return 0
+	}
+	return *t.PriorityDecrement
+}
+
+// GetTrackInterface retrieves the value of the leaf TrackInterface from the Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if TrackInterface is set, it can
+// safely use t.GetTrackInterface() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.TrackInterface == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking) GetTrackInterface() []string {
+	if t == nil || t.TrackInterface == nil {
+		return nil
+	}
+	return t.TrackInterface
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.PriorityDecrement == nil {
+		var v uint8 = 0
+		t.PriorityDecrement = &v
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking.
+func (*Interface_RoutedVlan_Ipv6_Address_VrrpGroup_InterfaceTracking) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv6_Counters represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6/state/counters YANG schema element.
+type Interface_RoutedVlan_Ipv6_Counters struct {
+	InDiscardedPkts    *uint64 `path:"in-discarded-pkts" module:"openconfig-if-ip"`
+	InErrorPkts        *uint64 `path:"in-error-pkts" module:"openconfig-if-ip"`
+	InForwardedOctets  *uint64 `path:"in-forwarded-octets" module:"openconfig-if-ip"`
+	InForwardedPkts    *uint64 `path:"in-forwarded-pkts" module:"openconfig-if-ip"`
+	InMulticastOctets  *uint64 `path:"in-multicast-octets" module:"openconfig-if-ip"`
+	InMulticastPkts    *uint64 `path:"in-multicast-pkts" module:"openconfig-if-ip"`
+	InOctets           *uint64 `path:"in-octets" module:"openconfig-if-ip"`
+	InPkts             *uint64 `path:"in-pkts" module:"openconfig-if-ip"`
+	OutDiscardedPkts   *uint64 `path:"out-discarded-pkts" module:"openconfig-if-ip"`
+	OutErrorPkts       *uint64 `path:"out-error-pkts" module:"openconfig-if-ip"`
+	OutForwardedOctets *uint64 `path:"out-forwarded-octets" module:"openconfig-if-ip"`
+	OutForwardedPkts   *uint64 `path:"out-forwarded-pkts" module:"openconfig-if-ip"`
+	OutMulticastOctets *uint64 `path:"out-multicast-octets" module:"openconfig-if-ip"`
+	OutMulticastPkts   *uint64 `path:"out-multicast-pkts" module:"openconfig-if-ip"`
+	OutOctets          *uint64 `path:"out-octets" module:"openconfig-if-ip"`
+	OutPkts            *uint64 `path:"out-pkts" module:"openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv6_Counters) IsYANGGoStruct() {}
+
+// GetInDiscardedPkts retrieves the value of the leaf InDiscardedPkts from the Interface_RoutedVlan_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InDiscardedPkts is set, it can
+// safely use t.GetInDiscardedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InDiscardedPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Counters) GetInDiscardedPkts() uint64 {
+	if t == nil || t.InDiscardedPkts == nil {
+		return 0
+	}
+	return *t.InDiscardedPkts
+}
+
+// GetInErrorPkts retrieves the value of the leaf InErrorPkts from the Interface_RoutedVlan_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InErrorPkts is set, it can
+// safely use t.GetInErrorPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InErrorPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Counters) GetInErrorPkts() uint64 {
+	if t == nil || t.InErrorPkts == nil {
+		return 0
+	}
+	return *t.InErrorPkts
+}
+
+// GetInForwardedOctets retrieves the value of the leaf InForwardedOctets from the Interface_RoutedVlan_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InForwardedOctets is set, it can
+// safely use t.GetInForwardedOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InForwardedOctets == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Counters) GetInForwardedOctets() uint64 {
+	if t == nil || t.InForwardedOctets == nil {
+		return 0
+	}
+	return *t.InForwardedOctets
+}
+
+// GetInForwardedPkts retrieves the value of the leaf InForwardedPkts from the Interface_RoutedVlan_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InForwardedPkts is set, it can
+// safely use t.GetInForwardedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InForwardedPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Counters) GetInForwardedPkts() uint64 {
+	if t == nil || t.InForwardedPkts == nil {
+		return 0
+	}
+	return *t.InForwardedPkts
+}
+
+// GetInMulticastOctets retrieves the value of the leaf InMulticastOctets from the Interface_RoutedVlan_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InMulticastOctets is set, it can
+// safely use t.GetInMulticastOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InMulticastOctets == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Counters) GetInMulticastOctets() uint64 {
+	if t == nil || t.InMulticastOctets == nil {
+		return 0
+	}
+	return *t.InMulticastOctets
+}
+
+// GetInMulticastPkts retrieves the value of the leaf InMulticastPkts from the Interface_RoutedVlan_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InMulticastPkts is set, it can
+// safely use t.GetInMulticastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InMulticastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Counters) GetInMulticastPkts() uint64 {
+	if t == nil || t.InMulticastPkts == nil {
+		return 0
+	}
+	return *t.InMulticastPkts
+}
+
+// GetInOctets retrieves the value of the leaf InOctets from the Interface_RoutedVlan_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InOctets is set, it can
+// safely use t.GetInOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InOctets == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Counters) GetInOctets() uint64 {
+	if t == nil || t.InOctets == nil {
+		return 0
+	}
+	return *t.InOctets
+}
+
+// GetInPkts retrieves the value of the leaf InPkts from the Interface_RoutedVlan_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InPkts is set, it can
+// safely use t.GetInPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Counters) GetInPkts() uint64 {
+	if t == nil || t.InPkts == nil {
+		return 0
+	}
+	return *t.InPkts
+}
+
+// GetOutDiscardedPkts retrieves the value of the leaf OutDiscardedPkts from the Interface_RoutedVlan_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutDiscardedPkts is set, it can
+// safely use t.GetOutDiscardedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutDiscardedPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Counters) GetOutDiscardedPkts() uint64 {
+	if t == nil || t.OutDiscardedPkts == nil {
+		return 0
+	}
+	return *t.OutDiscardedPkts
+}
+
+// GetOutErrorPkts retrieves the value of the leaf OutErrorPkts from the Interface_RoutedVlan_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutErrorPkts is set, it can
+// safely use t.GetOutErrorPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutErrorPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Counters) GetOutErrorPkts() uint64 {
+	if t == nil || t.OutErrorPkts == nil {
+		return 0
+	}
+	return *t.OutErrorPkts
+}
+
+// GetOutForwardedOctets retrieves the value of the leaf OutForwardedOctets from the Interface_RoutedVlan_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutForwardedOctets is set, it can
+// safely use t.GetOutForwardedOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutForwardedOctets == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Counters) GetOutForwardedOctets() uint64 {
+	if t == nil || t.OutForwardedOctets == nil {
+		return 0
+	}
+	return *t.OutForwardedOctets
+}
+
+// GetOutForwardedPkts retrieves the value of the leaf OutForwardedPkts from the Interface_RoutedVlan_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutForwardedPkts is set, it can
+// safely use t.GetOutForwardedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutForwardedPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Counters) GetOutForwardedPkts() uint64 {
+	if t == nil || t.OutForwardedPkts == nil {
+		return 0
+	}
+	return *t.OutForwardedPkts
+}
+
+// GetOutMulticastOctets retrieves the value of the leaf OutMulticastOctets from the Interface_RoutedVlan_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutMulticastOctets is set, it can
+// safely use t.GetOutMulticastOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutMulticastOctets == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Counters) GetOutMulticastOctets() uint64 {
+	if t == nil || t.OutMulticastOctets == nil {
+		return 0
+	}
+	return *t.OutMulticastOctets
+}
+
+// GetOutMulticastPkts retrieves the value of the leaf OutMulticastPkts from the Interface_RoutedVlan_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutMulticastPkts is set, it can
+// safely use t.GetOutMulticastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutMulticastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Counters) GetOutMulticastPkts() uint64 {
+	if t == nil || t.OutMulticastPkts == nil {
+		return 0
+	}
+	return *t.OutMulticastPkts
+}
+
+// GetOutOctets retrieves the value of the leaf OutOctets from the Interface_RoutedVlan_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutOctets is set, it can
+// safely use t.GetOutOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutOctets == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Counters) GetOutOctets() uint64 {
+	if t == nil || t.OutOctets == nil {
+		return 0
+	}
+	return *t.OutOctets
+}
+
+// GetOutPkts retrieves the value of the leaf OutPkts from the Interface_RoutedVlan_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutPkts is set, it can
+// safely use t.GetOutPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutPkts == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Counters) GetOutPkts() uint64 {
+	if t == nil || t.OutPkts == nil {
+		return 0
+	}
+	return *t.OutPkts
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv6_Counters
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv6_Counters) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv6_Counters.
+func (*Interface_RoutedVlan_Ipv6_Counters) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv6_Neighbor represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6/neighbors/neighbor YANG schema element.
+type Interface_RoutedVlan_Ipv6_Neighbor struct {
+	Ip               *string                         `path:"state/ip|ip" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip" shadow-path:"config/ip|ip" shadow-module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
+	IsRouter         *bool                           `path:"state/is-router" module:"openconfig-if-ip/openconfig-if-ip"`
+	LinkLayerAddress *string                         `path:"state/link-layer-address" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/link-layer-address" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	NeighborState    E_Neighbor_NeighborState        `path:"state/neighbor-state" module:"openconfig-if-ip/openconfig-if-ip"`
+	Origin           E_OpenconfigIfIp_NeighborOrigin `path:"state/origin" module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6_Neighbor implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv6_Neighbor) IsYANGGoStruct() {}
+
+// GetIp retrieves the value of the leaf Ip from the Interface_RoutedVlan_Ipv6_Neighbor
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Ip is set, it can
+// safely use t.GetIp() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Ip == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Neighbor) GetIp() string {
+	if t == nil || t.Ip == nil {
+		return ""
+	}
+	return *t.Ip
+}
+
+// GetIsRouter retrieves the value of the leaf IsRouter from the Interface_RoutedVlan_Ipv6_Neighbor
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if IsRouter is set, it can
+// safely use t.GetIsRouter() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.IsRouter == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Neighbor) GetIsRouter() bool {
+	if t == nil || t.IsRouter == nil {
+		return false
+	}
+	return *t.IsRouter
+}
+
+// GetLinkLayerAddress retrieves the value of the leaf LinkLayerAddress from the Interface_RoutedVlan_Ipv6_Neighbor
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if LinkLayerAddress is set, it can
+// safely use t.GetLinkLayerAddress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.LinkLayerAddress == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Neighbor) GetLinkLayerAddress() string {
+	if t == nil || t.LinkLayerAddress == nil {
+		return ""
+	}
+	return *t.LinkLayerAddress
+}
+
+// GetNeighborState retrieves the value of the leaf NeighborState from the Interface_RoutedVlan_Ipv6_Neighbor
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if NeighborState is set, it can
+// safely use t.GetNeighborState() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.NeighborState == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Neighbor) GetNeighborState() E_Neighbor_NeighborState {
+	if t == nil || t.NeighborState == 0 {
+		return 0
+	}
+	return t.NeighborState
+}
+
+// GetOrigin retrieves the value of the leaf Origin from the Interface_RoutedVlan_Ipv6_Neighbor
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Origin is set, it can
+// safely use t.GetOrigin() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Origin == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Neighbor) GetOrigin() E_OpenconfigIfIp_NeighborOrigin {
+	if t == nil || t.Origin == 0 {
+		return 0
+	}
+	return t.Origin
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv6_Neighbor
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv6_Neighbor) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛListKeyMap returns the keys of the Interface_RoutedVlan_Ipv6_Neighbor struct, which is a YANG list entry.
+func (t *Interface_RoutedVlan_Ipv6_Neighbor) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Ip == nil {
+		return nil, fmt.Errorf("nil value for key Ip")
+	}
+
+	return map[string]interface{}{
+		"ip": *t.Ip,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv6_Neighbor.
+func (*Interface_RoutedVlan_Ipv6_Neighbor) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv6_RouterAdvertisement represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6/router-advertisement YANG schema element.
+type Interface_RoutedVlan_Ipv6_RouterAdvertisement struct {
+	Enable      *bool                                                            `path:"state/enable" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/enable" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Interval    *uint32                                                          `path:"state/interval" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/interval" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Lifetime    *uint32                                                          `path:"state/lifetime" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/lifetime" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Managed     *bool                                                            `path:"state/managed" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/managed" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Mode        E_RouterAdvertisement_Mode                                       `path:"state/mode" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/mode" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	OtherConfig *bool                                                            `path:"state/other-config" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/other-config" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Prefix      map[string]*Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix `path:"prefixes/prefix" module:"openconfig-if-ip/openconfig-if-ip"`
+	Suppress    *bool                                                            `path:"state/suppress" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/suppress" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6_RouterAdvertisement implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv6_RouterAdvertisement) IsYANGGoStruct() {}
+
+// NewPrefix creates a new entry in the Prefix list of the
+// Interface_RoutedVlan_Ipv6_RouterAdvertisement struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement) NewPrefix(Prefix string) (*Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Prefix == nil {
+		t.Prefix = make(map[string]*Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix)
+	}
+
+	key := Prefix
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Prefix[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Prefix", key)
+	}
+
+	t.Prefix[key] = &Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix{
+		Prefix: &Prefix,
+	}
+
+	return t.Prefix[key], nil
+}
+
+// GetOrCreatePrefixMap returns the list (map) from Interface_RoutedVlan_Ipv6_RouterAdvertisement.
+//
+// It initializes the field if not already initialized.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement) GetOrCreatePrefixMap() map[string]*Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix {
+	if t.Prefix == nil {
+		t.Prefix = make(map[string]*Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix)
+	}
+	return t.Prefix
+}
+
+// GetOrCreatePrefix retrieves the value with the specified keys from
+// the receiver Interface_RoutedVlan_Ipv6_RouterAdvertisement. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement) GetOrCreatePrefix(Prefix string) *Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix {
+
+	key := Prefix
+
+	if v, ok := t.Prefix[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewPrefix(Prefix)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreatePrefix got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetPrefix retrieves the value with the specified key from
+// the Prefix map field of Interface_RoutedVlan_Ipv6_RouterAdvertisement. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement) GetPrefix(Prefix string) *Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Prefix
+
+	if lm, ok := t.Prefix[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeletePrefix deletes the value with the specified keys from
+// the receiver Interface_RoutedVlan_Ipv6_RouterAdvertisement. If there is no such element, the function
+// is a no-op.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement) DeletePrefix(Prefix string) {
+	key := Prefix
+
+	delete(t.Prefix, key)
+}
+
+// GetEnable retrieves the value of the leaf Enable from the Interface_RoutedVlan_Ipv6_RouterAdvertisement
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Enable is set, it can
+// safely use t.GetEnable() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Enable == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement) GetEnable() bool {
+	if t == nil || t.Enable == nil {
+		return true
+	}
+	return *t.Enable
+}
+
+// GetInterval retrieves the value of the leaf Interval from the Interface_RoutedVlan_Ipv6_RouterAdvertisement
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Interval is set, it can
+// safely use t.GetInterval() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Interval == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement) GetInterval() uint32 {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetLifetime retrieves the value of the leaf Lifetime from the Interface_RoutedVlan_Ipv6_RouterAdvertisement
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Lifetime is set, it can
+// safely use t.GetLifetime() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Lifetime == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement) GetLifetime() uint32 {
+	if t == nil || t.Lifetime == nil {
+		return 0
+	}
+	return *t.Lifetime
+}
+
+// GetManaged retrieves the value of the leaf Managed from the Interface_RoutedVlan_Ipv6_RouterAdvertisement
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Managed is set, it can
+// safely use t.GetManaged() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Managed == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement) GetManaged() bool {
+	if t == nil || t.Managed == nil {
+		return false
+	}
+	return *t.Managed
+}
+
+// GetMode retrieves the value of the leaf Mode from the Interface_RoutedVlan_Ipv6_RouterAdvertisement
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Mode is set, it can
+// safely use t.GetMode() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Mode == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement) GetMode() E_RouterAdvertisement_Mode {
+	if t == nil || t.Mode == 0 {
+		return RouterAdvertisement_Mode_ALL
+	}
+	return t.Mode
+}
+
+// GetOtherConfig retrieves the value of the leaf OtherConfig from the Interface_RoutedVlan_Ipv6_RouterAdvertisement
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OtherConfig is set, it can
+// safely use t.GetOtherConfig() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OtherConfig == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement) GetOtherConfig() bool {
+	if t == nil || t.OtherConfig == nil {
+		return false
+	}
+	return *t.OtherConfig
+}
+
+// GetSuppress retrieves the value of the leaf Suppress from the Interface_RoutedVlan_Ipv6_RouterAdvertisement
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Suppress is set, it can
+// safely use t.GetSuppress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Suppress == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement) GetSuppress() bool {
+	if t == nil || t.Suppress == nil {
+		return false
+	}
+	return *t.Suppress
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv6_RouterAdvertisement
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Enable == nil {
+		var v bool = true
+		t.Enable = &v
+	}
+	if t.Managed == nil {
+		var v bool = false
+		t.Managed = &v
+	}
+	if t.Mode == 0 {
+		t.Mode = RouterAdvertisement_Mode_ALL
+	}
+	if t.OtherConfig == nil {
+		var v bool = false
+		t.OtherConfig = &v
+	}
+	if t.Suppress == nil {
+		var v bool = false
+		t.Suppress = &v
+	}
+	for _, e := range t.Prefix {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv6_RouterAdvertisement.
+func (*Interface_RoutedVlan_Ipv6_RouterAdvertisement) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6/router-advertisement/prefixes/prefix YANG schema element.
+type Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix struct {
+	DisableAdvertisement     *bool   `path:"state/disable-advertisement" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/disable-advertisement" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	DisableAutoconfiguration *bool   `path:"state/disable-autoconfiguration" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/disable-autoconfiguration" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	EnableOnlink             *bool   `path:"state/enable-onlink" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/enable-onlink" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	PreferredLifetime        *uint32 `path:"state/preferred-lifetime" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/preferred-lifetime" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Prefix                   *string `path:"state/prefix|prefix" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip" shadow-path:"config/prefix|prefix" shadow-module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
+	ValidLifetime            *uint32 `path:"state/valid-lifetime" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/valid-lifetime" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix) IsYANGGoStruct() {}
+
+// GetDisableAdvertisement retrieves the value of the leaf DisableAdvertisement from the Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DisableAdvertisement is set, it can
+// safely use t.GetDisableAdvertisement() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DisableAdvertisement == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix) GetDisableAdvertisement() bool {
+	if t == nil || t.DisableAdvertisement == nil {
+		return false
+	}
+	return *t.DisableAdvertisement
+}
+
+// GetDisableAutoconfiguration retrieves the value of the leaf DisableAutoconfiguration from the Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DisableAutoconfiguration is set, it can
+// safely use t.GetDisableAutoconfiguration() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DisableAutoconfiguration == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix) GetDisableAutoconfiguration() bool {
+	if t == nil || t.DisableAutoconfiguration == nil {
+		return false
+	}
+	return *t.DisableAutoconfiguration
+}
+
+// GetEnableOnlink retrieves the value of the leaf EnableOnlink from the Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if EnableOnlink is set, it can
+// safely use t.GetEnableOnlink() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.EnableOnlink == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix) GetEnableOnlink() bool {
+	if t == nil || t.EnableOnlink == nil {
+		return false
+	}
+	return *t.EnableOnlink
+}
+
+// GetPreferredLifetime retrieves the value of the leaf PreferredLifetime from the Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PreferredLifetime is set, it can
+// safely use t.GetPreferredLifetime() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PreferredLifetime == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix) GetPreferredLifetime() uint32 {
+	if t == nil || t.PreferredLifetime == nil {
+		return 0
+	}
+	return *t.PreferredLifetime
+}
+
+// GetPrefix retrieves the value of the leaf Prefix from the Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Prefix is set, it can
+// safely use t.GetPrefix() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Prefix == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix) GetPrefix() string {
+	if t == nil || t.Prefix == nil {
+		return ""
+	}
+	return *t.Prefix
+}
+
+// GetValidLifetime retrieves the value of the leaf ValidLifetime from the Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if ValidLifetime is set, it can
+// safely use t.GetValidLifetime() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.ValidLifetime == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix) GetValidLifetime() uint32 {
+	if t == nil || t.ValidLifetime == nil {
+		return 0
+	}
+	return *t.ValidLifetime
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛListKeyMap returns the keys of the Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix struct, which is a YANG list entry.
+func (t *Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Prefix == nil {
+		return nil, fmt.Errorf("nil value for key Prefix")
+	}
+
+	return map[string]interface{}{
+		"prefix": *t.Prefix,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix.
+func (*Interface_RoutedVlan_Ipv6_RouterAdvertisement_Prefix) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv6_Unnumbered represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6/unnumbered YANG schema element.
+type Interface_RoutedVlan_Ipv6_Unnumbered struct {
+	Enabled      *bool                                              `path:"state/enabled" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/enabled" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	InterfaceRef *Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef `path:"interface-ref" module:"openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6_Unnumbered implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv6_Unnumbered) IsYANGGoStruct() {}
+
+// GetOrCreateInterfaceRef retrieves the value of the InterfaceRef field
+// or returns the existing field if it already exists.
+func (t *Interface_RoutedVlan_Ipv6_Unnumbered) GetOrCreateInterfaceRef() *Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef {
+	if t.InterfaceRef != nil {
+		return t.InterfaceRef
+	}
+	t.InterfaceRef = &Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef{}
+	return t.InterfaceRef
+}
+
+// GetInterfaceRef returns the value of the InterfaceRef struct pointer
+// from Interface_RoutedVlan_Ipv6_Unnumbered. If the receiver or the field InterfaceRef is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_RoutedVlan_Ipv6_Unnumbered) GetInterfaceRef() *Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef {
+	if t != nil && t.InterfaceRef != nil {
+		return t.InterfaceRef
+	}
+	return nil
+}
+
+// GetEnabled retrieves the value of the leaf Enabled from the Interface_RoutedVlan_Ipv6_Unnumbered
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Enabled is set, it can
+// safely use t.GetEnabled() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Enabled == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Unnumbered) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv6_Unnumbered
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv6_Unnumbered) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Enabled == nil {
+		var v bool = false
+		t.Enabled = &v
+	}
+	t.InterfaceRef.PopulateDefaults()
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv6_Unnumbered.
+func (*Interface_RoutedVlan_Ipv6_Unnumbered) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef represents the /openconfig-interfaces/interfaces/interface/routed-vlan/ipv6/unnumbered/interface-ref YANG schema element.
+type Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef struct {
+	Interface    *string `path:"state/interface" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/interface" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Subinterface *uint32 `path:"state/subinterface" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/subinterface" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef) IsYANGGoStruct() {}
+
+// GetInterface retrieves the value of the leaf Interface from the Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Interface is set, it can
+// safely use t.GetInterface() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Interface == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef) GetInterface() string {
+	if t == nil || t.Interface == nil {
+		return ""
+	}
+	return *t.Interface
+}
+
+// GetSubinterface retrieves the value of the leaf Subinterface from the Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Subinterface is set, it can
+// safely use t.GetSubinterface() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Subinterface == nil' before retrieving the leaf's value.
+func (t *Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef) GetSubinterface() uint32 {
+	if t == nil || t.Subinterface == nil {
+		return 0
+	}
+	return *t.Subinterface
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef.
+func (*Interface_RoutedVlan_Ipv6_Unnumbered_InterfaceRef) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface YANG schema element.
+type Interface_Subinterface struct {
+	AdminStatus E_Interface_AdminStatus          `path:"state/admin-status" module:"openconfig-interfaces/openconfig-interfaces"`
+	Counters    *Interface_Subinterface_Counters `path:"state/counters" module:"openconfig-interfaces/openconfig-interfaces"`
+	Cpu         *bool                            `path:"state/cpu" module:"openconfig-interfaces/openconfig-interfaces"`
+	Description *string                          `path:"state/description" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/description" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
+	Enabled     *bool                            `path:"state/enabled" module:"openconfig-interfaces/openconfig-interfaces" shadow-path:"config/enabled" shadow-module:"openconfig-interfaces/openconfig-interfaces"`
+	Ifindex     *uint32                          `path:"state/ifindex" module:"openconfig-interfaces/openconfig-interfaces"`
+	Index       *uint32                          `path:"state/index|index" module:"openconfig-interfaces/openconfig-interfaces|openconfig-interfaces" shadow-path:"config/index|index" shadow-module:"openconfig-interfaces/openconfig-interfaces|openconfig-interfaces"`
+	Ipv4        *Interface_Subinterface_Ipv4     `path:"ipv4" module:"openconfig-if-ip"`
+	Ipv6        *Interface_Subinterface_Ipv6     `path:"ipv6" module:"openconfig-if-ip"`
+	LastChange  *uint64                          `path:"state/last-change" module:"openconfig-interfaces/openconfig-interfaces"`
+	Logical     *bool                            `path:"state/logical" module:"openconfig-interfaces/openconfig-interfaces"`
+	Management  *bool                            `path:"state/management" module:"openconfig-interfaces/openconfig-interfaces"`
+	Name        *string                          `path:"state/name" module:"openconfig-interfaces/openconfig-interfaces"`
+	OperStatus  E_Interface_OperStatus           `path:"state/oper-status" module:"openconfig-interfaces/openconfig-interfaces"`
+	Vlan        *Interface_Subinterface_Vlan     `path:"vlan" module:"openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface) IsYANGGoStruct() {}
+
+// GetOrCreateCounters retrieves the value of the Counters field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface) GetOrCreateCounters() *Interface_Subinterface_Counters {
+	if t.Counters != nil {
+		return t.Counters
+	}
+	t.Counters = &Interface_Subinterface_Counters{}
+	return t.Counters
+}
+
+// GetOrCreateIpv4 retrieves the value of the Ipv4 field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface) GetOrCreateIpv4() *Interface_Subinterface_Ipv4 {
+	if t.Ipv4 != nil {
+		return t.Ipv4
+	}
+	t.Ipv4 = &Interface_Subinterface_Ipv4{}
+	return t.Ipv4
+}
+
+// GetOrCreateIpv6 retrieves the value of the Ipv6 field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface) GetOrCreateIpv6() *Interface_Subinterface_Ipv6 {
+	if t.Ipv6 != nil {
+		return t.Ipv6
+	}
+	t.Ipv6 = &Interface_Subinterface_Ipv6{}
+	return t.Ipv6
+}
+
+// GetOrCreateVlan retrieves the value of the Vlan field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface) GetOrCreateVlan() *Interface_Subinterface_Vlan {
+	if t.Vlan != nil {
+		return t.Vlan
+	}
+	t.Vlan = &Interface_Subinterface_Vlan{}
+	return t.Vlan
+}
+
+// GetCounters returns the value of the Counters struct pointer
+// from Interface_Subinterface. If the receiver or the field Counters is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface) GetCounters() *Interface_Subinterface_Counters {
+	if t != nil && t.Counters != nil {
+		return t.Counters
+	}
+	return nil
+}
+
+// GetIpv4 returns the value of the Ipv4 struct pointer
+// from Interface_Subinterface. If the receiver or the field Ipv4 is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface) GetIpv4() *Interface_Subinterface_Ipv4 {
+	if t != nil && t.Ipv4 != nil {
+		return t.Ipv4
+	}
+	return nil
+}
+
+// GetIpv6 returns the value of the Ipv6 struct pointer
+// from Interface_Subinterface. If the receiver or the field Ipv6 is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface) GetIpv6() *Interface_Subinterface_Ipv6 {
+	if t != nil && t.Ipv6 != nil {
+		return t.Ipv6
+	}
+	return nil
+}
+
+// GetVlan returns the value of the Vlan struct pointer
+// from Interface_Subinterface. If the receiver or the field Vlan is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface) GetVlan() *Interface_Subinterface_Vlan {
+	if t != nil && t.Vlan != nil {
+		return t.Vlan
+	}
+	return nil
+}
+
+// GetAdminStatus retrieves the value of the leaf AdminStatus from the Interface_Subinterface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AdminStatus is set, it can
+// safely use t.GetAdminStatus() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AdminStatus == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface) GetAdminStatus() E_Interface_AdminStatus {
+	if t == nil || t.AdminStatus == 0 {
+		return 0
+	}
+	return t.AdminStatus
+}
+
+// GetCpu retrieves the value of the leaf Cpu from the Interface_Subinterface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Cpu is set, it can
+// safely use t.GetCpu() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Cpu == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface) GetCpu() bool {
+	if t == nil || t.Cpu == nil {
+		return false
+	}
+	return *t.Cpu
+}
+
+// GetDescription retrieves the value of the leaf Description from the Interface_Subinterface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Description is set, it can
+// safely use t.GetDescription() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Description == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetEnabled retrieves the value of the leaf Enabled from the Interface_Subinterface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Enabled is set, it can
+// safely use t.GetEnabled() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Enabled == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return true
+	}
+	return *t.Enabled
+}
+
+// GetIfindex retrieves the value of the leaf Ifindex from the Interface_Subinterface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Ifindex is set, it can
+// safely use t.GetIfindex() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Ifindex == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface) GetIfindex() uint32 {
+	if t == nil || t.Ifindex == nil {
+		return 0
+	}
+	return *t.Ifindex
+}
+
+// GetIndex retrieves the value of the leaf Index from the Interface_Subinterface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Index is set, it can
+// safely use t.GetIndex() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Index == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface) GetIndex() uint32 {
+	if t == nil || t.Index == nil {
+		return 0
+	}
+	return *t.Index
+}
+
+// GetLastChange retrieves the value of the leaf LastChange from the Interface_Subinterface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if LastChange is set, it can
+// safely use t.GetLastChange() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.LastChange == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface) GetLastChange() uint64 {
+	if t == nil || t.LastChange == nil {
+		return 0
+	}
+	return *t.LastChange
+}
+
+// GetLogical retrieves the value of the leaf Logical from the Interface_Subinterface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Logical is set, it can
+// safely use t.GetLogical() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Logical == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface) GetLogical() bool {
+	if t == nil || t.Logical == nil {
+		return false
+	}
+	return *t.Logical
+}
+
+// GetManagement retrieves the value of the leaf Management from the Interface_Subinterface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Management is set, it can
+// safely use t.GetManagement() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Management == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface) GetManagement() bool {
+	if t == nil || t.Management == nil {
+		return false
+	}
+	return *t.Management
+}
+
+// GetName retrieves the value of the leaf Name from the Interface_Subinterface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Name is set, it can
+// safely use t.GetName() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Name == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+	return *t.Name
+}
+
+// GetOperStatus retrieves the value of the leaf OperStatus from the Interface_Subinterface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OperStatus is set, it can
+// safely use t.GetOperStatus() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OperStatus == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface) GetOperStatus() E_Interface_OperStatus {
+	if t == nil || t.OperStatus == 0 {
+		return 0
+	}
+	return t.OperStatus
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Enabled == nil {
+		var v bool = true
+		t.Enabled = &v
+	}
+	if t.Index == nil {
+		var v uint32 = 0
+		t.Index = &v
+	}
+	t.Counters.PopulateDefaults()
+	t.Ipv4.PopulateDefaults()
+	t.Ipv6.PopulateDefaults()
+	t.Vlan.PopulateDefaults()
+}
+
+// ΛListKeyMap returns the keys of the Interface_Subinterface struct, which is a YANG list entry.
+func (t *Interface_Subinterface) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Index == nil {
+		return nil, fmt.Errorf("nil value for key Index")
+	}
+
+	return map[string]interface{}{
+		"index": *t.Index,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface.
+func (*Interface_Subinterface) ΛBelongingModule() string {
+	return "openconfig-interfaces"
+}
+
+// Interface_Subinterface_Counters represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/state/counters YANG schema element.
+type Interface_Subinterface_Counters struct {
+	CarrierTransitions *uint64 `path:"carrier-transitions" module:"openconfig-interfaces"`
+	InBroadcastPkts    *uint64 `path:"in-broadcast-pkts" module:"openconfig-interfaces"`
+	InDiscards         *uint64 `path:"in-discards" module:"openconfig-interfaces"`
+	InErrors           *uint64 `path:"in-errors" module:"openconfig-interfaces"`
+	InFcsErrors        *uint64 `path:"in-fcs-errors" module:"openconfig-interfaces"`
+	InMulticastPkts    *uint64 `path:"in-multicast-pkts" module:"openconfig-interfaces"`
+	InOctets           *uint64 `path:"in-octets" module:"openconfig-interfaces"`
+	InPkts             *uint64 `path:"in-pkts" module:"openconfig-interfaces"`
+	InUnicastPkts      *uint64 `path:"in-unicast-pkts" module:"openconfig-interfaces"`
+	InUnknownProtos    *uint64 `path:"in-unknown-protos" module:"openconfig-interfaces"`
+	LastClear          *uint64 `path:"last-clear" module:"openconfig-interfaces"`
+	OutBroadcastPkts   *uint64 `path:"out-broadcast-pkts" module:"openconfig-interfaces"`
+	OutDiscards        *uint64 `path:"out-discards" module:"openconfig-interfaces"`
+	OutErrors          *uint64 `path:"out-errors" module:"openconfig-interfaces"`
+	OutMulticastPkts   *uint64 `path:"out-multicast-pkts" module:"openconfig-interfaces"`
+	OutOctets          *uint64 `path:"out-octets" module:"openconfig-interfaces"`
+	OutPkts            *uint64 `path:"out-pkts" module:"openconfig-interfaces"`
+	OutUnicastPkts     *uint64 `path:"out-unicast-pkts" module:"openconfig-interfaces"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Counters) IsYANGGoStruct() {}
+
+// GetCarrierTransitions retrieves the value of the leaf CarrierTransitions from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if CarrierTransitions is set, it can
+// safely use t.GetCarrierTransitions() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.CarrierTransitions == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetCarrierTransitions() uint64 {
+	if t == nil || t.CarrierTransitions == nil {
+		return 0
+	}
+	return *t.CarrierTransitions
+}
+
+// GetInBroadcastPkts retrieves the value of the leaf InBroadcastPkts from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InBroadcastPkts is set, it can
+// safely use t.GetInBroadcastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InBroadcastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetInBroadcastPkts() uint64 {
+	if t == nil || t.InBroadcastPkts == nil {
+		return 0
+	}
+	return *t.InBroadcastPkts
+}
+
+// GetInDiscards retrieves the value of the leaf InDiscards from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InDiscards is set, it can
+// safely use t.GetInDiscards() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InDiscards == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetInDiscards() uint64 {
+	if t == nil || t.InDiscards == nil {
+		return 0
+	}
+	return *t.InDiscards
+}
+
+// GetInErrors retrieves the value of the leaf InErrors from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InErrors is set, it can
+// safely use t.GetInErrors() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InErrors == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetInErrors() uint64 {
+	if t == nil || t.InErrors == nil {
+		return 0
+	}
+	return *t.InErrors
+}
+
+// GetInFcsErrors retrieves the value of the leaf InFcsErrors from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InFcsErrors is set, it can
+// safely use t.GetInFcsErrors() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InFcsErrors == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetInFcsErrors() uint64 {
+	if t == nil || t.InFcsErrors == nil {
+		return 0
+	}
+	return *t.InFcsErrors
+}
+
+// GetInMulticastPkts retrieves the value of the leaf InMulticastPkts from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InMulticastPkts is set, it can
+// safely use t.GetInMulticastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InMulticastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetInMulticastPkts() uint64 {
+	if t == nil || t.InMulticastPkts == nil {
+		return 0
+	}
+	return *t.InMulticastPkts
+}
+
+// GetInOctets retrieves the value of the leaf InOctets from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InOctets is set, it can
+// safely use t.GetInOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InOctets == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetInOctets() uint64 {
+	if t == nil || t.InOctets == nil {
+		return 0
+	}
+	return *t.InOctets
+}
+
+// GetInPkts retrieves the value of the leaf InPkts from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InPkts is set, it can
+// safely use t.GetInPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetInPkts() uint64 {
+	if t == nil || t.InPkts == nil {
+		return 0
+	}
+	return *t.InPkts
+}
+
+// GetInUnicastPkts retrieves the value of the leaf InUnicastPkts from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InUnicastPkts is set, it can
+// safely use t.GetInUnicastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InUnicastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetInUnicastPkts() uint64 {
+	if t == nil || t.InUnicastPkts == nil {
+		return 0
+	}
+	return *t.InUnicastPkts
+}
+
+// GetInUnknownProtos retrieves the value of the leaf InUnknownProtos from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InUnknownProtos is set, it can
+// safely use t.GetInUnknownProtos() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InUnknownProtos == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetInUnknownProtos() uint64 {
+	if t == nil || t.InUnknownProtos == nil {
+		return 0
+	}
+	return *t.InUnknownProtos
+}
+
+// GetLastClear retrieves the value of the leaf LastClear from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if LastClear is set, it can
+// safely use t.GetLastClear() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.LastClear == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetLastClear() uint64 {
+	if t == nil || t.LastClear == nil {
+		return 0
+	}
+	return *t.LastClear
+}
+
+// GetOutBroadcastPkts retrieves the value of the leaf OutBroadcastPkts from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutBroadcastPkts is set, it can
+// safely use t.GetOutBroadcastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutBroadcastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetOutBroadcastPkts() uint64 {
+	if t == nil || t.OutBroadcastPkts == nil {
+		return 0
+	}
+	return *t.OutBroadcastPkts
+}
+
+// GetOutDiscards retrieves the value of the leaf OutDiscards from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutDiscards is set, it can
+// safely use t.GetOutDiscards() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutDiscards == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetOutDiscards() uint64 {
+	if t == nil || t.OutDiscards == nil {
+		return 0
+	}
+	return *t.OutDiscards
+}
+
+// GetOutErrors retrieves the value of the leaf OutErrors from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutErrors is set, it can
+// safely use t.GetOutErrors() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutErrors == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetOutErrors() uint64 {
+	if t == nil || t.OutErrors == nil {
+		return 0
+	}
+	return *t.OutErrors
+}
+
+// GetOutMulticastPkts retrieves the value of the leaf OutMulticastPkts from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutMulticastPkts is set, it can
+// safely use t.GetOutMulticastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutMulticastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetOutMulticastPkts() uint64 {
+	if t == nil || t.OutMulticastPkts == nil {
+		return 0
+	}
+	return *t.OutMulticastPkts
+}
+
+// GetOutOctets retrieves the value of the leaf OutOctets from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutOctets is set, it can
+// safely use t.GetOutOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutOctets == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetOutOctets() uint64 {
+	if t == nil || t.OutOctets == nil {
+		return 0
+	}
+	return *t.OutOctets
+}
+
+// GetOutPkts retrieves the value of the leaf OutPkts from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutPkts is set, it can
+// safely use t.GetOutPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetOutPkts() uint64 {
+	if t == nil || t.OutPkts == nil {
+		return 0
+	}
+	return *t.OutPkts
+}
+
+// GetOutUnicastPkts retrieves the value of the leaf OutUnicastPkts from the Interface_Subinterface_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutUnicastPkts is set, it can
+// safely use t.GetOutUnicastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutUnicastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Counters) GetOutUnicastPkts() uint64 {
+	if t == nil || t.OutUnicastPkts == nil {
+		return 0
+	}
+	return *t.OutUnicastPkts
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Counters
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Counters) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Counters.
+func (*Interface_Subinterface_Counters) ΛBelongingModule() string {
+	return "openconfig-interfaces"
+}
+
+// Interface_Subinterface_Ipv4 represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv4 YANG schema element.
+type Interface_Subinterface_Ipv4 struct {
+	Address    map[string]*Interface_Subinterface_Ipv4_Address  `path:"addresses/address" module:"openconfig-if-ip/openconfig-if-ip"`
+	Counters   *Interface_Subinterface_Ipv4_Counters            `path:"state/counters" module:"openconfig-if-ip/openconfig-if-ip"`
+	DhcpClient *bool                                            `path:"state/dhcp-client" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/dhcp-client" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Enabled    *bool                                            `path:"state/enabled" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/enabled" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Mtu        *uint16                                          `path:"state/mtu" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/mtu" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Neighbor   map[string]*Interface_Subinterface_Ipv4_Neighbor `path:"neighbors/neighbor" module:"openconfig-if-ip/openconfig-if-ip"`
+	ProxyArp   *Interface_Subinterface_Ipv4_ProxyArp            `path:"proxy-arp" module:"openconfig-if-ip"`
+	Unnumbered *Interface_Subinterface_Ipv4_Unnumbered          `path:"unnumbered" module:"openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv4 implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv4) IsYANGGoStruct() {}
+
+// NewAddress creates a new entry in the Address list of the
+// Interface_Subinterface_Ipv4 struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface_Subinterface_Ipv4) NewAddress(Ip string) (*Interface_Subinterface_Ipv4_Address, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Address == nil {
+		t.Address = make(map[string]*Interface_Subinterface_Ipv4_Address)
+	}
+
+	key := Ip
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Address[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Address", key)
+	}
+
+	t.Address[key] = &Interface_Subinterface_Ipv4_Address{
+		Ip: &Ip,
+	}
+
+	return t.Address[key], nil
+}
+
+// GetOrCreateAddressMap returns the list (map) from Interface_Subinterface_Ipv4.
+//
+// It initializes the field if not already initialized.
+func (t *Interface_Subinterface_Ipv4) GetOrCreateAddressMap() map[string]*Interface_Subinterface_Ipv4_Address {
+	if t.Address == nil {
+		t.Address = make(map[string]*Interface_Subinterface_Ipv4_Address)
+	}
+	return t.Address
+}
+
+// GetOrCreateAddress retrieves the value with the specified keys from
+// the receiver Interface_Subinterface_Ipv4. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Interface_Subinterface_Ipv4) GetOrCreateAddress(Ip string) *Interface_Subinterface_Ipv4_Address {
+
+	key := Ip
+
+	if v, ok := t.Address[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewAddress(Ip)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateAddress got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetAddress retrieves the value with the specified key from
+// the Address map field of Interface_Subinterface_Ipv4. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Interface_Subinterface_Ipv4) GetAddress(Ip string) *Interface_Subinterface_Ipv4_Address {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Ip
+
+	if lm, ok := t.Address[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteAddress deletes the value with the specified keys from
+// the receiver Interface_Subinterface_Ipv4. If there is no such element, the function
+// is a no-op.
+func (t *Interface_Subinterface_Ipv4) DeleteAddress(Ip string) {
+	key := Ip
+
+	delete(t.Address, key)
+}
+
+// NewNeighbor creates a new entry in the Neighbor list of the
+// Interface_Subinterface_Ipv4 struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface_Subinterface_Ipv4) NewNeighbor(Ip string) (*Interface_Subinterface_Ipv4_Neighbor, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Neighbor == nil {
+		t.Neighbor = make(map[string]*Interface_Subinterface_Ipv4_Neighbor)
+	}
+
+	key := Ip
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Neighbor[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Neighbor", key)
+	}
+
+	t.Neighbor[key] = &Interface_Subinterface_Ipv4_Neighbor{
+		Ip: &Ip,
+	}
+
+	return t.Neighbor[key], nil
+}
+
+// GetOrCreateNeighborMap returns the list (map) from Interface_Subinterface_Ipv4.
+//
+// It initializes the field if not already initialized.
+func (t *Interface_Subinterface_Ipv4) GetOrCreateNeighborMap() map[string]*Interface_Subinterface_Ipv4_Neighbor {
+	if t.Neighbor == nil {
+		t.Neighbor = make(map[string]*Interface_Subinterface_Ipv4_Neighbor)
+	}
+	return t.Neighbor
+}
+
+// GetOrCreateNeighbor retrieves the value with the specified keys from
+// the receiver Interface_Subinterface_Ipv4. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Interface_Subinterface_Ipv4) GetOrCreateNeighbor(Ip string) *Interface_Subinterface_Ipv4_Neighbor {
+
+	key := Ip
+
+	if v, ok := t.Neighbor[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewNeighbor(Ip)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateNeighbor got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetNeighbor retrieves the value with the specified key from
+// the Neighbor map field of Interface_Subinterface_Ipv4. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Interface_Subinterface_Ipv4) GetNeighbor(Ip string) *Interface_Subinterface_Ipv4_Neighbor {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Ip
+
+	if lm, ok := t.Neighbor[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteNeighbor deletes the value with the specified keys from
+// the receiver Interface_Subinterface_Ipv4. If there is no such element, the function
+// is a no-op.
+func (t *Interface_Subinterface_Ipv4) DeleteNeighbor(Ip string) {
+	key := Ip
+
+	delete(t.Neighbor, key)
+}
+
+// GetOrCreateCounters retrieves the value of the Counters field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Ipv4) GetOrCreateCounters() *Interface_Subinterface_Ipv4_Counters {
+	if t.Counters != nil {
+		return t.Counters
+	}
+	t.Counters = &Interface_Subinterface_Ipv4_Counters{}
+	return t.Counters
+}
+
+// GetOrCreateProxyArp retrieves the value of the ProxyArp field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Ipv4) GetOrCreateProxyArp() *Interface_Subinterface_Ipv4_ProxyArp {
+	if t.ProxyArp != nil {
+		return t.ProxyArp
+	}
+	t.ProxyArp = &Interface_Subinterface_Ipv4_ProxyArp{}
+	return t.ProxyArp
+}
+
+// GetOrCreateUnnumbered retrieves the value of the Unnumbered field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Ipv4) GetOrCreateUnnumbered() *Interface_Subinterface_Ipv4_Unnumbered {
+	if t.Unnumbered != nil {
+		return t.Unnumbered
+	}
+	t.Unnumbered = &Interface_Subinterface_Ipv4_Unnumbered{}
+	return t.Unnumbered
+}
+
+// GetCounters returns the value of the Counters struct pointer
+// from Interface_Subinterface_Ipv4. If the receiver or the field Counters is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Ipv4) GetCounters() *Interface_Subinterface_Ipv4_Counters {
+	if t != nil && t.Counters != nil {
+		return t.Counters
+	}
+	return nil
+}
+
+// GetProxyArp returns the value of the ProxyArp struct pointer
+// from Interface_Subinterface_Ipv4. If the receiver or the field ProxyArp is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Ipv4) GetProxyArp() *Interface_Subinterface_Ipv4_ProxyArp {
+	if t != nil && t.ProxyArp != nil {
+		return t.ProxyArp
+	}
+	return nil
+}
+
+// GetUnnumbered returns the value of the Unnumbered struct pointer
+// from Interface_Subinterface_Ipv4. If the receiver or the field Unnumbered is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Ipv4) GetUnnumbered() *Interface_Subinterface_Ipv4_Unnumbered {
+	if t != nil && t.Unnumbered != nil {
+		return t.Unnumbered
+	}
+	return nil
+}
+
+// GetDhcpClient retrieves the value of the leaf DhcpClient from the Interface_Subinterface_Ipv4
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DhcpClient is set, it can
+// safely use t.GetDhcpClient() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DhcpClient == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4) GetDhcpClient() bool {
+	if t == nil || t.DhcpClient == nil {
+		return false
+	}
+	return *t.DhcpClient
+}
+
+// GetEnabled retrieves the value of the leaf Enabled from the Interface_Subinterface_Ipv4
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Enabled is set, it can
+// safely use t.GetEnabled() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Enabled == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return true
+	}
+	return *t.Enabled
+}
+
+// GetMtu retrieves the value of the leaf Mtu from the Interface_Subinterface_Ipv4
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Mtu is set, it can
+// safely use t.GetMtu() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Mtu == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4) GetMtu() uint16 {
+	if t == nil || t.Mtu == nil {
+		return 0
+	}
+	return *t.Mtu
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv4
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv4) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.DhcpClient == nil {
+		var v bool = false
+		t.DhcpClient = &v
+	}
+	if t.Enabled == nil {
+		var v bool = true
+		t.Enabled = &v
+	}
+	t.Counters.PopulateDefaults()
+	t.ProxyArp.PopulateDefaults()
+	t.Unnumbered.PopulateDefaults()
+	for _, e := range t.Address {
+		e.PopulateDefaults()
+	}
+	for _, e := range t.Neighbor {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv4.
+func (*Interface_Subinterface_Ipv4) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv4_Address represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv4/addresses/address YANG schema element.
+type Interface_Subinterface_Ipv4_Address struct {
+	Ip           *string                                                  `path:"state/ip|ip" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip" shadow-path:"config/ip|ip" shadow-module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
+	Origin       E_OpenconfigIfIp_IpAddressOrigin                         `path:"state/origin" module:"openconfig-if-ip/openconfig-if-ip"`
+	PrefixLength *uint8                                                   `path:"state/prefix-length" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/prefix-length" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Type         E_OpenconfigIfIp_Ipv4AddressType                         `path:"state/type" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/type" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	VrrpGroup    map[uint8]*Interface_Subinterface_Ipv4_Address_VrrpGroup `path:"vrrp/vrrp-group" module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv4_Address implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv4_Address) IsYANGGoStruct() {}
+
+// NewVrrpGroup creates a new entry in the VrrpGroup list of the
+// Interface_Subinterface_Ipv4_Address struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface_Subinterface_Ipv4_Address) NewVrrpGroup(VirtualRouterId uint8) (*Interface_Subinterface_Ipv4_Address_VrrpGroup, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.VrrpGroup == nil {
+		t.VrrpGroup = make(map[uint8]*Interface_Subinterface_Ipv4_Address_VrrpGroup)
+	}
+
+	key := VirtualRouterId
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.VrrpGroup[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list VrrpGroup", key)
+	}
+
+	t.VrrpGroup[key] = &Interface_Subinterface_Ipv4_Address_VrrpGroup{
+		VirtualRouterId: &VirtualRouterId,
+	}
+
+	return t.VrrpGroup[key], nil
+}
+
+// GetOrCreateVrrpGroupMap returns the list (map) from Interface_Subinterface_Ipv4_Address.
+//
+// It initializes the field if not already initialized.
+func (t *Interface_Subinterface_Ipv4_Address) GetOrCreateVrrpGroupMap() map[uint8]*Interface_Subinterface_Ipv4_Address_VrrpGroup {
+	if t.VrrpGroup == nil {
+		t.VrrpGroup = make(map[uint8]*Interface_Subinterface_Ipv4_Address_VrrpGroup)
+	}
+	return t.VrrpGroup
+}
+
+// GetOrCreateVrrpGroup retrieves the value with the specified keys from
+// the receiver Interface_Subinterface_Ipv4_Address. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Interface_Subinterface_Ipv4_Address) GetOrCreateVrrpGroup(VirtualRouterId uint8) *Interface_Subinterface_Ipv4_Address_VrrpGroup {
+
+	key := VirtualRouterId
+
+	if v, ok := t.VrrpGroup[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewVrrpGroup(VirtualRouterId)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateVrrpGroup got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetVrrpGroup retrieves the value with the specified key from
+// the VrrpGroup map field of Interface_Subinterface_Ipv4_Address. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Interface_Subinterface_Ipv4_Address) GetVrrpGroup(VirtualRouterId uint8) *Interface_Subinterface_Ipv4_Address_VrrpGroup {
+
+	if t == nil {
+		return nil
+	}
+
+	key := VirtualRouterId
+
+	if lm, ok := t.VrrpGroup[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteVrrpGroup deletes the value with the specified keys from
+// the receiver Interface_Subinterface_Ipv4_Address. If there is no such element, the function
+// is a no-op.
+func (t *Interface_Subinterface_Ipv4_Address) DeleteVrrpGroup(VirtualRouterId uint8) {
+	key := VirtualRouterId
+
+	delete(t.VrrpGroup, key)
+}
+
+// GetIp retrieves the value of the leaf Ip from the Interface_Subinterface_Ipv4_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Ip is set, it can
+// safely use t.GetIp() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Ip == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Address) GetIp() string {
+	if t == nil || t.Ip == nil {
+		return ""
+	}
+	return *t.Ip
+}
+
+// GetOrigin retrieves the value of the leaf Origin from the Interface_Subinterface_Ipv4_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Origin is set, it can
+// safely use t.GetOrigin() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Origin == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Address) GetOrigin() E_OpenconfigIfIp_IpAddressOrigin {
+	if t == nil || t.Origin == 0 {
+		return 0
+	}
+	return t.Origin
+}
+
+// GetPrefixLength retrieves the value of the leaf PrefixLength from the Interface_Subinterface_Ipv4_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PrefixLength is set, it can
+// safely use t.GetPrefixLength() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PrefixLength == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Address) GetPrefixLength() uint8 {
+	if t == nil || t.PrefixLength == nil {
+		return 0
+	}
+	return *t.PrefixLength
+}
+
+// GetType retrieves the value of the leaf Type from the Interface_Subinterface_Ipv4_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Type is set, it can
+// safely use t.GetType() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Type == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Address) GetType() E_OpenconfigIfIp_Ipv4AddressType {
+	if t == nil || t.Type == 0 {
+		return OpenconfigIfIp_Ipv4AddressType_PRIMARY
+	}
+	return t.Type
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv4_Address
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv4_Address) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Type == 0 {
+		t.Type = OpenconfigIfIp_Ipv4AddressType_PRIMARY
+	}
+	for _, e := range t.VrrpGroup {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛListKeyMap returns the keys of the Interface_Subinterface_Ipv4_Address struct, which is a YANG list entry.
+func (t *Interface_Subinterface_Ipv4_Address) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Ip == nil {
+		return nil, fmt.Errorf("nil value for key Ip")
+	}
+
+	return map[string]interface{}{
+		"ip": *t.Ip,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv4_Address.
+func (*Interface_Subinterface_Ipv4_Address) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv4_Address_VrrpGroup represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv4/addresses/address/vrrp/vrrp-group YANG schema element.
+type Interface_Subinterface_Ipv4_Address_VrrpGroup struct {
+	AcceptMode            *bool                                                            `path:"state/accept-mode" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/accept-mode" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	AdvertisementInterval *uint16                                                          `path:"state/advertisement-interval" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/advertisement-interval" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	CurrentPriority       *uint8                                                           `path:"state/current-priority" module:"openconfig-if-ip/openconfig-if-ip"`
+	InterfaceTracking     *Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking `path:"interface-tracking" module:"openconfig-if-ip"`
+	Preempt               *bool                                                            `path:"state/preempt" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/preempt" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	PreemptDelay          *uint16                                                          `path:"state/preempt-delay" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/preempt-delay" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Priority              *uint8                                                           `path:"state/priority" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/priority" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	VirtualAddress        []string                                                         `path:"state/virtual-address" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/virtual-address" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	VirtualRouterId       *uint8                                                           `path:"state/virtual-router-id|virtual-router-id" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip" shadow-path:"config/virtual-router-id|virtual-router-id" shadow-module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv4_Address_VrrpGroup implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv4_Address_VrrpGroup) IsYANGGoStruct() {}
+
+// GetOrCreateInterfaceTracking retrieves the value of the InterfaceTracking field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) GetOrCreateInterfaceTracking() *Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking {
+	if t.InterfaceTracking != nil {
+		return t.InterfaceTracking
+	}
+	t.InterfaceTracking = &Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking{}
+	return t.InterfaceTracking
+}
+
+// GetInterfaceTracking returns the value of the InterfaceTracking struct pointer
+// from Interface_Subinterface_Ipv4_Address_VrrpGroup. If the receiver or the field InterfaceTracking is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) GetInterfaceTracking() *Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking {
+	if t != nil && t.InterfaceTracking != nil {
+		return t.InterfaceTracking
+	}
+	return nil
+}
+
+// GetAcceptMode retrieves the value of the leaf AcceptMode from the Interface_Subinterface_Ipv4_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AcceptMode is set, it can
+// safely use t.GetAcceptMode() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AcceptMode == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) GetAcceptMode() bool {
+	if t == nil || t.AcceptMode == nil {
+		return false
+	}
+	return *t.AcceptMode
+}
+
+// GetAdvertisementInterval retrieves the value of the leaf AdvertisementInterval from the Interface_Subinterface_Ipv4_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AdvertisementInterval is set, it can
+// safely use t.GetAdvertisementInterval() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AdvertisementInterval == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) GetAdvertisementInterval() uint16 {
+	if t == nil || t.AdvertisementInterval == nil {
+		return 100
+	}
+	return *t.AdvertisementInterval
+}
+
+// GetCurrentPriority retrieves the value of the leaf CurrentPriority from the Interface_Subinterface_Ipv4_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if CurrentPriority is set, it can
+// safely use t.GetCurrentPriority() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.CurrentPriority == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) GetCurrentPriority() uint8 {
+	if t == nil || t.CurrentPriority == nil {
+		return 0
+	}
+	return *t.CurrentPriority
+}
+
+// GetPreempt retrieves the value of the leaf Preempt from the Interface_Subinterface_Ipv4_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Preempt is set, it can
+// safely use t.GetPreempt() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Preempt == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) GetPreempt() bool {
+	if t == nil || t.Preempt == nil {
+		return true
+	}
+	return *t.Preempt
+}
+
+// GetPreemptDelay retrieves the value of the leaf PreemptDelay from the Interface_Subinterface_Ipv4_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PreemptDelay is set, it can
+// safely use t.GetPreemptDelay() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PreemptDelay == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) GetPreemptDelay() uint16 {
+	if t == nil || t.PreemptDelay == nil {
+		return 0
+	}
+	return *t.PreemptDelay
+}
+
+// GetPriority retrieves the value of the leaf Priority from the Interface_Subinterface_Ipv4_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Priority is set, it can
+// safely use t.GetPriority() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Priority == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) GetPriority() uint8 {
+	if t == nil || t.Priority == nil {
+		return 100
+	}
+	return *t.Priority
+}
+
+// GetVirtualAddress retrieves the value of the leaf VirtualAddress from the Interface_Subinterface_Ipv4_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VirtualAddress is set, it can
+// safely use t.GetVirtualAddress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VirtualAddress == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) GetVirtualAddress() []string {
+	if t == nil || t.VirtualAddress == nil {
+		return nil
+	}
+	return t.VirtualAddress
+}
+
+// GetVirtualRouterId retrieves the value of the leaf VirtualRouterId from the Interface_Subinterface_Ipv4_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VirtualRouterId is set, it can
+// safely use t.GetVirtualRouterId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VirtualRouterId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) GetVirtualRouterId() uint8 {
+	if t == nil || t.VirtualRouterId == nil {
+		return 0
+	}
+	return *t.VirtualRouterId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv4_Address_VrrpGroup
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.AcceptMode == nil {
+		var v bool = false
+		t.AcceptMode = &v
+	}
+	if t.AdvertisementInterval == nil {
+		var v uint16 = 100
+		t.AdvertisementInterval = &v
+	}
+	if t.Preempt == nil {
+		var v bool = true
+		t.Preempt = &v
+	}
+	if t.PreemptDelay == nil {
+		var v uint16 = 0
+		t.PreemptDelay = &v
+	}
+	if t.Priority == nil {
+		var v uint8 = 100
+		t.Priority = &v
+	}
+	t.InterfaceTracking.PopulateDefaults()
+}
+
+// ΛListKeyMap returns the keys of the Interface_Subinterface_Ipv4_Address_VrrpGroup struct, which is a YANG list entry.
+func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.VirtualRouterId == nil {
+		return nil, fmt.Errorf("nil value for key VirtualRouterId")
+	}
+
+	return map[string]interface{}{
+		"virtual-router-id": *t.VirtualRouterId,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv4_Address_VrrpGroup.
+func (*Interface_Subinterface_Ipv4_Address_VrrpGroup) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv4/addresses/address/vrrp/vrrp-group/interface-tracking YANG schema element.
+type Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking struct {
+	PriorityDecrement *uint8   `path:"state/priority-decrement" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/priority-decrement" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	TrackInterface    []string `path:"state/track-interface" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/track-interface" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking) IsYANGGoStruct() {}
+
+// GetPriorityDecrement retrieves the value of the leaf PriorityDecrement from the Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PriorityDecrement is set, it can
+// safely use t.GetPriorityDecrement() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PriorityDecrement == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking) GetPriorityDecrement() uint8 {
+	if t == nil || t.PriorityDecrement == nil {
+		return 0
+	}
+	return *t.PriorityDecrement
+}
+
+// GetTrackInterface retrieves the value of the leaf TrackInterface from the Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if TrackInterface is set, it can
+// safely use t.GetTrackInterface() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.TrackInterface == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking) GetTrackInterface() []string {
+	if t == nil || t.TrackInterface == nil {
+		return nil
+	}
+	return t.TrackInterface
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.PriorityDecrement == nil {
+		var v uint8 = 0
+		t.PriorityDecrement = &v
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking.
+func (*Interface_Subinterface_Ipv4_Address_VrrpGroup_InterfaceTracking) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv4_Counters represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv4/state/counters YANG schema element.
+type Interface_Subinterface_Ipv4_Counters struct {
+	InDiscardedPkts    *uint64 `path:"in-discarded-pkts" module:"openconfig-if-ip"`
+	InErrorPkts        *uint64 `path:"in-error-pkts" module:"openconfig-if-ip"`
+	InForwardedOctets  *uint64 `path:"in-forwarded-octets" module:"openconfig-if-ip"`
+	InForwardedPkts    *uint64 `path:"in-forwarded-pkts" module:"openconfig-if-ip"`
+	InMulticastOctets  *uint64 `path:"in-multicast-octets" module:"openconfig-if-ip"`
+	InMulticastPkts    *uint64 `path:"in-multicast-pkts" module:"openconfig-if-ip"`
+	InOctets           *uint64 `path:"in-octets" module:"openconfig-if-ip"`
+	InPkts             *uint64 `path:"in-pkts" module:"openconfig-if-ip"`
+	OutDiscardedPkts   *uint64 `path:"out-discarded-pkts" module:"openconfig-if-ip"`
+	OutErrorPkts       *uint64 `path:"out-error-pkts" module:"openconfig-if-ip"`
+	OutForwardedOctets *uint64 `path:"out-forwarded-octets" module:"openconfig-if-ip"`
+	OutForwardedPkts   *uint64 `path:"out-forwarded-pkts" module:"openconfig-if-ip"`
+	OutMulticastOctets *uint64 `path:"out-multicast-octets" module:"openconfig-if-ip"`
+	OutMulticastPkts   *uint64 `path:"out-multicast-pkts" module:"openconfig-if-ip"`
+	OutOctets          *uint64 `path:"out-octets" module:"openconfig-if-ip"`
+	OutPkts            *uint64 `path:"out-pkts" module:"openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv4_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv4_Counters) IsYANGGoStruct() {}
+
+// GetInDiscardedPkts retrieves the value of the leaf InDiscardedPkts from the Interface_Subinterface_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InDiscardedPkts is set, it can
+// safely use t.GetInDiscardedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InDiscardedPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Counters) GetInDiscardedPkts() uint64 {
+	if t == nil || t.InDiscardedPkts == nil {
+		return 0
+	}
+	return *t.InDiscardedPkts
+}
+
+// GetInErrorPkts retrieves the value of the leaf InErrorPkts from the Interface_Subinterface_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InErrorPkts is set, it can
+// safely use t.GetInErrorPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InErrorPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Counters) GetInErrorPkts() uint64 {
+	if t == nil || t.InErrorPkts == nil {
+		return 0
+	}
+	return *t.InErrorPkts
+}
+
+// GetInForwardedOctets retrieves the value of the leaf InForwardedOctets from the Interface_Subinterface_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InForwardedOctets is set, it can
+// safely use t.GetInForwardedOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InForwardedOctets == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Counters) GetInForwardedOctets() uint64 {
+	if t == nil || t.InForwardedOctets == nil {
+		return 0
+	}
+	return *t.InForwardedOctets
+}
+
+// GetInForwardedPkts retrieves the value of the leaf InForwardedPkts from the Interface_Subinterface_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InForwardedPkts is set, it can
+// safely use t.GetInForwardedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InForwardedPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Counters) GetInForwardedPkts() uint64 {
+	if t == nil || t.InForwardedPkts == nil {
+		return 0
+	}
+	return *t.InForwardedPkts
+}
+
+// GetInMulticastOctets retrieves the value of the leaf InMulticastOctets from the Interface_Subinterface_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InMulticastOctets is set, it can
+// safely use t.GetInMulticastOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InMulticastOctets == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Counters) GetInMulticastOctets() uint64 {
+	if t == nil || t.InMulticastOctets == nil {
+		return 0
+	}
+	return *t.InMulticastOctets
+}
+
+// GetInMulticastPkts retrieves the value of the leaf InMulticastPkts from the Interface_Subinterface_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InMulticastPkts is set, it can
+// safely use t.GetInMulticastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InMulticastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Counters) GetInMulticastPkts() uint64 {
+	if t == nil || t.InMulticastPkts == nil {
+		return 0
+	}
+	return *t.InMulticastPkts
+}
+
+// GetInOctets retrieves the value of the leaf InOctets from the Interface_Subinterface_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InOctets is set, it can
+// safely use t.GetInOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InOctets == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Counters) GetInOctets() uint64 {
+	if t == nil || t.InOctets == nil {
+		return 0
+	}
+	return *t.InOctets
+}
+
+// GetInPkts retrieves the value of the leaf InPkts from the Interface_Subinterface_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InPkts is set, it can
+// safely use t.GetInPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Counters) GetInPkts() uint64 {
+	if t == nil || t.InPkts == nil {
+		return 0
+	}
+	return *t.InPkts
+}
+
+// GetOutDiscardedPkts retrieves the value of the leaf OutDiscardedPkts from the Interface_Subinterface_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutDiscardedPkts is set, it can
+// safely use t.GetOutDiscardedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutDiscardedPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Counters) GetOutDiscardedPkts() uint64 {
+	if t == nil || t.OutDiscardedPkts == nil {
+		return 0
+	}
+	return *t.OutDiscardedPkts
+}
+
+// GetOutErrorPkts retrieves the value of the leaf OutErrorPkts from the Interface_Subinterface_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutErrorPkts is set, it can
+// safely use t.GetOutErrorPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutErrorPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Counters) GetOutErrorPkts() uint64 {
+	if t == nil || t.OutErrorPkts == nil {
+		return 0
+	}
+	return *t.OutErrorPkts
+}
+
+// GetOutForwardedOctets retrieves the value of the leaf OutForwardedOctets from the Interface_Subinterface_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutForwardedOctets is set, it can
+// safely use t.GetOutForwardedOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutForwardedOctets == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Counters) GetOutForwardedOctets() uint64 {
+	if t == nil || t.OutForwardedOctets == nil {
+		return 0
+	}
+	return *t.OutForwardedOctets
+}
+
+// GetOutForwardedPkts retrieves the value of the leaf OutForwardedPkts from the Interface_Subinterface_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutForwardedPkts is set, it can
+// safely use t.GetOutForwardedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutForwardedPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Counters) GetOutForwardedPkts() uint64 {
+	if t == nil || t.OutForwardedPkts == nil {
+		return 0
+	}
+	return *t.OutForwardedPkts
+}
+
+// GetOutMulticastOctets retrieves the value of the leaf OutMulticastOctets from the Interface_Subinterface_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutMulticastOctets is set, it can
+// safely use t.GetOutMulticastOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutMulticastOctets == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Counters) GetOutMulticastOctets() uint64 {
+	if t == nil || t.OutMulticastOctets == nil {
+		return 0
+	}
+	return *t.OutMulticastOctets
+}
+
+// GetOutMulticastPkts retrieves the value of the leaf OutMulticastPkts from the Interface_Subinterface_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutMulticastPkts is set, it can
+// safely use t.GetOutMulticastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutMulticastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Counters) GetOutMulticastPkts() uint64 {
+	if t == nil || t.OutMulticastPkts == nil {
+		return 0
+	}
+	return *t.OutMulticastPkts
+}
+
+// GetOutOctets retrieves the value of the leaf OutOctets from the Interface_Subinterface_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutOctets is set, it can
+// safely use t.GetOutOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutOctets == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Counters) GetOutOctets() uint64 {
+	if t == nil || t.OutOctets == nil {
+		return 0
+	}
+	return *t.OutOctets
+}
+
+// GetOutPkts retrieves the value of the leaf OutPkts from the Interface_Subinterface_Ipv4_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutPkts is set, it can
+// safely use t.GetOutPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Counters) GetOutPkts() uint64 {
+	if t == nil || t.OutPkts == nil {
+		return 0
+	}
+	return *t.OutPkts
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv4_Counters
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv4_Counters) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv4_Counters.
+func (*Interface_Subinterface_Ipv4_Counters) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv4_Neighbor represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv4/neighbors/neighbor YANG schema element.
+type Interface_Subinterface_Ipv4_Neighbor struct {
+	Ip               *string                         `path:"state/ip|ip" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip" shadow-path:"config/ip|ip" shadow-module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
+	LinkLayerAddress *string                         `path:"state/link-layer-address" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/link-layer-address" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Origin           E_OpenconfigIfIp_NeighborOrigin `path:"state/origin" module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv4_Neighbor implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv4_Neighbor) IsYANGGoStruct() {}
+
+// GetIp retrieves the value of the leaf Ip from the Interface_Subinterface_Ipv4_Neighbor
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Ip is set, it can
+// safely use t.GetIp() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Ip == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Neighbor) GetIp() string {
+	if t == nil || t.Ip == nil {
+		return ""
+	}
+	return *t.Ip
+}
+
+// GetLinkLayerAddress retrieves the value of the leaf LinkLayerAddress from the Interface_Subinterface_Ipv4_Neighbor
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if LinkLayerAddress is set, it can
+// safely use t.GetLinkLayerAddress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.LinkLayerAddress == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Neighbor) GetLinkLayerAddress() string {
+	if t == nil || t.LinkLayerAddress == nil {
+		return ""
+	}
+	return *t.LinkLayerAddress
+}
+
+// GetOrigin retrieves the value of the leaf Origin from the Interface_Subinterface_Ipv4_Neighbor
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Origin is set, it can
+// safely use t.GetOrigin() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Origin == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Neighbor) GetOrigin() E_OpenconfigIfIp_NeighborOrigin {
+	if t == nil || t.Origin == 0 {
+		return 0
+	}
+	return t.Origin
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv4_Neighbor
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv4_Neighbor) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛListKeyMap returns the keys of the Interface_Subinterface_Ipv4_Neighbor struct, which is a YANG list entry.
+func (t *Interface_Subinterface_Ipv4_Neighbor) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Ip == nil {
+		return nil, fmt.Errorf("nil value for key Ip")
+	}
+
+	return map[string]interface{}{
+		"ip": *t.Ip,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv4_Neighbor.
+func (*Interface_Subinterface_Ipv4_Neighbor) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv4_ProxyArp represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv4/proxy-arp YANG schema element.
+type Interface_Subinterface_Ipv4_ProxyArp struct {
+	Mode E_ProxyArp_Mode `path:"state/mode" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/mode" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv4_ProxyArp implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv4_ProxyArp) IsYANGGoStruct() {}
+
+// GetMode retrieves the value of the leaf Mode from the Interface_Subinterface_Ipv4_ProxyArp
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Mode is set, it can
+// safely use t.GetMode() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Mode == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_ProxyArp) GetMode() E_ProxyArp_Mode {
+	if t == nil || t.Mode == 0 {
+		return ProxyArp_Mode_DISABLE
+	}
+	return t.Mode
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv4_ProxyArp
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv4_ProxyArp) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Mode == 0 {
+		t.Mode = ProxyArp_Mode_DISABLE
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv4_ProxyArp.
+func (*Interface_Subinterface_Ipv4_ProxyArp) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv4_Unnumbered represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv4/unnumbered YANG schema element.
+type Interface_Subinterface_Ipv4_Unnumbered struct {
+	Enabled      *bool                                                `path:"state/enabled" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/enabled" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	InterfaceRef *Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef `path:"interface-ref" module:"openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv4_Unnumbered implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv4_Unnumbered) IsYANGGoStruct() {}
+
+// GetOrCreateInterfaceRef retrieves the value of the InterfaceRef field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Ipv4_Unnumbered) GetOrCreateInterfaceRef() *Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef {
+	if t.InterfaceRef != nil {
+		return t.InterfaceRef
+	}
+	t.InterfaceRef = &Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef{}
+	return t.InterfaceRef
+}
+
+// GetInterfaceRef returns the value of the InterfaceRef struct pointer
+// from Interface_Subinterface_Ipv4_Unnumbered. If the receiver or the field InterfaceRef is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Ipv4_Unnumbered) GetInterfaceRef() *Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef {
+	if t != nil && t.InterfaceRef != nil {
+		return t.InterfaceRef
+	}
+	return nil
+}
+
+// GetEnabled retrieves the value of the leaf Enabled from the Interface_Subinterface_Ipv4_Unnumbered
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Enabled is set, it can
+// safely use t.GetEnabled() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Enabled == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Unnumbered) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv4_Unnumbered
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv4_Unnumbered) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Enabled == nil {
+		var v bool = false
+		t.Enabled = &v
+	}
+	t.InterfaceRef.PopulateDefaults()
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv4_Unnumbered.
+func (*Interface_Subinterface_Ipv4_Unnumbered) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv4/unnumbered/interface-ref YANG schema element.
+type Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef struct {
+	Interface    *string `path:"state/interface" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/interface" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Subinterface *uint32 `path:"state/subinterface" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/subinterface" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef) IsYANGGoStruct() {}
+
+// GetInterface retrieves the value of the leaf Interface from the Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Interface is set, it can
+// safely use t.GetInterface() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Interface == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef) GetInterface() string {
+	if t == nil || t.Interface == nil {
+		return ""
+	}
+	return *t.Interface
+}
+
+// GetSubinterface retrieves the value of the leaf Subinterface from the Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Subinterface is set, it can
+// safely use t.GetSubinterface() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Subinterface == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef) GetSubinterface() uint32 {
+	if t == nil || t.Subinterface == nil {
+		return 0
+	}
+	return *t.Subinterface
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef.
+func (*Interface_Subinterface_Ipv4_Unnumbered_InterfaceRef) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv6 represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6 YANG schema element.
+type Interface_Subinterface_Ipv6 struct {
+	Address                map[string]*Interface_Subinterface_Ipv6_Address  `path:"addresses/address" module:"openconfig-if-ip/openconfig-if-ip"`
+	Counters               *Interface_Subinterface_Ipv6_Counters            `path:"state/counters" module:"openconfig-if-ip/openconfig-if-ip"`
+	DhcpClient             *bool                                            `path:"state/dhcp-client" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/dhcp-client" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	DupAddrDetectTransmits *uint32                                          `path:"state/dup-addr-detect-transmits" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/dup-addr-detect-transmits" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Enabled                *bool                                            `path:"state/enabled" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/enabled" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Mtu                    *uint32                                          `path:"state/mtu" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/mtu" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Neighbor               map[string]*Interface_Subinterface_Ipv6_Neighbor `path:"neighbors/neighbor" module:"openconfig-if-ip/openconfig-if-ip"`
+	RouterAdvertisement    *Interface_Subinterface_Ipv6_RouterAdvertisement `path:"router-advertisement" module:"openconfig-if-ip"`
+	Unnumbered             *Interface_Subinterface_Ipv6_Unnumbered          `path:"unnumbered" module:"openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6 implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv6) IsYANGGoStruct() {}
+
+// NewAddress creates a new entry in the Address list of the
+// Interface_Subinterface_Ipv6 struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface_Subinterface_Ipv6) NewAddress(Ip string) (*Interface_Subinterface_Ipv6_Address, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Address == nil {
+		t.Address = make(map[string]*Interface_Subinterface_Ipv6_Address)
+	}
+
+	key := Ip
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Address[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Address", key)
+	}
+
+	t.Address[key] = &Interface_Subinterface_Ipv6_Address{
+		Ip: &Ip,
+	}
+
+	return t.Address[key], nil
+}
+
+// GetOrCreateAddressMap returns the list (map) from Interface_Subinterface_Ipv6.
+//
+// It initializes the field if not already initialized.
+func (t *Interface_Subinterface_Ipv6) GetOrCreateAddressMap() map[string]*Interface_Subinterface_Ipv6_Address {
+	if t.Address == nil {
+		t.Address = make(map[string]*Interface_Subinterface_Ipv6_Address)
+	}
+	return t.Address
+}
+
+// GetOrCreateAddress retrieves the value with the specified keys from
+// the receiver Interface_Subinterface_Ipv6. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Interface_Subinterface_Ipv6) GetOrCreateAddress(Ip string) *Interface_Subinterface_Ipv6_Address {
+
+	key := Ip
+
+	if v, ok := t.Address[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewAddress(Ip)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateAddress got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetAddress retrieves the value with the specified key from
+// the Address map field of Interface_Subinterface_Ipv6. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Interface_Subinterface_Ipv6) GetAddress(Ip string) *Interface_Subinterface_Ipv6_Address {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Ip
+
+	if lm, ok := t.Address[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteAddress deletes the value with the specified keys from
+// the receiver Interface_Subinterface_Ipv6. If there is no such element, the function
+// is a no-op.
+func (t *Interface_Subinterface_Ipv6) DeleteAddress(Ip string) {
+	key := Ip
+
+	delete(t.Address, key)
+}
+
+// NewNeighbor creates a new entry in the Neighbor list of the
+// Interface_Subinterface_Ipv6 struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface_Subinterface_Ipv6) NewNeighbor(Ip string) (*Interface_Subinterface_Ipv6_Neighbor, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Neighbor == nil {
+		t.Neighbor = make(map[string]*Interface_Subinterface_Ipv6_Neighbor)
+	}
+
+	key := Ip
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Neighbor[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Neighbor", key)
+	}
+
+	t.Neighbor[key] = &Interface_Subinterface_Ipv6_Neighbor{
+		Ip: &Ip,
+	}
+
+	return t.Neighbor[key], nil
+}
+
+// GetOrCreateNeighborMap returns the list (map) from Interface_Subinterface_Ipv6.
+//
+// It initializes the field if not already initialized.
+func (t *Interface_Subinterface_Ipv6) GetOrCreateNeighborMap() map[string]*Interface_Subinterface_Ipv6_Neighbor {
+	if t.Neighbor == nil {
+		t.Neighbor = make(map[string]*Interface_Subinterface_Ipv6_Neighbor)
+	}
+	return t.Neighbor
+}
+
+// GetOrCreateNeighbor retrieves the value with the specified keys from
+// the receiver Interface_Subinterface_Ipv6. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Interface_Subinterface_Ipv6) GetOrCreateNeighbor(Ip string) *Interface_Subinterface_Ipv6_Neighbor {
+
+	key := Ip
+
+	if v, ok := t.Neighbor[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewNeighbor(Ip)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateNeighbor got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetNeighbor retrieves the value with the specified key from
+// the Neighbor map field of Interface_Subinterface_Ipv6. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Interface_Subinterface_Ipv6) GetNeighbor(Ip string) *Interface_Subinterface_Ipv6_Neighbor {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Ip
+
+	if lm, ok := t.Neighbor[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteNeighbor deletes the value with the specified keys from
+// the receiver Interface_Subinterface_Ipv6. If there is no such element, the function
+// is a no-op.
+func (t *Interface_Subinterface_Ipv6) DeleteNeighbor(Ip string) {
+	key := Ip
+
+	delete(t.Neighbor, key)
+}
+
+// GetOrCreateCounters retrieves the value of the Counters field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Ipv6) GetOrCreateCounters() *Interface_Subinterface_Ipv6_Counters {
+	if t.Counters != nil {
+		return t.Counters
+	}
+	t.Counters = &Interface_Subinterface_Ipv6_Counters{}
+	return t.Counters
+}
+
+// GetOrCreateRouterAdvertisement retrieves the value of the RouterAdvertisement field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Ipv6) GetOrCreateRouterAdvertisement() *Interface_Subinterface_Ipv6_RouterAdvertisement {
+	if t.RouterAdvertisement != nil {
+		return t.RouterAdvertisement
+	}
+	t.RouterAdvertisement = &Interface_Subinterface_Ipv6_RouterAdvertisement{}
+	return t.RouterAdvertisement
+}
+
+// GetOrCreateUnnumbered retrieves the value of the Unnumbered field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Ipv6) GetOrCreateUnnumbered() *Interface_Subinterface_Ipv6_Unnumbered {
+	if t.Unnumbered != nil {
+		return t.Unnumbered
+	}
+	t.Unnumbered = &Interface_Subinterface_Ipv6_Unnumbered{}
+	return t.Unnumbered
+}
+
+// GetCounters returns the value of the Counters struct pointer
+// from Interface_Subinterface_Ipv6. If the receiver or the field Counters is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Ipv6) GetCounters() *Interface_Subinterface_Ipv6_Counters {
+	if t != nil && t.Counters != nil {
+		return t.Counters
+	}
+	return nil
+}
+
+// GetRouterAdvertisement returns the value of the RouterAdvertisement struct pointer
+// from Interface_Subinterface_Ipv6. If the receiver or the field RouterAdvertisement is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Ipv6) GetRouterAdvertisement() *Interface_Subinterface_Ipv6_RouterAdvertisement {
+	if t != nil && t.RouterAdvertisement != nil {
+		return t.RouterAdvertisement
+	}
+	return nil
+}
+
+// GetUnnumbered returns the value of the Unnumbered struct pointer
+// from Interface_Subinterface_Ipv6. If the receiver or the field Unnumbered is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Ipv6) GetUnnumbered() *Interface_Subinterface_Ipv6_Unnumbered {
+	if t != nil && t.Unnumbered != nil {
+		return t.Unnumbered
+	}
+	return nil
+}
+
+// GetDhcpClient retrieves the value of the leaf DhcpClient from the Interface_Subinterface_Ipv6
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DhcpClient is set, it can
+// safely use t.GetDhcpClient() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DhcpClient == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6) GetDhcpClient() bool {
+	if t == nil || t.DhcpClient == nil {
+		return false
+	}
+	return *t.DhcpClient
+}
+
+// GetDupAddrDetectTransmits retrieves the value of the leaf DupAddrDetectTransmits from the Interface_Subinterface_Ipv6
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DupAddrDetectTransmits is set, it can
+// safely use t.GetDupAddrDetectTransmits() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DupAddrDetectTransmits == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6) GetDupAddrDetectTransmits() uint32 {
+	if t == nil || t.DupAddrDetectTransmits == nil {
+		return 1
+	}
+	return *t.DupAddrDetectTransmits
+}
+
+// GetEnabled retrieves the value of the leaf Enabled from the Interface_Subinterface_Ipv6
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Enabled is set, it can
+// safely use t.GetEnabled() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Enabled == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return true
+	}
+	return *t.Enabled
+}
+
+// GetMtu retrieves the value of the leaf Mtu from the Interface_Subinterface_Ipv6
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Mtu is set, it can
+// safely use t.GetMtu() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Mtu == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6) GetMtu() uint32 {
+	if t == nil || t.Mtu == nil {
+		return 0
+	}
+	return *t.Mtu
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv6
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv6) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.DhcpClient == nil {
+		var v bool = false
+		t.DhcpClient = &v
+	}
+	if t.DupAddrDetectTransmits == nil {
+		var v uint32 = 1
+		t.DupAddrDetectTransmits = &v
+	}
+	if t.Enabled == nil {
+		var v bool = true
+		t.Enabled = &v
+	}
+	t.Counters.PopulateDefaults()
+	t.RouterAdvertisement.PopulateDefaults()
+	t.Unnumbered.PopulateDefaults()
+	for _, e := range t.Address {
+		e.PopulateDefaults()
+	}
+	for _, e := range t.Neighbor {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv6.
+func (*Interface_Subinterface_Ipv6) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv6_Address represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6/addresses/address YANG schema element.
+type Interface_Subinterface_Ipv6_Address struct {
+	Ip           *string                                                  `path:"state/ip|ip" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip" shadow-path:"config/ip|ip" shadow-module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
+	Origin       E_OpenconfigIfIp_IpAddressOrigin                         `path:"state/origin" module:"openconfig-if-ip/openconfig-if-ip"`
+	PrefixLength *uint8                                                   `path:"state/prefix-length" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/prefix-length" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Status       E_Address_Status                                         `path:"state/status" module:"openconfig-if-ip/openconfig-if-ip"`
+	Type         E_OpenconfigIfIp_Ipv6AddressType                         `path:"state/type" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/type" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	VrrpGroup    map[uint8]*Interface_Subinterface_Ipv6_Address_VrrpGroup `path:"vrrp/vrrp-group" module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6_Address implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv6_Address) IsYANGGoStruct() {}
+
+// NewVrrpGroup creates a new entry in the VrrpGroup list of the
+// Interface_Subinterface_Ipv6_Address struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface_Subinterface_Ipv6_Address) NewVrrpGroup(VirtualRouterId uint8) (*Interface_Subinterface_Ipv6_Address_VrrpGroup, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.VrrpGroup == nil {
+		t.VrrpGroup = make(map[uint8]*Interface_Subinterface_Ipv6_Address_VrrpGroup)
+	}
+
+	key := VirtualRouterId
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.VrrpGroup[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list VrrpGroup", key)
+	}
+
+	t.VrrpGroup[key] = &Interface_Subinterface_Ipv6_Address_VrrpGroup{
+		VirtualRouterId: &VirtualRouterId,
+	}
+
+	return t.VrrpGroup[key], nil
+}
+
+// GetOrCreateVrrpGroupMap returns the list (map) from Interface_Subinterface_Ipv6_Address.
+//
+// It initializes the field if not already initialized.
+func (t *Interface_Subinterface_Ipv6_Address) GetOrCreateVrrpGroupMap() map[uint8]*Interface_Subinterface_Ipv6_Address_VrrpGroup {
+	if t.VrrpGroup == nil {
+		t.VrrpGroup = make(map[uint8]*Interface_Subinterface_Ipv6_Address_VrrpGroup)
+	}
+	return t.VrrpGroup
+}
+
+// GetOrCreateVrrpGroup retrieves the value with the specified keys from
+// the receiver Interface_Subinterface_Ipv6_Address. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Interface_Subinterface_Ipv6_Address) GetOrCreateVrrpGroup(VirtualRouterId uint8) *Interface_Subinterface_Ipv6_Address_VrrpGroup {
+
+	key := VirtualRouterId
+
+	if v, ok := t.VrrpGroup[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewVrrpGroup(VirtualRouterId)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateVrrpGroup got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetVrrpGroup retrieves the value with the specified key from
+// the VrrpGroup map field of Interface_Subinterface_Ipv6_Address. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Interface_Subinterface_Ipv6_Address) GetVrrpGroup(VirtualRouterId uint8) *Interface_Subinterface_Ipv6_Address_VrrpGroup {
+
+	if t == nil {
+		return nil
+	}
+
+	key := VirtualRouterId
+
+	if lm, ok := t.VrrpGroup[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteVrrpGroup deletes the value with the specified keys from
+// the receiver Interface_Subinterface_Ipv6_Address. If there is no such element, the function
+// is a no-op.
+func (t *Interface_Subinterface_Ipv6_Address) DeleteVrrpGroup(VirtualRouterId uint8) {
+	key := VirtualRouterId
+
+	delete(t.VrrpGroup, key)
+}
+
+// GetIp retrieves the value of the leaf Ip from the Interface_Subinterface_Ipv6_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Ip is set, it can
+// safely use t.GetIp() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Ip == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Address) GetIp() string {
+	if t == nil || t.Ip == nil {
+		return ""
+	}
+	return *t.Ip
+}
+
+// GetOrigin retrieves the value of the leaf Origin from the Interface_Subinterface_Ipv6_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Origin is set, it can
+// safely use t.GetOrigin() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Origin == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Address) GetOrigin() E_OpenconfigIfIp_IpAddressOrigin {
+	if t == nil || t.Origin == 0 {
+		return 0
+	}
+	return t.Origin
+}
+
+// GetPrefixLength retrieves the value of the leaf PrefixLength from the Interface_Subinterface_Ipv6_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PrefixLength is set, it can
+// safely use t.GetPrefixLength() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PrefixLength == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Address) GetPrefixLength() uint8 {
+	if t == nil || t.PrefixLength == nil {
+		return 0
+	}
+	return *t.PrefixLength
+}
+
+// GetStatus retrieves the value of the leaf Status from the Interface_Subinterface_Ipv6_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Status is set, it can
+// safely use t.GetStatus() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Status == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Address) GetStatus() E_Address_Status {
+	if t == nil || t.Status == 0 {
+		return 0
+	}
+	return t.Status
+}
+
+// GetType retrieves the value of the leaf Type from the Interface_Subinterface_Ipv6_Address
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Type is set, it can
+// safely use t.GetType() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Type == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Address) GetType() E_OpenconfigIfIp_Ipv6AddressType {
+	if t == nil || t.Type == 0 {
+		return OpenconfigIfIp_Ipv6AddressType_GLOBAL_UNICAST
+	}
+	return t.Type
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv6_Address
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv6_Address) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Type == 0 {
+		t.Type = OpenconfigIfIp_Ipv6AddressType_GLOBAL_UNICAST
+	}
+	for _, e := range t.VrrpGroup {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛListKeyMap returns the keys of the Interface_Subinterface_Ipv6_Address struct, which is a YANG list entry.
+func (t *Interface_Subinterface_Ipv6_Address) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Ip == nil {
+		return nil, fmt.Errorf("nil value for key Ip")
+	}
+
+	return map[string]interface{}{
+		"ip": *t.Ip,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv6_Address.
+func (*Interface_Subinterface_Ipv6_Address) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv6_Address_VrrpGroup represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6/addresses/address/vrrp/vrrp-group YANG schema element.
+type Interface_Subinterface_Ipv6_Address_VrrpGroup struct {
+	AcceptMode            *bool                                                            `path:"state/accept-mode" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/accept-mode" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	AdvertisementInterval *uint16                                                          `path:"state/advertisement-interval" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/advertisement-interval" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	CurrentPriority       *uint8                                                           `path:"state/current-priority" module:"openconfig-if-ip/openconfig-if-ip"`
+	InterfaceTracking     *Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking `path:"interface-tracking" module:"openconfig-if-ip"`
+	Preempt               *bool                                                            `path:"state/preempt" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/preempt" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	PreemptDelay          *uint16                                                          `path:"state/preempt-delay" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/preempt-delay" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Priority              *uint8                                                           `path:"state/priority" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/priority" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	VirtualAddress        []string                                                         `path:"state/virtual-address" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/virtual-address" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	VirtualLinkLocal      *string                                                          `path:"state/virtual-link-local" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/virtual-link-local" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	VirtualRouterId       *uint8                                                           `path:"state/virtual-router-id|virtual-router-id" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip" shadow-path:"config/virtual-router-id|virtual-router-id" shadow-module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6_Address_VrrpGroup implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv6_Address_VrrpGroup) IsYANGGoStruct() {}
+
+// GetOrCreateInterfaceTracking retrieves the value of the InterfaceTracking field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) GetOrCreateInterfaceTracking() *Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking {
+	if t.InterfaceTracking != nil {
+		return t.InterfaceTracking
+	}
+	t.InterfaceTracking = &Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking{}
+	return t.InterfaceTracking
+}
+
+// GetInterfaceTracking returns the value of the InterfaceTracking struct pointer
+// from Interface_Subinterface_Ipv6_Address_VrrpGroup. If the receiver or the field InterfaceTracking is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) GetInterfaceTracking() *Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking {
+	if t != nil && t.InterfaceTracking != nil {
+		return t.InterfaceTracking
+	}
+	return nil
+}
+
+// GetAcceptMode retrieves the value of the leaf AcceptMode from the Interface_Subinterface_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AcceptMode is set, it can
+// safely use t.GetAcceptMode() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AcceptMode == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) GetAcceptMode() bool {
+	if t == nil || t.AcceptMode == nil {
+		return false
+	}
+	return *t.AcceptMode
+}
+
+// GetAdvertisementInterval retrieves the value of the leaf AdvertisementInterval from the Interface_Subinterface_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AdvertisementInterval is set, it can
+// safely use t.GetAdvertisementInterval() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AdvertisementInterval == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) GetAdvertisementInterval() uint16 {
+	if t == nil || t.AdvertisementInterval == nil {
+		return 100
+	}
+	return *t.AdvertisementInterval
+}
+
+// GetCurrentPriority retrieves the value of the leaf CurrentPriority from the Interface_Subinterface_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if CurrentPriority is set, it can
+// safely use t.GetCurrentPriority() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.CurrentPriority == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) GetCurrentPriority() uint8 {
+	if t == nil || t.CurrentPriority == nil {
+		return 0
+	}
+	return *t.CurrentPriority
+}
+
+// GetPreempt retrieves the value of the leaf Preempt from the Interface_Subinterface_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Preempt is set, it can
+// safely use t.GetPreempt() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Preempt == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) GetPreempt() bool {
+	if t == nil || t.Preempt == nil {
+		return true
+	}
+	return *t.Preempt
+}
+
+// GetPreemptDelay retrieves the value of the leaf PreemptDelay from the Interface_Subinterface_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PreemptDelay is set, it can
+// safely use t.GetPreemptDelay() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PreemptDelay == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) GetPreemptDelay() uint16 {
+	if t == nil || t.PreemptDelay == nil {
+		return 0
+	}
+	return *t.PreemptDelay
+}
+
+// GetPriority retrieves the value of the leaf Priority from the Interface_Subinterface_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Priority is set, it can
+// safely use t.GetPriority() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Priority == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) GetPriority() uint8 {
+	if t == nil || t.Priority == nil {
+		return 100
+	}
+	return *t.Priority
+}
+
+// GetVirtualAddress retrieves the value of the leaf VirtualAddress from the Interface_Subinterface_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VirtualAddress is set, it can
+// safely use t.GetVirtualAddress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VirtualAddress == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) GetVirtualAddress() []string {
+	if t == nil || t.VirtualAddress == nil {
+		return nil
+	}
+	return t.VirtualAddress
+}
+
+// GetVirtualLinkLocal retrieves the value of the leaf VirtualLinkLocal from the Interface_Subinterface_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VirtualLinkLocal is set, it can
+// safely use t.GetVirtualLinkLocal() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VirtualLinkLocal == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) GetVirtualLinkLocal() string {
+	if t == nil || t.VirtualLinkLocal == nil {
+		return ""
+	}
+	return *t.VirtualLinkLocal
+}
+
+// GetVirtualRouterId retrieves the value of the leaf VirtualRouterId from the Interface_Subinterface_Ipv6_Address_VrrpGroup
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VirtualRouterId is set, it can
+// safely use t.GetVirtualRouterId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VirtualRouterId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) GetVirtualRouterId() uint8 {
+	if t == nil || t.VirtualRouterId == nil {
+		return 0
+	}
+	return *t.VirtualRouterId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv6_Address_VrrpGroup
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.AcceptMode == nil {
+		var v bool = false
+		t.AcceptMode = &v
+	}
+	if t.AdvertisementInterval == nil {
+		var v uint16 = 100
+		t.AdvertisementInterval = &v
+	}
+	if t.Preempt == nil {
+		var v bool = true
+		t.Preempt = &v
+	}
+	if t.PreemptDelay == nil {
+		var v uint16 = 0
+		t.PreemptDelay = &v
+	}
+	if t.Priority == nil {
+		var v uint8 = 100
+		t.Priority = &v
+	}
+	t.InterfaceTracking.PopulateDefaults()
+}
+
+// ΛListKeyMap returns the keys of the Interface_Subinterface_Ipv6_Address_VrrpGroup struct, which is a YANG list entry.
+func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.VirtualRouterId == nil {
+		return nil, fmt.Errorf("nil value for key VirtualRouterId")
+	}
+
+	return map[string]interface{}{
+		"virtual-router-id": *t.VirtualRouterId,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv6_Address_VrrpGroup.
+func (*Interface_Subinterface_Ipv6_Address_VrrpGroup) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6/addresses/address/vrrp/vrrp-group/interface-tracking YANG schema element.
+type Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking struct {
+	PriorityDecrement *uint8   `path:"state/priority-decrement" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/priority-decrement" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	TrackInterface    []string `path:"state/track-interface" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/track-interface" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking) IsYANGGoStruct() {}
+
+// GetPriorityDecrement retrieves the value of the leaf PriorityDecrement from the Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PriorityDecrement is set, it can
+// safely use t.GetPriorityDecrement() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PriorityDecrement == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking) GetPriorityDecrement() uint8 {
+	if t == nil || t.PriorityDecrement == nil {
+		return 0
+	}
+	return *t.PriorityDecrement
+}
+
+// GetTrackInterface retrieves the value of the leaf TrackInterface from the Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if TrackInterface is set, it can
+// safely use t.GetTrackInterface() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.TrackInterface == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking) GetTrackInterface() []string {
+	if t == nil || t.TrackInterface == nil {
+		return nil
+	}
+	return t.TrackInterface
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.PriorityDecrement == nil {
+		var v uint8 = 0
+		t.PriorityDecrement = &v
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking.
+func (*Interface_Subinterface_Ipv6_Address_VrrpGroup_InterfaceTracking) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv6_Counters represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6/state/counters YANG schema element.
+type Interface_Subinterface_Ipv6_Counters struct {
+	InDiscardedPkts    *uint64 `path:"in-discarded-pkts" module:"openconfig-if-ip"`
+	InErrorPkts        *uint64 `path:"in-error-pkts" module:"openconfig-if-ip"`
+	InForwardedOctets  *uint64 `path:"in-forwarded-octets" module:"openconfig-if-ip"`
+	InForwardedPkts    *uint64 `path:"in-forwarded-pkts" module:"openconfig-if-ip"`
+	InMulticastOctets  *uint64 `path:"in-multicast-octets" module:"openconfig-if-ip"`
+	InMulticastPkts    *uint64 `path:"in-multicast-pkts" module:"openconfig-if-ip"`
+	InOctets           *uint64 `path:"in-octets" module:"openconfig-if-ip"`
+	InPkts             *uint64 `path:"in-pkts" module:"openconfig-if-ip"`
+	OutDiscardedPkts   *uint64 `path:"out-discarded-pkts" module:"openconfig-if-ip"`
+	OutErrorPkts       *uint64 `path:"out-error-pkts" module:"openconfig-if-ip"`
+	OutForwardedOctets *uint64 `path:"out-forwarded-octets" module:"openconfig-if-ip"`
+	OutForwardedPkts   *uint64 `path:"out-forwarded-pkts" module:"openconfig-if-ip"`
+	OutMulticastOctets *uint64 `path:"out-multicast-octets" module:"openconfig-if-ip"`
+	OutMulticastPkts   *uint64 `path:"out-multicast-pkts" module:"openconfig-if-ip"`
+	OutOctets          *uint64 `path:"out-octets" module:"openconfig-if-ip"`
+	OutPkts            *uint64 `path:"out-pkts" module:"openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6_Counters implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv6_Counters) IsYANGGoStruct() {}
+
+// GetInDiscardedPkts retrieves the value of the leaf InDiscardedPkts from the Interface_Subinterface_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InDiscardedPkts is set, it can
+// safely use t.GetInDiscardedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InDiscardedPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Counters) GetInDiscardedPkts() uint64 {
+	if t == nil || t.InDiscardedPkts == nil {
+		return 0
+	}
+	return *t.InDiscardedPkts
+}
+
+// GetInErrorPkts retrieves the value of the leaf InErrorPkts from the Interface_Subinterface_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InErrorPkts is set, it can
+// safely use t.GetInErrorPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InErrorPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Counters) GetInErrorPkts() uint64 {
+	if t == nil || t.InErrorPkts == nil {
+		return 0
+	}
+	return *t.InErrorPkts
+}
+
+// GetInForwardedOctets retrieves the value of the leaf InForwardedOctets from the Interface_Subinterface_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InForwardedOctets is set, it can
+// safely use t.GetInForwardedOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InForwardedOctets == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Counters) GetInForwardedOctets() uint64 {
+	if t == nil || t.InForwardedOctets == nil {
+		return 0
+	}
+	return *t.InForwardedOctets
+}
+
+// GetInForwardedPkts retrieves the value of the leaf InForwardedPkts from the Interface_Subinterface_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InForwardedPkts is set, it can
+// safely use t.GetInForwardedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InForwardedPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Counters) GetInForwardedPkts() uint64 {
+	if t == nil || t.InForwardedPkts == nil {
+		return 0
+	}
+	return *t.InForwardedPkts
+}
+
+// GetInMulticastOctets retrieves the value of the leaf InMulticastOctets from the Interface_Subinterface_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InMulticastOctets is set, it can
+// safely use t.GetInMulticastOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InMulticastOctets == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Counters) GetInMulticastOctets() uint64 {
+	if t == nil || t.InMulticastOctets == nil {
+		return 0
+	}
+	return *t.InMulticastOctets
+}
+
+// GetInMulticastPkts retrieves the value of the leaf InMulticastPkts from the Interface_Subinterface_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InMulticastPkts is set, it can
+// safely use t.GetInMulticastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InMulticastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Counters) GetInMulticastPkts() uint64 {
+	if t == nil || t.InMulticastPkts == nil {
+		return 0
+	}
+	return *t.InMulticastPkts
+}
+
+// GetInOctets retrieves the value of the leaf InOctets from the Interface_Subinterface_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InOctets is set, it can
+// safely use t.GetInOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InOctets == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Counters) GetInOctets() uint64 {
+	if t == nil || t.InOctets == nil {
+		return 0
+	}
+	return *t.InOctets
+}
+
+// GetInPkts retrieves the value of the leaf InPkts from the Interface_Subinterface_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InPkts is set, it can
+// safely use t.GetInPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Counters) GetInPkts() uint64 {
+	if t == nil || t.InPkts == nil {
+		return 0
+	}
+	return *t.InPkts
+}
+
+// GetOutDiscardedPkts retrieves the value of the leaf OutDiscardedPkts from the Interface_Subinterface_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutDiscardedPkts is set, it can
+// safely use t.GetOutDiscardedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutDiscardedPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Counters) GetOutDiscardedPkts() uint64 {
+	if t == nil || t.OutDiscardedPkts == nil {
+		return 0
+	}
+	return *t.OutDiscardedPkts
+}
+
+// GetOutErrorPkts retrieves the value of the leaf OutErrorPkts from the Interface_Subinterface_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutErrorPkts is set, it can
+// safely use t.GetOutErrorPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutErrorPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Counters) GetOutErrorPkts() uint64 {
+	if t == nil || t.OutErrorPkts == nil {
+		return 0
+	}
+	return *t.OutErrorPkts
+}
+
+// GetOutForwardedOctets retrieves the value of the leaf OutForwardedOctets from the Interface_Subinterface_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutForwardedOctets is set, it can
+// safely use t.GetOutForwardedOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutForwardedOctets == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Counters) GetOutForwardedOctets() uint64 {
+	if t == nil || t.OutForwardedOctets == nil {
+		return 0
+	}
+	return *t.OutForwardedOctets
+}
+
+// GetOutForwardedPkts retrieves the value of the leaf OutForwardedPkts from the Interface_Subinterface_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutForwardedPkts is set, it can
+// safely use t.GetOutForwardedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutForwardedPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Counters) GetOutForwardedPkts() uint64 {
+	if t == nil || t.OutForwardedPkts == nil {
+		return 0
+	}
+	return *t.OutForwardedPkts
+}
+
+// GetOutMulticastOctets retrieves the value of the leaf OutMulticastOctets from the Interface_Subinterface_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutMulticastOctets is set, it can
+// safely use t.GetOutMulticastOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutMulticastOctets == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Counters) GetOutMulticastOctets() uint64 {
+	if t == nil || t.OutMulticastOctets == nil {
+		return 0
+	}
+	return *t.OutMulticastOctets
+}
+
+// GetOutMulticastPkts retrieves the value of the leaf OutMulticastPkts from the Interface_Subinterface_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutMulticastPkts is set, it can
+// safely use t.GetOutMulticastPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutMulticastPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Counters) GetOutMulticastPkts() uint64 {
+	if t == nil || t.OutMulticastPkts == nil {
+		return 0
+	}
+	return *t.OutMulticastPkts
+}
+
+// GetOutOctets retrieves the value of the leaf OutOctets from the Interface_Subinterface_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutOctets is set, it can
+// safely use t.GetOutOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutOctets == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Counters) GetOutOctets() uint64 {
+	if t == nil || t.OutOctets == nil {
+		return 0
+	}
+	return *t.OutOctets
+}
+
+// GetOutPkts retrieves the value of the leaf OutPkts from the Interface_Subinterface_Ipv6_Counters
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OutPkts is set, it can
+// safely use t.GetOutPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OutPkts == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Counters) GetOutPkts() uint64 {
+	if t == nil || t.OutPkts == nil {
+		return 0
+	}
+	return *t.OutPkts
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv6_Counters
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv6_Counters) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv6_Counters.
+func (*Interface_Subinterface_Ipv6_Counters) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv6_Neighbor represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6/neighbors/neighbor YANG schema element.
+type Interface_Subinterface_Ipv6_Neighbor struct {
+	Ip               *string                         `path:"state/ip|ip" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip" shadow-path:"config/ip|ip" shadow-module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
+	IsRouter         *bool                           `path:"state/is-router" module:"openconfig-if-ip/openconfig-if-ip"`
+	LinkLayerAddress *string                         `path:"state/link-layer-address" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/link-layer-address" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	NeighborState    E_Neighbor_NeighborState        `path:"state/neighbor-state" module:"openconfig-if-ip/openconfig-if-ip"`
+	Origin           E_OpenconfigIfIp_NeighborOrigin `path:"state/origin" module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6_Neighbor implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv6_Neighbor) IsYANGGoStruct() {}
+
+// GetIp retrieves the value of the leaf Ip from the Interface_Subinterface_Ipv6_Neighbor
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Ip is set, it can
+// safely use t.GetIp() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Ip == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Neighbor) GetIp() string {
+	if t == nil || t.Ip == nil {
+		return ""
+	}
+	return *t.Ip
+}
+
+// GetIsRouter retrieves the value of the leaf IsRouter from the Interface_Subinterface_Ipv6_Neighbor
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if IsRouter is set, it can
+// safely use t.GetIsRouter() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.IsRouter == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Neighbor) GetIsRouter() bool {
+	if t == nil || t.IsRouter == nil {
+		return false
+	}
+	return *t.IsRouter
+}
+
+// GetLinkLayerAddress retrieves the value of the leaf LinkLayerAddress from the Interface_Subinterface_Ipv6_Neighbor
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if LinkLayerAddress is set, it can
+// safely use t.GetLinkLayerAddress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.LinkLayerAddress == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Neighbor) GetLinkLayerAddress() string {
+	if t == nil || t.LinkLayerAddress == nil {
+		return ""
+	}
+	return *t.LinkLayerAddress
+}
+
+// GetNeighborState retrieves the value of the leaf NeighborState from the Interface_Subinterface_Ipv6_Neighbor
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if NeighborState is set, it can
+// safely use t.GetNeighborState() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.NeighborState == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Neighbor) GetNeighborState() E_Neighbor_NeighborState {
+	if t == nil || t.NeighborState == 0 {
+		return 0
+	}
+	return t.NeighborState
+}
+
+// GetOrigin retrieves the value of the leaf Origin from the Interface_Subinterface_Ipv6_Neighbor
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Origin is set, it can
+// safely use t.GetOrigin() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Origin == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Neighbor) GetOrigin() E_OpenconfigIfIp_NeighborOrigin {
+	if t == nil || t.Origin == 0 {
+		return 0
+	}
+	return t.Origin
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv6_Neighbor
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv6_Neighbor) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛListKeyMap returns the keys of the Interface_Subinterface_Ipv6_Neighbor struct, which is a YANG list entry.
+func (t *Interface_Subinterface_Ipv6_Neighbor) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Ip == nil {
+		return nil, fmt.Errorf("nil value for key Ip")
+	}
+
+	return map[string]interface{}{
+		"ip": *t.Ip,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv6_Neighbor.
+func (*Interface_Subinterface_Ipv6_Neighbor) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv6_RouterAdvertisement represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6/router-advertisement YANG schema element.
+type Interface_Subinterface_Ipv6_RouterAdvertisement struct {
+	Enable      *bool                                                              `path:"state/enable" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/enable" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Interval    *uint32                                                            `path:"state/interval" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/interval" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Lifetime    *uint32                                                            `path:"state/lifetime" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/lifetime" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Managed     *bool                                                              `path:"state/managed" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/managed" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Mode        E_RouterAdvertisement_Mode                                         `path:"state/mode" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/mode" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	OtherConfig *bool                                                              `path:"state/other-config" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/other-config" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Prefix      map[string]*Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix `path:"prefixes/prefix" module:"openconfig-if-ip/openconfig-if-ip"`
+	Suppress    *bool                                                              `path:"state/suppress" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/suppress" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6_RouterAdvertisement implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv6_RouterAdvertisement) IsYANGGoStruct() {}
+
+// NewPrefix creates a new entry in the Prefix list of the
+// Interface_Subinterface_Ipv6_RouterAdvertisement struct. The keys of the list are populated from the input
+// arguments.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement) NewPrefix(Prefix string) (*Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Prefix == nil {
+		t.Prefix = make(map[string]*Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix)
+	}
+
+	key := Prefix
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Prefix[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Prefix", key)
+	}
+
+	t.Prefix[key] = &Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix{
+		Prefix: &Prefix,
+	}
+
+	return t.Prefix[key], nil
+}
+
+// GetOrCreatePrefixMap returns the list (map) from Interface_Subinterface_Ipv6_RouterAdvertisement.
+//
+// It initializes the field if not already initialized.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement) GetOrCreatePrefixMap() map[string]*Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix {
+	if t.Prefix == nil {
+		t.Prefix = make(map[string]*Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix)
+	}
+	return t.Prefix
+}
+
+// GetOrCreatePrefix retrieves the value with the specified keys from
+// the receiver Interface_Subinterface_Ipv6_RouterAdvertisement. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement) GetOrCreatePrefix(Prefix string) *Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix {
+
+	key := Prefix
+
+	if v, ok := t.Prefix[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewPrefix(Prefix)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreatePrefix got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetPrefix retrieves the value with the specified key from
+// the Prefix map field of Interface_Subinterface_Ipv6_RouterAdvertisement. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement) GetPrefix(Prefix string) *Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Prefix
+
+	if lm, ok := t.Prefix[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeletePrefix deletes the value with the specified keys from
+// the receiver Interface_Subinterface_Ipv6_RouterAdvertisement. If there is no such element, the function
+// is a no-op.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement) DeletePrefix(Prefix string) {
+	key := Prefix
+
+	delete(t.Prefix, key)
+}
+
+// GetEnable retrieves the value of the leaf Enable from the Interface_Subinterface_Ipv6_RouterAdvertisement
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Enable is set, it can
+// safely use t.GetEnable() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Enable == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement) GetEnable() bool {
+	if t == nil || t.Enable == nil {
+		return true
+	}
+	return *t.Enable
+}
+
+// GetInterval retrieves the value of the leaf Interval from the Interface_Subinterface_Ipv6_RouterAdvertisement
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Interval is set, it can
+// safely use t.GetInterval() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Interval == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement) GetInterval() uint32 {
+	if t == nil || t.Interval == nil {
+		return 0
+	}
+	return *t.Interval
+}
+
+// GetLifetime retrieves the value of the leaf Lifetime from the Interface_Subinterface_Ipv6_RouterAdvertisement
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Lifetime is set, it can
+// safely use t.GetLifetime() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Lifetime == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement) GetLifetime() uint32 {
+	if t == nil || t.Lifetime == nil {
+		return 0
+	}
+	return *t.Lifetime
+}
+
+// GetManaged retrieves the value of the leaf Managed from the Interface_Subinterface_Ipv6_RouterAdvertisement
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Managed is set, it can
+// safely use t.GetManaged() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Managed == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement) GetManaged() bool {
+	if t == nil || t.Managed == nil {
+		return false
+	}
+	return *t.Managed
+}
+
+// GetMode retrieves the value of the leaf Mode from the Interface_Subinterface_Ipv6_RouterAdvertisement
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Mode is set, it can
+// safely use t.GetMode() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Mode == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement) GetMode() E_RouterAdvertisement_Mode {
+	if t == nil || t.Mode == 0 {
+		return RouterAdvertisement_Mode_ALL
+	}
+	return t.Mode
+}
+
+// GetOtherConfig retrieves the value of the leaf OtherConfig from the Interface_Subinterface_Ipv6_RouterAdvertisement
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OtherConfig is set, it can
+// safely use t.GetOtherConfig() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OtherConfig == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement) GetOtherConfig() bool {
+	if t == nil || t.OtherConfig == nil {
+		return false
+	}
+	return *t.OtherConfig
+}
+
+// GetSuppress retrieves the value of the leaf Suppress from the Interface_Subinterface_Ipv6_RouterAdvertisement
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Suppress is set, it can
+// safely use t.GetSuppress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Suppress == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement) GetSuppress() bool {
+	if t == nil || t.Suppress == nil {
+		return false
+	}
+	return *t.Suppress
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv6_RouterAdvertisement
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Enable == nil {
+		var v bool = true
+		t.Enable = &v
+	}
+	if t.Managed == nil {
+		var v bool = false
+		t.Managed = &v
+	}
+	if t.Mode == 0 {
+		t.Mode = RouterAdvertisement_Mode_ALL
+	}
+	if t.OtherConfig == nil {
+		var v bool = false
+		t.OtherConfig = &v
+	}
+	if t.Suppress == nil {
+		var v bool = false
+		t.Suppress = &v
+	}
+	for _, e := range t.Prefix {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv6_RouterAdvertisement.
+func (*Interface_Subinterface_Ipv6_RouterAdvertisement) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6/router-advertisement/prefixes/prefix YANG schema element.
+type Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix struct {
+	DisableAdvertisement     *bool   `path:"state/disable-advertisement" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/disable-advertisement" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	DisableAutoconfiguration *bool   `path:"state/disable-autoconfiguration" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/disable-autoconfiguration" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	EnableOnlink             *bool   `path:"state/enable-onlink" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/enable-onlink" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	PreferredLifetime        *uint32 `path:"state/preferred-lifetime" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/preferred-lifetime" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Prefix                   *string `path:"state/prefix|prefix" module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip" shadow-path:"config/prefix|prefix" shadow-module:"openconfig-if-ip/openconfig-if-ip|openconfig-if-ip"`
+	ValidLifetime            *uint32 `path:"state/valid-lifetime" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/valid-lifetime" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix) IsYANGGoStruct() {}
+
+// GetDisableAdvertisement retrieves the value of the leaf DisableAdvertisement from the Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DisableAdvertisement is set, it can
+// safely use t.GetDisableAdvertisement() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DisableAdvertisement == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix) GetDisableAdvertisement() bool {
+	if t == nil || t.DisableAdvertisement == nil {
+		return false
+	}
+	return *t.DisableAdvertisement
+}
+
+// GetDisableAutoconfiguration retrieves the value of the leaf DisableAutoconfiguration from the Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DisableAutoconfiguration is set, it can
+// safely use t.GetDisableAutoconfiguration() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DisableAutoconfiguration == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix) GetDisableAutoconfiguration() bool {
+	if t == nil || t.DisableAutoconfiguration == nil {
+		return false
+	}
+	return *t.DisableAutoconfiguration
+}
+
+// GetEnableOnlink retrieves the value of the leaf EnableOnlink from the Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if EnableOnlink is set, it can
+// safely use t.GetEnableOnlink() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.EnableOnlink == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix) GetEnableOnlink() bool {
+	if t == nil || t.EnableOnlink == nil {
+		return false
+	}
+	return *t.EnableOnlink
+}
+
+// GetPreferredLifetime retrieves the value of the leaf PreferredLifetime from the Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PreferredLifetime is set, it can
+// safely use t.GetPreferredLifetime() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PreferredLifetime == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix) GetPreferredLifetime() uint32 {
+	if t == nil || t.PreferredLifetime == nil {
+		return 0
+	}
+	return *t.PreferredLifetime
+}
+
+// GetPrefix retrieves the value of the leaf Prefix from the Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Prefix is set, it can
+// safely use t.GetPrefix() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Prefix == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix) GetPrefix() string {
+	if t == nil || t.Prefix == nil {
+		return ""
+	}
+	return *t.Prefix
+}
+
+// GetValidLifetime retrieves the value of the leaf ValidLifetime from the Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if ValidLifetime is set, it can
+// safely use t.GetValidLifetime() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.ValidLifetime == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix) GetValidLifetime() uint32 {
+	if t == nil || t.ValidLifetime == nil {
+		return 0
+	}
+	return *t.ValidLifetime
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛListKeyMap returns the keys of the Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix struct, which is a YANG list entry.
+func (t *Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Prefix == nil {
+		return nil, fmt.Errorf("nil value for key Prefix")
+	}
+
+	return map[string]interface{}{
+		"prefix": *t.Prefix,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix.
+func (*Interface_Subinterface_Ipv6_RouterAdvertisement_Prefix) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv6_Unnumbered represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6/unnumbered YANG schema element.
+type Interface_Subinterface_Ipv6_Unnumbered struct {
+	Enabled      *bool                                                `path:"state/enabled" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/enabled" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	InterfaceRef *Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef `path:"interface-ref" module:"openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6_Unnumbered implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv6_Unnumbered) IsYANGGoStruct() {}
+
+// GetOrCreateInterfaceRef retrieves the value of the InterfaceRef field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Ipv6_Unnumbered) GetOrCreateInterfaceRef() *Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef {
+	if t.InterfaceRef != nil {
+		return t.InterfaceRef
+	}
+	t.InterfaceRef = &Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef{}
+	return t.InterfaceRef
+}
+
+// GetInterfaceRef returns the value of the InterfaceRef struct pointer
+// from Interface_Subinterface_Ipv6_Unnumbered. If the receiver or the field InterfaceRef is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Ipv6_Unnumbered) GetInterfaceRef() *Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef {
+	if t != nil && t.InterfaceRef != nil {
+		return t.InterfaceRef
+	}
+	return nil
+}
+
+// GetEnabled retrieves the value of the leaf Enabled from the Interface_Subinterface_Ipv6_Unnumbered
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Enabled is set, it can
+// safely use t.GetEnabled() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Enabled == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Unnumbered) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv6_Unnumbered
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv6_Unnumbered) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	if t.Enabled == nil {
+		var v bool = false
+		t.Enabled = &v
+	}
+	t.InterfaceRef.PopulateDefaults()
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv6_Unnumbered.
+func (*Interface_Subinterface_Ipv6_Unnumbered) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/ipv6/unnumbered/interface-ref YANG schema element.
+type Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef struct {
+	Interface    *string `path:"state/interface" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/interface" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+	Subinterface *uint32 `path:"state/subinterface" module:"openconfig-if-ip/openconfig-if-ip" shadow-path:"config/subinterface" shadow-module:"openconfig-if-ip/openconfig-if-ip"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef) IsYANGGoStruct() {}
+
+// GetInterface retrieves the value of the leaf Interface from the Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Interface is set, it can
+// safely use t.GetInterface() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Interface == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef) GetInterface() string {
+	if t == nil || t.Interface == nil {
+		return ""
+	}
+	return *t.Interface
+}
+
+// GetSubinterface retrieves the value of the leaf Subinterface from the Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Subinterface is set, it can
+// safely use t.GetSubinterface() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Subinterface == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef) GetSubinterface() uint32 {
+	if t == nil || t.Subinterface == nil {
+		return 0
+	}
+	return *t.Subinterface
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef.
+func (*Interface_Subinterface_Ipv6_Unnumbered_InterfaceRef) ΛBelongingModule() string {
+	return "openconfig-if-ip"
+}
+
+// Interface_Subinterface_Vlan represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan YANG schema element.
+type Interface_Subinterface_Vlan struct {
+	EgressMapping  *Interface_Subinterface_Vlan_EgressMapping  `path:"egress-mapping" module:"openconfig-vlan"`
+	IngressMapping *Interface_Subinterface_Vlan_IngressMapping `path:"ingress-mapping" module:"openconfig-vlan"`
+	Match          *Interface_Subinterface_Vlan_Match          `path:"match" module:"openconfig-vlan"`
+	VlanId         Interface_Subinterface_Vlan_VlanId_Union    `path:"state/vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Vlan implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Vlan) IsYANGGoStruct() {}
+
+// GetOrCreateEgressMapping retrieves the value of the EgressMapping field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Vlan) GetOrCreateEgressMapping() *Interface_Subinterface_Vlan_EgressMapping {
+	if t.EgressMapping != nil {
+		return t.EgressMapping
+	}
+	t.EgressMapping = &Interface_Subinterface_Vlan_EgressMapping{}
+	return t.EgressMapping
+}
+
+// GetOrCreateIngressMapping retrieves the value of the IngressMapping field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Vlan) GetOrCreateIngressMapping() *Interface_Subinterface_Vlan_IngressMapping {
+	if t.IngressMapping != nil {
+		return t.IngressMapping
+	}
+	t.IngressMapping = &Interface_Subinterface_Vlan_IngressMapping{}
+	return t.IngressMapping
+}
+
+// GetOrCreateMatch retrieves the value of the Match field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Vlan) GetOrCreateMatch() *Interface_Subinterface_Vlan_Match {
+	if t.Match != nil {
+		return t.Match
+	}
+	t.Match = &Interface_Subinterface_Vlan_Match{}
+	return t.Match
+}
+
+// GetEgressMapping returns the value of the EgressMapping struct pointer
+// from Interface_Subinterface_Vlan. If the receiver or the field EgressMapping is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Vlan) GetEgressMapping() *Interface_Subinterface_Vlan_EgressMapping {
+	if t != nil && t.EgressMapping != nil {
+		return t.EgressMapping
+	}
+	return nil
+}
+
+// GetIngressMapping returns the value of the IngressMapping struct pointer
+// from Interface_Subinterface_Vlan. If the receiver or the field IngressMapping is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Vlan) GetIngressMapping() *Interface_Subinterface_Vlan_IngressMapping {
+	if t != nil && t.IngressMapping != nil {
+		return t.IngressMapping
+	}
+	return nil
+}
+
+// GetMatch returns the value of the Match struct pointer
+// from Interface_Subinterface_Vlan. If the receiver or the field Match is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Vlan) GetMatch() *Interface_Subinterface_Vlan_Match {
+	if t != nil && t.Match != nil {
+		return t.Match
+	}
+	return nil
+}
+
+// GetVlanId retrieves the value of the leaf VlanId from the Interface_Subinterface_Vlan
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VlanId is set, it can
+// safely use t.GetVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan) GetVlanId() Interface_Subinterface_Vlan_VlanId_Union {
+	if t == nil || t.VlanId == nil {
+		return nil
+	}
+	return t.VlanId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Vlan
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Vlan) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	t.EgressMapping.PopulateDefaults()
+	t.IngressMapping.PopulateDefaults()
+	t.Match.PopulateDefaults()
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Vlan.
+func (*Interface_Subinterface_Vlan) ΛBelongingModule() string {
+	return "openconfig-vlan"
+}
+
+// Interface_Subinterface_Vlan_VlanId_Union is an interface that is implemented by valid types for the union
+// for the leaf /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/state/vlan-id within the YANG schema.
+type Interface_Subinterface_Vlan_VlanId_Union interface {
+	Is_Interface_Subinterface_Vlan_VlanId_Union()
+}
+
+// Interface_Subinterface_Vlan_VlanId_Union_String is used when /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/state/vlan-id
+// is to be set to a string value.
+type Interface_Subinterface_Vlan_VlanId_Union_String struct {
+	String string
+}
+
+// Is_Interface_Subinterface_Vlan_VlanId_Union ensures that Interface_Subinterface_Vlan_VlanId_Union_String
+// implements the Interface_Subinterface_Vlan_VlanId_Union interface.
+func (*Interface_Subinterface_Vlan_VlanId_Union_String) Is_Interface_Subinterface_Vlan_VlanId_Union() {
+}
+
+// Interface_Subinterface_Vlan_VlanId_Union_Uint16 is used when /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/state/vlan-id
+// is to be set to a uint16 value.
+type Interface_Subinterface_Vlan_VlanId_Union_Uint16 struct {
+	Uint16 uint16
+}
+
+// Is_Interface_Subinterface_Vlan_VlanId_Union ensures that Interface_Subinterface_Vlan_VlanId_Union_Uint16
+// implements the Interface_Subinterface_Vlan_VlanId_Union interface.
+func (*Interface_Subinterface_Vlan_VlanId_Union_Uint16) Is_Interface_Subinterface_Vlan_VlanId_Union() {
+}
+
+// To_Interface_Subinterface_Vlan_VlanId_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the Interface_Subinterface_Vlan_VlanId_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *Interface_Subinterface_Vlan) To_Interface_Subinterface_Vlan_VlanId_Union(i interface{}) (Interface_Subinterface_Vlan_VlanId_Union, error) {
+	switch v := i.(type) {
+	case string:
+		return &Interface_Subinterface_Vlan_VlanId_Union_String{v}, nil
+	case uint16:
+		return &Interface_Subinterface_Vlan_VlanId_Union_Uint16{v}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %v to Interface_Subinterface_Vlan_VlanId_Union, unknown union type, got: %T, want any of [string, uint16]", i, i)
+	}
+}
+
+// Interface_Subinterface_Vlan_EgressMapping represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/egress-mapping YANG schema element.
+type Interface_Subinterface_Vlan_EgressMapping struct {
+	Tpid            E_OpenconfigVlanTypes_TPID_TYPES `path:"state/tpid" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/tpid" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	VlanId          *uint16                          `path:"state/vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	VlanStackAction E_OpenconfigVlan_VlanStackAction `path:"state/vlan-stack-action" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/vlan-stack-action" shadow-module:"openconfig-vlan/openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Vlan_EgressMapping implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Vlan_EgressMapping) IsYANGGoStruct() {}
+
+// GetTpid retrieves the value of the leaf Tpid from the Interface_Subinterface_Vlan_EgressMapping
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Tpid is set, it can
+// safely use t.GetTpid() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Tpid == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_EgressMapping) GetTpid() E_OpenconfigVlanTypes_TPID_TYPES {
+	if t == nil || t.Tpid == 0 {
+		return 0
+	}
+	return t.Tpid
+}
+
+// GetVlanId retrieves the value of the leaf VlanId from the Interface_Subinterface_Vlan_EgressMapping
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VlanId is set, it can
+// safely use t.GetVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_EgressMapping) GetVlanId() uint16 {
+	if t == nil || t.VlanId == nil {
+		return 0
+	}
+	return *t.VlanId
+}
+
+// GetVlanStackAction retrieves the value of the leaf VlanStackAction from the Interface_Subinterface_Vlan_EgressMapping
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VlanStackAction is set, it can
+// safely use t.GetVlanStackAction() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VlanStackAction == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_EgressMapping) GetVlanStackAction() E_OpenconfigVlan_VlanStackAction {
+	if t == nil || t.VlanStackAction == 0 {
+		return 0
+	}
+	return t.VlanStackAction
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Vlan_EgressMapping
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Vlan_EgressMapping) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Vlan_EgressMapping.
+func (*Interface_Subinterface_Vlan_EgressMapping) ΛBelongingModule() string {
+	return "openconfig-vlan"
+}
+
+// Interface_Subinterface_Vlan_IngressMapping represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/ingress-mapping YANG schema element.
+type Interface_Subinterface_Vlan_IngressMapping struct {
+	Tpid            E_OpenconfigVlanTypes_TPID_TYPES `path:"state/tpid" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/tpid" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	VlanId          *uint16                          `path:"state/vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	VlanStackAction E_OpenconfigVlan_VlanStackAction `path:"state/vlan-stack-action" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/vlan-stack-action" shadow-module:"openconfig-vlan/openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Vlan_IngressMapping implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Vlan_IngressMapping) IsYANGGoStruct() {}
+
+// GetTpid retrieves the value of the leaf Tpid from the Interface_Subinterface_Vlan_IngressMapping
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Tpid is set, it can
+// safely use t.GetTpid() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Tpid == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_IngressMapping) GetTpid() E_OpenconfigVlanTypes_TPID_TYPES {
+	if t == nil || t.Tpid == 0 {
+		return 0
+	}
+	return t.Tpid
+}
+
+// GetVlanId retrieves the value of the leaf VlanId from the Interface_Subinterface_Vlan_IngressMapping
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VlanId is set, it can
+// safely use t.GetVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_IngressMapping) GetVlanId() uint16 {
+	if t == nil || t.VlanId == nil {
+		return 0
+	}
+	return *t.VlanId
+}
+
+// GetVlanStackAction retrieves the value of the leaf VlanStackAction from the Interface_Subinterface_Vlan_IngressMapping
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VlanStackAction is set, it can
+// safely use t.GetVlanStackAction() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VlanStackAction == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_IngressMapping) GetVlanStackAction() E_OpenconfigVlan_VlanStackAction {
+	if t == nil || t.VlanStackAction == 0 {
+		return 0
+	}
+	return t.VlanStackAction
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Vlan_IngressMapping
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Vlan_IngressMapping) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Vlan_IngressMapping.
+func (*Interface_Subinterface_Vlan_IngressMapping) ΛBelongingModule() string {
+	return "openconfig-vlan"
+}
+
+// Interface_Subinterface_Vlan_Match represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/match YANG schema element.
+type Interface_Subinterface_Vlan_Match struct {
+	DoubleTagged                *Interface_Subinterface_Vlan_Match_DoubleTagged                `path:"double-tagged" module:"openconfig-vlan"`
+	DoubleTaggedInnerList       *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList       `path:"double-tagged-inner-list" module:"openconfig-vlan"`
+	DoubleTaggedInnerOuterRange *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange `path:"double-tagged-inner-outer-range" module:"openconfig-vlan"`
+	DoubleTaggedInnerRange      *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange      `path:"double-tagged-inner-range" module:"openconfig-vlan"`
+	DoubleTaggedOuterList       *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList       `path:"double-tagged-outer-list" module:"openconfig-vlan"`
+	DoubleTaggedOuterRange      *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange      `path:"double-tagged-outer-range" module:"openconfig-vlan"`
+	SingleTagged                *Interface_Subinterface_Vlan_Match_SingleTagged                `path:"single-tagged" module:"openconfig-vlan"`
+	SingleTaggedList            *Interface_Subinterface_Vlan_Match_SingleTaggedList            `path:"single-tagged-list" module:"openconfig-vlan"`
+	SingleTaggedRange           *Interface_Subinterface_Vlan_Match_SingleTaggedRange           `path:"single-tagged-range" module:"openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Vlan_Match implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Vlan_Match) IsYANGGoStruct() {}
+
+// GetOrCreateDoubleTagged retrieves the value of the DoubleTagged field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Vlan_Match) GetOrCreateDoubleTagged() *Interface_Subinterface_Vlan_Match_DoubleTagged {
+	if t.DoubleTagged != nil {
+		return t.DoubleTagged
+	}
+	t.DoubleTagged = &Interface_Subinterface_Vlan_Match_DoubleTagged{}
+	return t.DoubleTagged
+}
+
+// GetOrCreateDoubleTaggedInnerList retrieves the value of the DoubleTaggedInnerList field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Vlan_Match) GetOrCreateDoubleTaggedInnerList() *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList {
+	if t.DoubleTaggedInnerList != nil {
+		return t.DoubleTaggedInnerList
+	}
+	t.DoubleTaggedInnerList = &Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList{}
+	return t.DoubleTaggedInnerList
+}
+
+// GetOrCreateDoubleTaggedInnerOuterRange retrieves the value of the DoubleTaggedInnerOuterRange field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Vlan_Match) GetOrCreateDoubleTaggedInnerOuterRange() *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange {
+	if t.DoubleTaggedInnerOuterRange != nil {
+		return t.DoubleTaggedInnerOuterRange
+	}
+	t.DoubleTaggedInnerOuterRange = &Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange{}
+	return t.DoubleTaggedInnerOuterRange
+}
+
+// GetOrCreateDoubleTaggedInnerRange retrieves the value of the DoubleTaggedInnerRange field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Vlan_Match) GetOrCreateDoubleTaggedInnerRange() *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange {
+	if t.DoubleTaggedInnerRange != nil {
+		return t.DoubleTaggedInnerRange
+	}
+	t.DoubleTaggedInnerRange = &Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange{}
+	return t.DoubleTaggedInnerRange
+}
+
+// GetOrCreateDoubleTaggedOuterList retrieves the value of the DoubleTaggedOuterList field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Vlan_Match) GetOrCreateDoubleTaggedOuterList() *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList {
+	if t.DoubleTaggedOuterList != nil {
+		return t.DoubleTaggedOuterList
+	}
+	t.DoubleTaggedOuterList = &Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList{}
+	return t.DoubleTaggedOuterList
+}
+
+// GetOrCreateDoubleTaggedOuterRange retrieves the value of the DoubleTaggedOuterRange field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Vlan_Match) GetOrCreateDoubleTaggedOuterRange() *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange {
+	if t.DoubleTaggedOuterRange != nil {
+		return t.DoubleTaggedOuterRange
+	}
+	t.DoubleTaggedOuterRange = &Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange{}
+	return t.DoubleTaggedOuterRange
+}
+
+// GetOrCreateSingleTagged retrieves the value of the SingleTagged field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Vlan_Match) GetOrCreateSingleTagged() *Interface_Subinterface_Vlan_Match_SingleTagged {
+	if t.SingleTagged != nil {
+		return t.SingleTagged
+	}
+	t.SingleTagged = &Interface_Subinterface_Vlan_Match_SingleTagged{}
+	return t.SingleTagged
+}
+
+// GetOrCreateSingleTaggedList retrieves the value of the SingleTaggedList field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Vlan_Match) GetOrCreateSingleTaggedList() *Interface_Subinterface_Vlan_Match_SingleTaggedList {
+	if t.SingleTaggedList != nil {
+		return t.SingleTaggedList
+	}
+	t.SingleTaggedList = &Interface_Subinterface_Vlan_Match_SingleTaggedList{}
+	return t.SingleTaggedList
+}
+
+// GetOrCreateSingleTaggedRange retrieves the value of the SingleTaggedRange field
+// or returns the existing field if it already exists.
+func (t *Interface_Subinterface_Vlan_Match) GetOrCreateSingleTaggedRange() *Interface_Subinterface_Vlan_Match_SingleTaggedRange {
+	if t.SingleTaggedRange != nil {
+		return t.SingleTaggedRange
+	}
+	t.SingleTaggedRange = &Interface_Subinterface_Vlan_Match_SingleTaggedRange{}
+	return t.SingleTaggedRange
+}
+
+// GetDoubleTagged returns the value of the DoubleTagged struct pointer
+// from Interface_Subinterface_Vlan_Match. If the receiver or the field DoubleTagged is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Vlan_Match) GetDoubleTagged() *Interface_Subinterface_Vlan_Match_DoubleTagged {
+	if t != nil && t.DoubleTagged != nil {
+		return t.DoubleTagged
+	}
+	return nil
+}
+
+// GetDoubleTaggedInnerList returns the value of the DoubleTaggedInnerList struct pointer
+// from Interface_Subinterface_Vlan_Match. If the receiver or the field DoubleTaggedInnerList is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Vlan_Match) GetDoubleTaggedInnerList() *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList {
+	if t != nil && t.DoubleTaggedInnerList != nil {
+		return t.DoubleTaggedInnerList
+	}
+	return nil
+}
+
+// GetDoubleTaggedInnerOuterRange returns the value of the DoubleTaggedInnerOuterRange struct pointer
+// from Interface_Subinterface_Vlan_Match. If the receiver or the field DoubleTaggedInnerOuterRange is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Vlan_Match) GetDoubleTaggedInnerOuterRange() *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange {
+	if t != nil && t.DoubleTaggedInnerOuterRange != nil {
+		return t.DoubleTaggedInnerOuterRange
+	}
+	return nil
+}
+
+// GetDoubleTaggedInnerRange returns the value of the DoubleTaggedInnerRange struct pointer
+// from Interface_Subinterface_Vlan_Match. If the receiver or the field DoubleTaggedInnerRange is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Vlan_Match) GetDoubleTaggedInnerRange() *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange {
+	if t != nil && t.DoubleTaggedInnerRange != nil {
+		return t.DoubleTaggedInnerRange
+	}
+	return nil
+}
+
+// GetDoubleTaggedOuterList returns the value of the DoubleTaggedOuterList struct pointer
+// from Interface_Subinterface_Vlan_Match. If the receiver or the field DoubleTaggedOuterList is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Vlan_Match) GetDoubleTaggedOuterList() *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList {
+	if t != nil && t.DoubleTaggedOuterList != nil {
+		return t.DoubleTaggedOuterList
+	}
+	return nil
+}
+
+// GetDoubleTaggedOuterRange returns the value of the DoubleTaggedOuterRange struct pointer
+// from Interface_Subinterface_Vlan_Match. If the receiver or the field DoubleTaggedOuterRange is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Vlan_Match) GetDoubleTaggedOuterRange() *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange {
+	if t != nil && t.DoubleTaggedOuterRange != nil {
+		return t.DoubleTaggedOuterRange
+	}
+	return nil
+}
+
+// GetSingleTagged returns the value of the SingleTagged struct pointer
+// from Interface_Subinterface_Vlan_Match. If the receiver or the field SingleTagged is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Vlan_Match) GetSingleTagged() *Interface_Subinterface_Vlan_Match_SingleTagged {
+	if t != nil && t.SingleTagged != nil {
+		return t.SingleTagged
+	}
+	return nil
+}
+
+// GetSingleTaggedList returns the value of the SingleTaggedList struct pointer
+// from Interface_Subinterface_Vlan_Match. If the receiver or the field SingleTaggedList is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Vlan_Match) GetSingleTaggedList() *Interface_Subinterface_Vlan_Match_SingleTaggedList {
+	if t != nil && t.SingleTaggedList != nil {
+		return t.SingleTaggedList
+	}
+	return nil
+}
+
+// GetSingleTaggedRange returns the value of the SingleTaggedRange struct pointer
+// from Interface_Subinterface_Vlan_Match. If the receiver or the field SingleTaggedRange is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Interface_Subinterface_Vlan_Match) GetSingleTaggedRange() *Interface_Subinterface_Vlan_Match_SingleTaggedRange {
+	if t != nil && t.SingleTaggedRange != nil {
+		return t.SingleTaggedRange
+	}
+	return nil
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Vlan_Match
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Vlan_Match) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	t.DoubleTagged.PopulateDefaults()
+	t.DoubleTaggedInnerList.PopulateDefaults()
+	t.DoubleTaggedInnerOuterRange.PopulateDefaults()
+	t.DoubleTaggedInnerRange.PopulateDefaults()
+	t.DoubleTaggedOuterList.PopulateDefaults()
+	t.DoubleTaggedOuterRange.PopulateDefaults()
+	t.SingleTagged.PopulateDefaults()
+	t.SingleTaggedList.PopulateDefaults()
+	t.SingleTaggedRange.PopulateDefaults()
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Vlan_Match.
+func (*Interface_Subinterface_Vlan_Match) ΛBelongingModule() string {
+	return "openconfig-vlan"
+}
+
+// Interface_Subinterface_Vlan_Match_DoubleTagged represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/match/double-tagged YANG schema element.
+type Interface_Subinterface_Vlan_Match_DoubleTagged struct {
+	InnerVlanId *uint16 `path:"state/inner-vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/inner-vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	OuterVlanId *uint16 `path:"state/outer-vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/outer-vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Vlan_Match_DoubleTagged implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Vlan_Match_DoubleTagged) IsYANGGoStruct() {}
+
+// GetInnerVlanId retrieves the value of the leaf InnerVlanId from the Interface_Subinterface_Vlan_Match_DoubleTagged
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InnerVlanId is set, it can
+// safely use t.GetInnerVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InnerVlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTagged) GetInnerVlanId() uint16 {
+	if t == nil || t.InnerVlanId == nil {
+		return 0
+	}
+	return *t.InnerVlanId
+}
+
+// GetOuterVlanId retrieves the value of the leaf OuterVlanId from the Interface_Subinterface_Vlan_Match_DoubleTagged
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OuterVlanId is set, it can
+// safely use t.GetOuterVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OuterVlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTagged) GetOuterVlanId() uint16 {
+	if t == nil || t.OuterVlanId == nil {
+		return 0
+	}
+	return *t.OuterVlanId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Vlan_Match_DoubleTagged
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTagged) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Vlan_Match_DoubleTagged.
+func (*Interface_Subinterface_Vlan_Match_DoubleTagged) ΛBelongingModule() string {
+	return "openconfig-vlan"
+}
+
+// Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/match/double-tagged-inner-list YANG schema element.
+type Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList struct {
+	InnerVlanIds []uint16 `path:"state/inner-vlan-ids" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/inner-vlan-ids" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	OuterVlanId  *uint16  `path:"state/outer-vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/outer-vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList) IsYANGGoStruct() {}
+
+// GetInnerVlanIds retrieves the value of the leaf InnerVlanIds from the Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InnerVlanIds is set, it can
+// safely use t.GetInnerVlanIds() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InnerVlanIds == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList) GetInnerVlanIds() []uint16 {
+	if t == nil || t.InnerVlanIds == nil {
+		return nil
+	}
+	return t.InnerVlanIds
+}
+
+// GetOuterVlanId retrieves the value of the leaf OuterVlanId from the Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OuterVlanId is set, it can
+// safely use t.GetOuterVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OuterVlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList) GetOuterVlanId() uint16 {
+	if t == nil || t.OuterVlanId == nil {
+		return 0
+	}
+	return *t.OuterVlanId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList.
+func (*Interface_Subinterface_Vlan_Match_DoubleTaggedInnerList) ΛBelongingModule() string {
+	return "openconfig-vlan"
+}
+
+// Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/match/double-tagged-inner-outer-range YANG schema element.
+type Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange struct {
+	InnerHighVlanId *uint16 `path:"state/inner-high-vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/inner-high-vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	InnerLowVlanId  *uint16 `path:"state/inner-low-vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/inner-low-vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	OuterHighVlanId *uint16 `path:"state/outer-high-vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/outer-high-vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	OuterLowVlanId  *uint16 `path:"state/outer-low-vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/outer-low-vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange) IsYANGGoStruct() {}
+
+// GetInnerHighVlanId retrieves the value of the leaf InnerHighVlanId from the Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InnerHighVlanId is set, it can
+// safely use t.GetInnerHighVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InnerHighVlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange) GetInnerHighVlanId() uint16 {
+	if t == nil || t.InnerHighVlanId == nil {
+		return 0
+	}
+	return *t.InnerHighVlanId
+}
+
+// GetInnerLowVlanId retrieves the value of the leaf InnerLowVlanId from the Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InnerLowVlanId is set, it can
+// safely use t.GetInnerLowVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InnerLowVlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange) GetInnerLowVlanId() uint16 {
+	if t == nil || t.InnerLowVlanId == nil {
+		return 0
+	}
+	return *t.InnerLowVlanId
+}
+
+// GetOuterHighVlanId retrieves the value of the leaf OuterHighVlanId from the Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OuterHighVlanId is set, it can
+// safely use t.GetOuterHighVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OuterHighVlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange) GetOuterHighVlanId() uint16 {
+	if t == nil || t.OuterHighVlanId == nil {
+		return 0
+	}
+	return *t.OuterHighVlanId
+}
+
+// GetOuterLowVlanId retrieves the value of the leaf OuterLowVlanId from the Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OuterLowVlanId is set, it can
+// safely use t.GetOuterLowVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OuterLowVlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange) GetOuterLowVlanId() uint16 {
+	if t == nil || t.OuterLowVlanId == nil {
+		return 0
+	}
+	return *t.OuterLowVlanId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange.
+func (*Interface_Subinterface_Vlan_Match_DoubleTaggedInnerOuterRange) ΛBelongingModule() string {
+	return "openconfig-vlan"
+}
+
+// Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/match/double-tagged-inner-range YANG schema element.
+type Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange struct {
+	InnerHighVlanId *uint16  `path:"state/inner-high-vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/inner-high-vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	InnerLowVlanId  *uint16  `path:"state/inner-low-vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/inner-low-vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	OuterVlanId     []uint16 `path:"state/outer-vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/outer-vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange) IsYANGGoStruct() {}
+
+// GetInnerHighVlanId retrieves the value of the leaf InnerHighVlanId from the Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InnerHighVlanId is set, it can
+// safely use t.GetInnerHighVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InnerHighVlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange) GetInnerHighVlanId() uint16 {
+	if t == nil || t.InnerHighVlanId == nil {
+		return 0
+	}
+	return *t.InnerHighVlanId
+}
+
+// GetInnerLowVlanId retrieves the value of the leaf InnerLowVlanId from the Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InnerLowVlanId is set, it can
+// safely use t.GetInnerLowVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InnerLowVlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange) GetInnerLowVlanId() uint16 {
+	if t == nil || t.InnerLowVlanId == nil {
+		return 0
+	}
+	return *t.InnerLowVlanId
+}
+
+// GetOuterVlanId retrieves the value of the leaf OuterVlanId from the Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OuterVlanId is set, it can
+// safely use t.GetOuterVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OuterVlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange) GetOuterVlanId() []uint16 {
+	if t == nil || t.OuterVlanId == nil {
+		return nil
+	}
+	return t.OuterVlanId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange.
+func (*Interface_Subinterface_Vlan_Match_DoubleTaggedInnerRange) ΛBelongingModule() string {
+	return "openconfig-vlan"
+}
+
+// Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/match/double-tagged-outer-list YANG schema element.
+type Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList struct {
+	InnerVlanId  *uint16  `path:"state/inner-vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/inner-vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	OuterVlanIds []uint16 `path:"state/outer-vlan-ids" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/outer-vlan-ids" shadow-module:"openconfig-vlan/openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList) IsYANGGoStruct() {}
+
+// GetInnerVlanId retrieves the value of the leaf InnerVlanId from the Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InnerVlanId is set, it can
+// safely use t.GetInnerVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InnerVlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList) GetInnerVlanId() uint16 {
+	if t == nil || t.InnerVlanId == nil {
+		return 0
+	}
+	return *t.InnerVlanId
+}
+
+// GetOuterVlanIds retrieves the value of the leaf OuterVlanIds from the Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OuterVlanIds is set, it can
+// safely use t.GetOuterVlanIds() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OuterVlanIds == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList) GetOuterVlanIds() []uint16 {
+	if t == nil || t.OuterVlanIds == nil {
+		return nil
+	}
+	return t.OuterVlanIds
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList.
+func (*Interface_Subinterface_Vlan_Match_DoubleTaggedOuterList) ΛBelongingModule() string {
+	return "openconfig-vlan"
+}
+
+// Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/match/double-tagged-outer-range YANG schema element.
+type Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange struct {
+	InnerVlanId     *uint16 `path:"state/inner-vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/inner-vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	OuterHighVlanId *uint16 `path:"state/outer-high-vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/outer-high-vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	OuterLowVlanId  *uint16 `path:"state/outer-low-vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/outer-low-vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange) IsYANGGoStruct() {}
+
+// GetInnerVlanId retrieves the value of the leaf InnerVlanId from the Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InnerVlanId is set, it can
+// safely use t.GetInnerVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InnerVlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange) GetInnerVlanId() uint16 {
+	if t == nil || t.InnerVlanId == nil {
+		return 0
+	}
+	return *t.InnerVlanId
+}
+
+// GetOuterHighVlanId retrieves the value of the leaf OuterHighVlanId from the Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OuterHighVlanId is set, it can
+// safely use t.GetOuterHighVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OuterHighVlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange) GetOuterHighVlanId() uint16 {
+	if t == nil || t.OuterHighVlanId == nil {
+		return 0
+	}
+	return *t.OuterHighVlanId
+}
+
+// GetOuterLowVlanId retrieves the value of the leaf OuterLowVlanId from the Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if OuterLowVlanId is set, it can
+// safely use t.GetOuterLowVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.OuterLowVlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange) GetOuterLowVlanId() uint16 {
+	if t == nil || t.OuterLowVlanId == nil {
+		return 0
+	}
+	return *t.OuterLowVlanId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange.
+func (*Interface_Subinterface_Vlan_Match_DoubleTaggedOuterRange) ΛBelongingModule() string {
+	return "openconfig-vlan"
+}
+
+// Interface_Subinterface_Vlan_Match_SingleTagged represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/match/single-tagged YANG schema element.
+type Interface_Subinterface_Vlan_Match_SingleTagged struct {
+	VlanId *uint16 `path:"state/vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Vlan_Match_SingleTagged implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Vlan_Match_SingleTagged) IsYANGGoStruct() {}
+
+// GetVlanId retrieves the value of the leaf VlanId from the Interface_Subinterface_Vlan_Match_SingleTagged
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VlanId is set, it can
+// safely use t.GetVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_SingleTagged) GetVlanId() uint16 {
+	if t == nil || t.VlanId == nil {
+		return 0
+	}
+	return *t.VlanId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Vlan_Match_SingleTagged
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Vlan_Match_SingleTagged) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Vlan_Match_SingleTagged.
+func (*Interface_Subinterface_Vlan_Match_SingleTagged) ΛBelongingModule() string {
+	return "openconfig-vlan"
+}
+
+// Interface_Subinterface_Vlan_Match_SingleTaggedList represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/match/single-tagged-list YANG schema element.
+type Interface_Subinterface_Vlan_Match_SingleTaggedList struct {
+	VlanIds []uint16 `path:"state/vlan-ids" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/vlan-ids" shadow-module:"openconfig-vlan/openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Vlan_Match_SingleTaggedList implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Vlan_Match_SingleTaggedList) IsYANGGoStruct() {}
+
+// GetVlanIds retrieves the value of the leaf VlanIds from the Interface_Subinterface_Vlan_Match_SingleTaggedList
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if VlanIds is set, it can
+// safely use t.GetVlanIds() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.VlanIds == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_SingleTaggedList) GetVlanIds() []uint16 {
+	if t == nil || t.VlanIds == nil {
+		return nil
+	}
+	return t.VlanIds
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Vlan_Match_SingleTaggedList
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Vlan_Match_SingleTaggedList) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Vlan_Match_SingleTaggedList.
+func (*Interface_Subinterface_Vlan_Match_SingleTaggedList) ΛBelongingModule() string {
+	return "openconfig-vlan"
+}
+
+// Interface_Subinterface_Vlan_Match_SingleTaggedRange represents the /openconfig-interfaces/interfaces/interface/subinterfaces/subinterface/vlan/match/single-tagged-range YANG schema element.
+type Interface_Subinterface_Vlan_Match_SingleTaggedRange struct {
+	HighVlanId *uint16 `path:"state/high-vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/high-vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+	LowVlanId  *uint16 `path:"state/low-vlan-id" module:"openconfig-vlan/openconfig-vlan" shadow-path:"config/low-vlan-id" shadow-module:"openconfig-vlan/openconfig-vlan"`
+}
+
+// IsYANGGoStruct ensures that Interface_Subinterface_Vlan_Match_SingleTaggedRange implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Interface_Subinterface_Vlan_Match_SingleTaggedRange) IsYANGGoStruct() {}
+
+// GetHighVlanId retrieves the value of the leaf HighVlanId from the Interface_Subinterface_Vlan_Match_SingleTaggedRange
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if HighVlanId is set, it can
+// safely use t.GetHighVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.HighVlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_SingleTaggedRange) GetHighVlanId() uint16 {
+	if t == nil || t.HighVlanId == nil {
+		return 0
+	}
+	return *t.HighVlanId
+}
+
+// GetLowVlanId retrieves the value of the leaf LowVlanId from the Interface_Subinterface_Vlan_Match_SingleTaggedRange
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if LowVlanId is set, it can
+// safely use t.GetLowVlanId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.LowVlanId == nil' before retrieving the leaf's value.
+func (t *Interface_Subinterface_Vlan_Match_SingleTaggedRange) GetLowVlanId() uint16 {
+	if t == nil || t.LowVlanId == nil {
+		return 0
+	}
+	return *t.LowVlanId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Interface_Subinterface_Vlan_Match_SingleTaggedRange
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Interface_Subinterface_Vlan_Match_SingleTaggedRange) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Interface_Subinterface_Vlan_Match_SingleTaggedRange.
+func (*Interface_Subinterface_Vlan_Match_SingleTaggedRange) ΛBelongingModule() string {
+	return "openconfig-vlan"
+}
+
+// Root represents the /root YANG schema element.
+type Root struct {
+	Interface map[string]*Interface `path:"interfaces/interface" module:"openconfig-interfaces/openconfig-interfaces"`
+}
+
+// IsYANGGoStruct ensures that Root implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Root) IsYANGGoStruct() {}
+
+// NewInterface creates a new entry in the Interface list of the
+// Root struct. The keys of the list are populated from the input
+// arguments.
+func (t *Root) NewInterface(Name string) (*Interface, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Interface == nil {
+		t.Interface = make(map[string]*Interface)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Interface[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Interface", key)
+	}
+
+	t.Interface[key] = &Interface{
+		Name: &Name,
+	}
+
+	return t.Interface[key], nil
+}
+
+// GetOrCreateInterfaceMap returns the list (map) from Root.
+//
+// It initializes the field if not already initialized.
+func (t *Root) GetOrCreateInterfaceMap() map[string]*Interface {
+	if t.Interface == nil {
+		t.Interface = make(map[string]*Interface)
+	}
+	return t.Interface
+}
+
+// GetOrCreateInterface retrieves the value with the specified keys from
+// the receiver Root. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Root) GetOrCreateInterface(Name string) *Interface {
+
+	key := Name
+
+	if v, ok := t.Interface[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewInterface(Name)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateInterface got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetInterface retrieves the value with the specified key from
+// the Interface map field of Root. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Root) GetInterface(Name string) *Interface {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Name
+
+	if lm, ok := t.Interface[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteInterface deletes the value with the specified keys from
+// the receiver Root. If there is no such element, the function
+// is a no-op.
+func (t *Root) DeleteInterface(Name string) {
+	key := Name
+
+	delete(t.Interface, key)
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Root
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Root) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	for _, e := range t.Interface {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Root.
+func (*Root) ΛBelongingModule() string {
+	return ""
+}
+
+// E_Address_Status is a derived int64 type which is used to represent
+// the enumerated node Address_Status. An additional value named
+// Address_Status_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_Address_Status int64
+
+// IsYANGGoEnum ensures that Address_Status implements the yang.GoEnum
+// interface. This ensures that Address_Status can be identified as a
+// mapped type for a YANG enumeration.
+func (E_Address_Status) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  Address_Status.
+func (E_Address_Status) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_Address_Status.
+func (e E_Address_Status) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_Address_Status")
+}
+
+const (
+	// Address_Status_UNSET corresponds to the value UNSET of Address_Status
+	Address_Status_UNSET E_Address_Status = 0
+	// Address_Status_PREFERRED corresponds to the value PREFERRED of Address_Status
+	Address_Status_PREFERRED E_Address_Status = 1
+	// Address_Status_DEPRECATED corresponds to the value DEPRECATED of Address_Status
+	Address_Status_DEPRECATED E_Address_Status = 2
+	// Address_Status_INVALID corresponds to the value INVALID of Address_Status
+	Address_Status_INVALID E_Address_Status = 3
+	// Address_Status_INACCESSIBLE corresponds to the value INACCESSIBLE of Address_Status
+	Address_Status_INACCESSIBLE E_Address_Status = 4
+	// Address_Status_UNKNOWN corresponds to the value UNKNOWN of Address_Status
+	Address_Status_UNKNOWN E_Address_Status = 5
+	// Address_Status_TENTATIVE corresponds to the value TENTATIVE of Address_Status
+	Address_Status_TENTATIVE E_Address_Status = 6
+	// Address_Status_DUPLICATE corresponds to the value DUPLICATE of Address_Status
+	Address_Status_DUPLICATE E_Address_Status = 7
+	// Address_Status_OPTIMISTIC corresponds to the value OPTIMISTIC of Address_Status
+	Address_Status_OPTIMISTIC E_Address_Status = 8
+)
+
+// E_Ethernet_DuplexMode is a derived int64 type which is used to represent
+// the enumerated node Ethernet_DuplexMode. An additional value named
+// Ethernet_DuplexMode_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_Ethernet_DuplexMode int64
+
+// IsYANGGoEnum ensures that Ethernet_DuplexMode implements the yang.GoEnum
+// interface. This ensures that Ethernet_DuplexMode can be identified as a
+// mapped type for a YANG enumeration.
+func (E_Ethernet_DuplexMode) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  Ethernet_DuplexMode.
+func (E_Ethernet_DuplexMode) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_Ethernet_DuplexMode.
+func (e E_Ethernet_DuplexMode) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_Ethernet_DuplexMode")
+}
+
+const (
+	// Ethernet_DuplexMode_UNSET corresponds to the value UNSET of Ethernet_DuplexMode
+	Ethernet_DuplexMode_UNSET E_Ethernet_DuplexMode = 0
+	// Ethernet_DuplexMode_FULL corresponds to the value FULL of Ethernet_DuplexMode
+	Ethernet_DuplexMode_FULL E_Ethernet_DuplexMode = 1
+	// Ethernet_DuplexMode_HALF corresponds to the value HALF of Ethernet_DuplexMode
+	Ethernet_DuplexMode_HALF E_Ethernet_DuplexMode = 2
+)
+
+// E_Ethernet_NegotiatedDuplexMode is a derived int64 type which is used to represent
+// the enumerated node Ethernet_NegotiatedDuplexMode. An additional value named
+// Ethernet_NegotiatedDuplexMode_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_Ethernet_NegotiatedDuplexMode int64
+
+// IsYANGGoEnum ensures that Ethernet_NegotiatedDuplexMode implements the yang.GoEnum
+// interface. This ensures that Ethernet_NegotiatedDuplexMode can be identified as a
+// mapped type for a YANG enumeration.
+func (E_Ethernet_NegotiatedDuplexMode) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  Ethernet_NegotiatedDuplexMode.
+func (E_Ethernet_NegotiatedDuplexMode) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_Ethernet_NegotiatedDuplexMode.
+func (e E_Ethernet_NegotiatedDuplexMode) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_Ethernet_NegotiatedDuplexMode")
+}
+
+const (
+	// Ethernet_NegotiatedDuplexMode_UNSET corresponds to the value UNSET of Ethernet_NegotiatedDuplexMode
+	Ethernet_NegotiatedDuplexMode_UNSET E_Ethernet_NegotiatedDuplexMode = 0
+	// Ethernet_NegotiatedDuplexMode_FULL corresponds to the value FULL of Ethernet_NegotiatedDuplexMode
+	Ethernet_NegotiatedDuplexMode_FULL E_Ethernet_NegotiatedDuplexMode = 1
+	// Ethernet_NegotiatedDuplexMode_HALF corresponds to the value HALF of Ethernet_NegotiatedDuplexMode
+	Ethernet_NegotiatedDuplexMode_HALF E_Ethernet_NegotiatedDuplexMode = 2
+)
+
+// E_IETFInterfaces_InterfaceType is a derived int64 type which is used to represent
+// the enumerated node IETFInterfaces_InterfaceType. An additional value named
+// IETFInterfaces_InterfaceType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_IETFInterfaces_InterfaceType int64
+
+// IsYANGGoEnum ensures that IETFInterfaces_InterfaceType implements the yang.GoEnum
+// interface. This ensures that IETFInterfaces_InterfaceType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_IETFInterfaces_InterfaceType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  IETFInterfaces_InterfaceType.
+func (E_IETFInterfaces_InterfaceType) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_IETFInterfaces_InterfaceType.
+func (e E_IETFInterfaces_InterfaceType) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_IETFInterfaces_InterfaceType")
+}
+
+const (
+	// IETFInterfaces_InterfaceType_UNSET corresponds to the value UNSET of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_UNSET E_IETFInterfaces_InterfaceType = 0
+	// IETFInterfaces_InterfaceType_a12MppSwitch corresponds to the value a12MppSwitch of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_a12MppSwitch E_IETFInterfaces_InterfaceType = 1
+	// IETFInterfaces_InterfaceType_aal2 corresponds to the value aal2 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aal2 E_IETFInterfaces_InterfaceType = 2
+	// IETFInterfaces_InterfaceType_aal5 corresponds to the value aal5 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aal5 E_IETFInterfaces_InterfaceType = 3
+	// IETFInterfaces_InterfaceType_actelisMetaLOOP corresponds to the value actelisMetaLOOP of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_actelisMetaLOOP E_IETFInterfaces_InterfaceType = 4
+	// IETFInterfaces_InterfaceType_adsl corresponds to the value adsl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_adsl E_IETFInterfaces_InterfaceType = 5
+	// IETFInterfaces_InterfaceType_adsl2 corresponds to the value adsl2 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_adsl2 E_IETFInterfaces_InterfaceType = 6
+	// IETFInterfaces_InterfaceType_adsl2plus corresponds to the value adsl2plus of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_adsl2plus E_IETFInterfaces_InterfaceType = 7
+	// IETFInterfaces_InterfaceType_aflane8023 corresponds to the value aflane8023 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aflane8023 E_IETFInterfaces_InterfaceType = 8
+	// IETFInterfaces_InterfaceType_aflane8025 corresponds to the value aflane8025 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aflane8025 E_IETFInterfaces_InterfaceType = 9
+	// IETFInterfaces_InterfaceType_aluELP corresponds to the value aluELP of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aluELP E_IETFInterfaces_InterfaceType = 10
+	// IETFInterfaces_InterfaceType_aluEpon corresponds to the value aluEpon of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aluEpon E_IETFInterfaces_InterfaceType = 11
+	// IETFInterfaces_InterfaceType_aluEponLogicalLink corresponds to the value aluEponLogicalLink of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aluEponLogicalLink E_IETFInterfaces_InterfaceType = 12
+	// IETFInterfaces_InterfaceType_aluEponOnu corresponds to the value aluEponOnu of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aluEponOnu E_IETFInterfaces_InterfaceType = 13
+	// IETFInterfaces_InterfaceType_aluEponPhysicalUni corresponds to the value aluEponPhysicalUni of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aluEponPhysicalUni E_IETFInterfaces_InterfaceType = 14
+	// IETFInterfaces_InterfaceType_aluGponOnu corresponds to the value aluGponOnu of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aluGponOnu E_IETFInterfaces_InterfaceType = 15
+	// IETFInterfaces_InterfaceType_aluGponPhysicalUni corresponds to the value aluGponPhysicalUni of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aluGponPhysicalUni E_IETFInterfaces_InterfaceType = 16
+	// IETFInterfaces_InterfaceType_arap corresponds to the value arap of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_arap E_IETFInterfaces_InterfaceType = 17
+	// IETFInterfaces_InterfaceType_arcnet corresponds to the value arcnet of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_arcnet E_IETFInterfaces_InterfaceType = 18
+	// IETFInterfaces_InterfaceType_arcnetPlus corresponds to the value arcnetPlus of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_arcnetPlus E_IETFInterfaces_InterfaceType = 19
+	// IETFInterfaces_InterfaceType_async corresponds to the value async of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_async E_IETFInterfaces_InterfaceType = 20
+	// IETFInterfaces_InterfaceType_atm corresponds to the value atm of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atm E_IETFInterfaces_InterfaceType = 21
+	// IETFInterfaces_InterfaceType_atmDxi corresponds to the value atmDxi of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmDxi E_IETFInterfaces_InterfaceType = 22
+	// IETFInterfaces_InterfaceType_atmFuni corresponds to the value atmFuni of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmFuni E_IETFInterfaces_InterfaceType = 23
+	// IETFInterfaces_InterfaceType_atmIma corresponds to the value atmIma of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmIma E_IETFInterfaces_InterfaceType = 24
+	// IETFInterfaces_InterfaceType_atmLogical corresponds to the value atmLogical of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmLogical E_IETFInterfaces_InterfaceType = 25
+	// IETFInterfaces_InterfaceType_atmRadio corresponds to the value atmRadio of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmRadio E_IETFInterfaces_InterfaceType = 26
+	// IETFInterfaces_InterfaceType_atmSubInterface corresponds to the value atmSubInterface of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmSubInterface E_IETFInterfaces_InterfaceType = 27
+	// IETFInterfaces_InterfaceType_atmVciEndPt corresponds to the value atmVciEndPt of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmVciEndPt E_IETFInterfaces_InterfaceType = 28
+	// IETFInterfaces_InterfaceType_atmVirtual corresponds to the value atmVirtual of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmVirtual E_IETFInterfaces_InterfaceType = 29
+	// IETFInterfaces_InterfaceType_atmbond corresponds to the value atmbond of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_atmbond E_IETFInterfaces_InterfaceType = 30
+	// IETFInterfaces_InterfaceType_aviciOpticalEther corresponds to the value aviciOpticalEther of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_aviciOpticalEther E_IETFInterfaces_InterfaceType = 31
+	// IETFInterfaces_InterfaceType_basicISDN corresponds to the value basicISDN of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_basicISDN E_IETFInterfaces_InterfaceType = 32
+	// IETFInterfaces_InterfaceType_bgppolicyaccounting corresponds to the value bgppolicyaccounting of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_bgppolicyaccounting E_IETFInterfaces_InterfaceType = 33
+	// IETFInterfaces_InterfaceType_bits corresponds to the value bits of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_bits E_IETFInterfaces_InterfaceType = 34
+	// IETFInterfaces_InterfaceType_bridge corresponds to the value bridge of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_bridge E_IETFInterfaces_InterfaceType = 35
+	// IETFInterfaces_InterfaceType_bsc corresponds to the value bsc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_bsc E_IETFInterfaces_InterfaceType = 36
+	// IETFInterfaces_InterfaceType_cableDownstreamRfPort corresponds to the value cableDownstreamRfPort of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_cableDownstreamRfPort E_IETFInterfaces_InterfaceType = 37
+	// IETFInterfaces_InterfaceType_capwapDot11Bss corresponds to the value capwapDot11Bss of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_capwapDot11Bss E_IETFInterfaces_InterfaceType = 38
+	// IETFInterfaces_InterfaceType_capwapDot11Profile corresponds to the value capwapDot11Profile of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_capwapDot11Profile E_IETFInterfaces_InterfaceType = 39
+	// IETFInterfaces_InterfaceType_capwapWtpVirtualRadio corresponds to the value capwapWtpVirtualRadio of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_capwapWtpVirtualRadio E_IETFInterfaces_InterfaceType = 40
+	// IETFInterfaces_InterfaceType_cblVectaStar corresponds to the value cblVectaStar of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_cblVectaStar E_IETFInterfaces_InterfaceType = 41
+	// IETFInterfaces_InterfaceType_cctEmul corresponds to the value cctEmul of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_cctEmul E_IETFInterfaces_InterfaceType = 42
+	// IETFInterfaces_InterfaceType_ces corresponds to the value ces of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ces E_IETFInterfaces_InterfaceType = 43
+	// IETFInterfaces_InterfaceType_channel corresponds to the value channel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_channel E_IETFInterfaces_InterfaceType = 44
+	// IETFInterfaces_InterfaceType_ciscoISLvlan corresponds to the value ciscoISLvlan of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ciscoISLvlan E_IETFInterfaces_InterfaceType = 45
+	// IETFInterfaces_InterfaceType_cnr corresponds to the value cnr of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_cnr E_IETFInterfaces_InterfaceType = 46
+	// IETFInterfaces_InterfaceType_coffee corresponds to the value coffee of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_coffee E_IETFInterfaces_InterfaceType = 47
+	// IETFInterfaces_InterfaceType_compositeLink corresponds to the value compositeLink of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_compositeLink E_IETFInterfaces_InterfaceType = 48
+	// IETFInterfaces_InterfaceType_dcn corresponds to the value dcn of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dcn E_IETFInterfaces_InterfaceType = 49
+	// IETFInterfaces_InterfaceType_ddnX25 corresponds to the value ddnX25 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ddnX25 E_IETFInterfaces_InterfaceType = 50
+	// IETFInterfaces_InterfaceType_digitalPowerline corresponds to the value digitalPowerline of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_digitalPowerline E_IETFInterfaces_InterfaceType = 51
+	// IETFInterfaces_InterfaceType_digitalWrapperOverheadChannel corresponds to the value digitalWrapperOverheadChannel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_digitalWrapperOverheadChannel E_IETFInterfaces_InterfaceType = 52
+	// IETFInterfaces_InterfaceType_dlsw corresponds to the value dlsw of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dlsw E_IETFInterfaces_InterfaceType = 53
+	// IETFInterfaces_InterfaceType_docsCableDownstream corresponds to the value docsCableDownstream of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableDownstream E_IETFInterfaces_InterfaceType = 54
+	// IETFInterfaces_InterfaceType_docsCableMCmtsDownstream corresponds to the value docsCableMCmtsDownstream of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableMCmtsDownstream E_IETFInterfaces_InterfaceType = 55
+	// IETFInterfaces_InterfaceType_docsCableMaclayer corresponds to the value docsCableMaclayer of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableMaclayer E_IETFInterfaces_InterfaceType = 56
+	// IETFInterfaces_InterfaceType_docsCableNdf corresponds to the value docsCableNdf of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableNdf E_IETFInterfaces_InterfaceType = 57
+	// IETFInterfaces_InterfaceType_docsCableNdr corresponds to the value docsCableNdr of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableNdr E_IETFInterfaces_InterfaceType = 58
+	// IETFInterfaces_InterfaceType_docsCableScte55d1FwdOob corresponds to the value docsCableScte55d1FwdOob of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableScte55d1FwdOob E_IETFInterfaces_InterfaceType = 59
+	// IETFInterfaces_InterfaceType_docsCableScte55d1RetOob corresponds to the value docsCableScte55d1RetOob of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableScte55d1RetOob E_IETFInterfaces_InterfaceType = 60
+	// IETFInterfaces_InterfaceType_docsCableScte55d2DsOob corresponds to the value docsCableScte55d2DsOob of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableScte55d2DsOob E_IETFInterfaces_InterfaceType = 61
+	// IETFInterfaces_InterfaceType_docsCableScte55d2UsOob corresponds to the value docsCableScte55d2UsOob of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableScte55d2UsOob E_IETFInterfaces_InterfaceType = 62
+	// IETFInterfaces_InterfaceType_docsCableUpstream corresponds to the value docsCableUpstream of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableUpstream E_IETFInterfaces_InterfaceType = 63
+	// IETFInterfaces_InterfaceType_docsCableUpstreamChannel corresponds to the value docsCableUpstreamChannel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableUpstreamChannel E_IETFInterfaces_InterfaceType = 64
+	// IETFInterfaces_InterfaceType_docsCableUpstreamRfPort corresponds to the value docsCableUpstreamRfPort of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsCableUpstreamRfPort E_IETFInterfaces_InterfaceType = 65
+	// IETFInterfaces_InterfaceType_docsOfdmDownstream corresponds to the value docsOfdmDownstream of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsOfdmDownstream E_IETFInterfaces_InterfaceType = 66
+	// IETFInterfaces_InterfaceType_docsOfdmaUpstream corresponds to the value docsOfdmaUpstream of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_docsOfdmaUpstream E_IETFInterfaces_InterfaceType = 67
+	// IETFInterfaces_InterfaceType_ds0 corresponds to the value ds0 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ds0 E_IETFInterfaces_InterfaceType = 68
+	// IETFInterfaces_InterfaceType_ds0Bundle corresponds to the value ds0Bundle of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ds0Bundle E_IETFInterfaces_InterfaceType = 69
+	// IETFInterfaces_InterfaceType_ds1 corresponds to the value ds1 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ds1 E_IETFInterfaces_InterfaceType = 70
+	// IETFInterfaces_InterfaceType_ds1FDL corresponds to the value ds1FDL of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ds1FDL E_IETFInterfaces_InterfaceType = 71
+	// IETFInterfaces_InterfaceType_ds3 corresponds to the value ds3 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ds3 E_IETFInterfaces_InterfaceType = 72
+	// IETFInterfaces_InterfaceType_dtm corresponds to the value dtm of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dtm E_IETFInterfaces_InterfaceType = 73
+	// IETFInterfaces_InterfaceType_dvbAsiIn corresponds to the value dvbAsiIn of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dvbAsiIn E_IETFInterfaces_InterfaceType = 74
+	// IETFInterfaces_InterfaceType_dvbAsiOut corresponds to the value dvbAsiOut of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dvbAsiOut E_IETFInterfaces_InterfaceType = 75
+	// IETFInterfaces_InterfaceType_dvbRccDownstream corresponds to the value dvbRccDownstream of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dvbRccDownstream E_IETFInterfaces_InterfaceType = 76
+	// IETFInterfaces_InterfaceType_dvbRccMacLayer corresponds to the value dvbRccMacLayer of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dvbRccMacLayer E_IETFInterfaces_InterfaceType = 77
+	// IETFInterfaces_InterfaceType_dvbRccUpstream corresponds to the value dvbRccUpstream of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dvbRccUpstream E_IETFInterfaces_InterfaceType = 78
+	// IETFInterfaces_InterfaceType_dvbRcsMacLayer corresponds to the value dvbRcsMacLayer of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dvbRcsMacLayer E_IETFInterfaces_InterfaceType = 79
+	// IETFInterfaces_InterfaceType_dvbRcsTdma corresponds to the value dvbRcsTdma of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dvbRcsTdma E_IETFInterfaces_InterfaceType = 80
+	// IETFInterfaces_InterfaceType_dvbTdm corresponds to the value dvbTdm of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_dvbTdm E_IETFInterfaces_InterfaceType = 81
+	// IETFInterfaces_InterfaceType_e1 corresponds to the value e1 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_e1 E_IETFInterfaces_InterfaceType = 82
+	// IETFInterfaces_InterfaceType_econet corresponds to the value econet of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_econet E_IETFInterfaces_InterfaceType = 83
+	// IETFInterfaces_InterfaceType_eon corresponds to the value eon of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_eon E_IETFInterfaces_InterfaceType = 84
+	// IETFInterfaces_InterfaceType_eplrs corresponds to the value eplrs of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_eplrs E_IETFInterfaces_InterfaceType = 85
+	// IETFInterfaces_InterfaceType_escon corresponds to the value escon of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_escon E_IETFInterfaces_InterfaceType = 86
+	// IETFInterfaces_InterfaceType_ethernet3Mbit corresponds to the value ethernet3Mbit of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ethernet3Mbit E_IETFInterfaces_InterfaceType = 87
+	// IETFInterfaces_InterfaceType_ethernetCsmacd corresponds to the value ethernetCsmacd of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ethernetCsmacd E_IETFInterfaces_InterfaceType = 88
+	// IETFInterfaces_InterfaceType_fast corresponds to the value fast of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_fast E_IETFInterfaces_InterfaceType = 89
+	// IETFInterfaces_InterfaceType_fastEther corresponds to the value fastEther of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_fastEther E_IETFInterfaces_InterfaceType = 90
+	// IETFInterfaces_InterfaceType_fastEtherFX corresponds to the value fastEtherFX of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_fastEtherFX E_IETFInterfaces_InterfaceType = 91
+	// IETFInterfaces_InterfaceType_fastdsl corresponds to the value fastdsl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_fastdsl E_IETFInterfaces_InterfaceType = 92
+	// IETFInterfaces_InterfaceType_fcipLink corresponds to the value fcipLink of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_fcipLink E_IETFInterfaces_InterfaceType = 93
+	// IETFInterfaces_InterfaceType_fddi corresponds to the value fddi of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_fddi E_IETFInterfaces_InterfaceType = 94
+	// IETFInterfaces_InterfaceType_fibreChannel corresponds to the value fibreChannel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_fibreChannel E_IETFInterfaces_InterfaceType = 95
+	// IETFInterfaces_InterfaceType_frDlciEndPt corresponds to the value frDlciEndPt of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_frDlciEndPt E_IETFInterfaces_InterfaceType = 96
+	// IETFInterfaces_InterfaceType_frForward corresponds to the value frForward of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_frForward E_IETFInterfaces_InterfaceType = 97
+	// IETFInterfaces_InterfaceType_frameRelay corresponds to the value frameRelay of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_frameRelay E_IETFInterfaces_InterfaceType = 98
+	// IETFInterfaces_InterfaceType_frameRelayInterconnect corresponds to the value frameRelayInterconnect of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_frameRelayInterconnect E_IETFInterfaces_InterfaceType = 99
+	// IETFInterfaces_InterfaceType_frameRelayMPI corresponds to the value frameRelayMPI of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_frameRelayMPI E_IETFInterfaces_InterfaceType = 100
+	// IETFInterfaces_InterfaceType_frameRelayService corresponds to the value frameRelayService of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_frameRelayService E_IETFInterfaces_InterfaceType = 101
+	// IETFInterfaces_InterfaceType_frf16MfrBundle corresponds to the value frf16MfrBundle of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_frf16MfrBundle E_IETFInterfaces_InterfaceType = 102
+	// IETFInterfaces_InterfaceType_g703at2mb corresponds to the value g703at2mb of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_g703at2mb E_IETFInterfaces_InterfaceType = 103
+	// IETFInterfaces_InterfaceType_g703at64k corresponds to the value g703at64k of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_g703at64k E_IETFInterfaces_InterfaceType = 104
+	// IETFInterfaces_InterfaceType_g9981 corresponds to the value g9981 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_g9981 E_IETFInterfaces_InterfaceType = 105
+	// IETFInterfaces_InterfaceType_g9982 corresponds to the value g9982 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_g9982 E_IETFInterfaces_InterfaceType = 106
+	// IETFInterfaces_InterfaceType_g9983 corresponds to the value g9983 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_g9983 E_IETFInterfaces_InterfaceType = 107
+	// IETFInterfaces_InterfaceType_gfast corresponds to the value gfast of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_gfast E_IETFInterfaces_InterfaceType = 108
+	// IETFInterfaces_InterfaceType_gfp corresponds to the value gfp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_gfp E_IETFInterfaces_InterfaceType = 109
+	// IETFInterfaces_InterfaceType_gigabitEthernet corresponds to the value gigabitEthernet of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_gigabitEthernet E_IETFInterfaces_InterfaceType = 110
+	// IETFInterfaces_InterfaceType_gpon corresponds to the value gpon of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_gpon E_IETFInterfaces_InterfaceType = 111
+	// IETFInterfaces_InterfaceType_gr303IDT corresponds to the value gr303IDT of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_gr303IDT E_IETFInterfaces_InterfaceType = 112
+	// IETFInterfaces_InterfaceType_gr303RDT corresponds to the value gr303RDT of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_gr303RDT E_IETFInterfaces_InterfaceType = 113
+	// IETFInterfaces_InterfaceType_gtp corresponds to the value gtp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_gtp E_IETFInterfaces_InterfaceType = 114
+	// IETFInterfaces_InterfaceType_h323Gatekeeper corresponds to the value h323Gatekeeper of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_h323Gatekeeper E_IETFInterfaces_InterfaceType = 115
+	// IETFInterfaces_InterfaceType_h323Proxy corresponds to the value h323Proxy of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_h323Proxy E_IETFInterfaces_InterfaceType = 116
+	// IETFInterfaces_InterfaceType_hdh1822 corresponds to the value hdh1822 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hdh1822 E_IETFInterfaces_InterfaceType = 117
+	// IETFInterfaces_InterfaceType_hdlc corresponds to the value hdlc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hdlc E_IETFInterfaces_InterfaceType = 118
+	// IETFInterfaces_InterfaceType_hdsl2 corresponds to the value hdsl2 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hdsl2 E_IETFInterfaces_InterfaceType = 119
+	// IETFInterfaces_InterfaceType_hiperlan2 corresponds to the value hiperlan2 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hiperlan2 E_IETFInterfaces_InterfaceType = 120
+	// IETFInterfaces_InterfaceType_hippi corresponds to the value hippi of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hippi E_IETFInterfaces_InterfaceType = 121
+	// IETFInterfaces_InterfaceType_hippiInterface corresponds to the value hippiInterface of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hippiInterface E_IETFInterfaces_InterfaceType = 122
+	// IETFInterfaces_InterfaceType_homepna corresponds to the value homepna of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_homepna E_IETFInterfaces_InterfaceType = 123
+	// IETFInterfaces_InterfaceType_hostPad corresponds to the value hostPad of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hostPad E_IETFInterfaces_InterfaceType = 124
+	// IETFInterfaces_InterfaceType_hssi corresponds to the value hssi of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hssi E_IETFInterfaces_InterfaceType = 125
+	// IETFInterfaces_InterfaceType_hyperchannel corresponds to the value hyperchannel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_hyperchannel E_IETFInterfaces_InterfaceType = 126
+	// IETFInterfaces_InterfaceType_iana_interface_type corresponds to the value iana_interface_type of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iana_interface_type E_IETFInterfaces_InterfaceType = 127
+	// IETFInterfaces_InterfaceType_ibm370parChan corresponds to the value ibm370parChan of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ibm370parChan E_IETFInterfaces_InterfaceType = 128
+	// IETFInterfaces_InterfaceType_idsl corresponds to the value idsl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_idsl E_IETFInterfaces_InterfaceType = 129
+	// IETFInterfaces_InterfaceType_ieee1394 corresponds to the value ieee1394 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ieee1394 E_IETFInterfaces_InterfaceType = 130
+	// IETFInterfaces_InterfaceType_ieee80211 corresponds to the value ieee80211 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ieee80211 E_IETFInterfaces_InterfaceType = 131
+	// IETFInterfaces_InterfaceType_ieee80212 corresponds to the value ieee80212 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ieee80212 E_IETFInterfaces_InterfaceType = 132
+	// IETFInterfaces_InterfaceType_ieee802154 corresponds to the value ieee802154 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ieee802154 E_IETFInterfaces_InterfaceType = 133
+	// IETFInterfaces_InterfaceType_ieee80216WMAN corresponds to the value ieee80216WMAN of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ieee80216WMAN E_IETFInterfaces_InterfaceType = 134
+	// IETFInterfaces_InterfaceType_ieee8023adLag corresponds to the value ieee8023adLag of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ieee8023adLag E_IETFInterfaces_InterfaceType = 135
+	// IETFInterfaces_InterfaceType_if_gsn corresponds to the value if_gsn of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_if_gsn E_IETFInterfaces_InterfaceType = 136
+	// IETFInterfaces_InterfaceType_ifPwType corresponds to the value ifPwType of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ifPwType E_IETFInterfaces_InterfaceType = 137
+	// IETFInterfaces_InterfaceType_ifVfiType corresponds to the value ifVfiType of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ifVfiType E_IETFInterfaces_InterfaceType = 138
+	// IETFInterfaces_InterfaceType_ilan corresponds to the value ilan of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ilan E_IETFInterfaces_InterfaceType = 139
+	// IETFInterfaces_InterfaceType_imt corresponds to the value imt of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_imt E_IETFInterfaces_InterfaceType = 140
+	// IETFInterfaces_InterfaceType_infiniband corresponds to the value infiniband of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_infiniband E_IETFInterfaces_InterfaceType = 141
+	// IETFInterfaces_InterfaceType_interleave corresponds to the value interleave of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_interleave E_IETFInterfaces_InterfaceType = 142
+	// IETFInterfaces_InterfaceType_ip corresponds to the value ip of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ip E_IETFInterfaces_InterfaceType = 143
+	// IETFInterfaces_InterfaceType_ipForward corresponds to the value ipForward of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ipForward E_IETFInterfaces_InterfaceType = 144
+	// IETFInterfaces_InterfaceType_ipOverAtm corresponds to the value ipOverAtm of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ipOverAtm E_IETFInterfaces_InterfaceType = 145
+	// IETFInterfaces_InterfaceType_ipOverCdlc corresponds to the value ipOverCdlc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ipOverCdlc E_IETFInterfaces_InterfaceType = 146
+	// IETFInterfaces_InterfaceType_ipOverClaw corresponds to the value ipOverClaw of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ipOverClaw E_IETFInterfaces_InterfaceType = 147
+	// IETFInterfaces_InterfaceType_ipSwitch corresponds to the value ipSwitch of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ipSwitch E_IETFInterfaces_InterfaceType = 148
+	// IETFInterfaces_InterfaceType_isdn corresponds to the value isdn of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_isdn E_IETFInterfaces_InterfaceType = 149
+	// IETFInterfaces_InterfaceType_isdns corresponds to the value isdns of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_isdns E_IETFInterfaces_InterfaceType = 150
+	// IETFInterfaces_InterfaceType_isdnu corresponds to the value isdnu of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_isdnu E_IETFInterfaces_InterfaceType = 151
+	// IETFInterfaces_InterfaceType_iso88022llc corresponds to the value iso88022llc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iso88022llc E_IETFInterfaces_InterfaceType = 152
+	// IETFInterfaces_InterfaceType_iso88023Csmacd corresponds to the value iso88023Csmacd of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iso88023Csmacd E_IETFInterfaces_InterfaceType = 153
+	// IETFInterfaces_InterfaceType_iso88024TokenBus corresponds to the value iso88024TokenBus of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iso88024TokenBus E_IETFInterfaces_InterfaceType = 154
+	// IETFInterfaces_InterfaceType_iso88025CRFPInt corresponds to the value iso88025CRFPInt of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iso88025CRFPInt E_IETFInterfaces_InterfaceType = 155
+	// IETFInterfaces_InterfaceType_iso88025Dtr corresponds to the value iso88025Dtr of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iso88025Dtr E_IETFInterfaces_InterfaceType = 156
+	// IETFInterfaces_InterfaceType_iso88025Fiber corresponds to the value iso88025Fiber of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iso88025Fiber E_IETFInterfaces_InterfaceType = 157
+	// IETFInterfaces_InterfaceType_iso88025TokenRing corresponds to the value iso88025TokenRing of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iso88025TokenRing E_IETFInterfaces_InterfaceType = 158
+	// IETFInterfaces_InterfaceType_iso88026Man corresponds to the value iso88026Man of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_iso88026Man E_IETFInterfaces_InterfaceType = 159
+	// IETFInterfaces_InterfaceType_isup corresponds to the value isup of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_isup E_IETFInterfaces_InterfaceType = 160
+	// IETFInterfaces_InterfaceType_l2vlan corresponds to the value l2vlan of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_l2vlan E_IETFInterfaces_InterfaceType = 161
+	// IETFInterfaces_InterfaceType_l3ipvlan corresponds to the value l3ipvlan of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_l3ipvlan E_IETFInterfaces_InterfaceType = 162
+	// IETFInterfaces_InterfaceType_l3ipxvlan corresponds to the value l3ipxvlan of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_l3ipxvlan E_IETFInterfaces_InterfaceType = 163
+	// IETFInterfaces_InterfaceType_lapb corresponds to the value lapb of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_lapb E_IETFInterfaces_InterfaceType = 164
+	// IETFInterfaces_InterfaceType_lapd corresponds to the value lapd of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_lapd E_IETFInterfaces_InterfaceType = 165
+	// IETFInterfaces_InterfaceType_lapf corresponds to the value lapf of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_lapf E_IETFInterfaces_InterfaceType = 166
+	// IETFInterfaces_InterfaceType_linegroup corresponds to the value linegroup of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_linegroup E_IETFInterfaces_InterfaceType = 167
+	// IETFInterfaces_InterfaceType_lmp corresponds to the value lmp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_lmp E_IETFInterfaces_InterfaceType = 168
+	// IETFInterfaces_InterfaceType_localTalk corresponds to the value localTalk of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_localTalk E_IETFInterfaces_InterfaceType = 169
+	// IETFInterfaces_InterfaceType_macSecControlledIF corresponds to the value macSecControlledIF of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_macSecControlledIF E_IETFInterfaces_InterfaceType = 170
+	// IETFInterfaces_InterfaceType_macSecUncontrolledIF corresponds to the value macSecUncontrolledIF of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_macSecUncontrolledIF E_IETFInterfaces_InterfaceType = 171
+	// IETFInterfaces_InterfaceType_mediaMailOverIp corresponds to the value mediaMailOverIp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_mediaMailOverIp E_IETFInterfaces_InterfaceType = 172
+	// IETFInterfaces_InterfaceType_mfSigLink corresponds to the value mfSigLink of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_mfSigLink E_IETFInterfaces_InterfaceType = 173
+	// IETFInterfaces_InterfaceType_miox25 corresponds to the value miox25 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_miox25 E_IETFInterfaces_InterfaceType = 174
+	// IETFInterfaces_InterfaceType_mocaVersion1 corresponds to the value mocaVersion1 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_mocaVersion1 E_IETFInterfaces_InterfaceType = 175
+	// IETFInterfaces_InterfaceType_modem corresponds to the value modem of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_modem E_IETFInterfaces_InterfaceType = 176
+	// IETFInterfaces_InterfaceType_mpc corresponds to the value mpc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_mpc E_IETFInterfaces_InterfaceType = 177
+	// IETFInterfaces_InterfaceType_mpegTransport corresponds to the value mpegTransport of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_mpegTransport E_IETFInterfaces_InterfaceType = 178
+	// IETFInterfaces_InterfaceType_mpls corresponds to the value mpls of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_mpls E_IETFInterfaces_InterfaceType = 179
+	// IETFInterfaces_InterfaceType_mplsTunnel corresponds to the value mplsTunnel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_mplsTunnel E_IETFInterfaces_InterfaceType = 180
+	// IETFInterfaces_InterfaceType_msdsl corresponds to the value msdsl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_msdsl E_IETFInterfaces_InterfaceType = 181
+	// IETFInterfaces_InterfaceType_mvl corresponds to the value mvl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_mvl E_IETFInterfaces_InterfaceType = 182
+	// IETFInterfaces_InterfaceType_myrinet corresponds to the value myrinet of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_myrinet E_IETFInterfaces_InterfaceType = 183
+	// IETFInterfaces_InterfaceType_nfas corresponds to the value nfas of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_nfas E_IETFInterfaces_InterfaceType = 184
+	// IETFInterfaces_InterfaceType_nsip corresponds to the value nsip of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_nsip E_IETFInterfaces_InterfaceType = 185
+	// IETFInterfaces_InterfaceType_opticalChannel corresponds to the value opticalChannel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_opticalChannel E_IETFInterfaces_InterfaceType = 186
+	// IETFInterfaces_InterfaceType_opticalChannelGroup corresponds to the value opticalChannelGroup of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_opticalChannelGroup E_IETFInterfaces_InterfaceType = 187
+	// IETFInterfaces_InterfaceType_opticalTransport corresponds to the value opticalTransport of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_opticalTransport E_IETFInterfaces_InterfaceType = 188
+	// IETFInterfaces_InterfaceType_other corresponds to the value other of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_other E_IETFInterfaces_InterfaceType = 189
+	// IETFInterfaces_InterfaceType_otnOdu corresponds to the value otnOdu of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_otnOdu E_IETFInterfaces_InterfaceType = 190
+	// IETFInterfaces_InterfaceType_otnOtu corresponds to the value otnOtu of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_otnOtu E_IETFInterfaces_InterfaceType = 191
+	// IETFInterfaces_InterfaceType_para corresponds to the value para of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_para E_IETFInterfaces_InterfaceType = 192
+	// IETFInterfaces_InterfaceType_pdnEtherLoop1 corresponds to the value pdnEtherLoop1 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_pdnEtherLoop1 E_IETFInterfaces_InterfaceType = 193
+	// IETFInterfaces_InterfaceType_pdnEtherLoop2 corresponds to the value pdnEtherLoop2 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_pdnEtherLoop2 E_IETFInterfaces_InterfaceType = 194
+	// IETFInterfaces_InterfaceType_pip corresponds to the value pip of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_pip E_IETFInterfaces_InterfaceType = 195
+	// IETFInterfaces_InterfaceType_plc corresponds to the value plc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_plc E_IETFInterfaces_InterfaceType = 196
+	// IETFInterfaces_InterfaceType_pon155 corresponds to the value pon155 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_pon155 E_IETFInterfaces_InterfaceType = 197
+	// IETFInterfaces_InterfaceType_pon622 corresponds to the value pon622 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_pon622 E_IETFInterfaces_InterfaceType = 198
+	// IETFInterfaces_InterfaceType_pos corresponds to the value pos of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_pos E_IETFInterfaces_InterfaceType = 199
+	// IETFInterfaces_InterfaceType_ppp corresponds to the value ppp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ppp E_IETFInterfaces_InterfaceType = 200
+	// IETFInterfaces_InterfaceType_pppMultilinkBundle corresponds to the value pppMultilinkBundle of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_pppMultilinkBundle E_IETFInterfaces_InterfaceType = 201
+	// IETFInterfaces_InterfaceType_primaryISDN corresponds to the value primaryISDN of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_primaryISDN E_IETFInterfaces_InterfaceType = 202
+	// IETFInterfaces_InterfaceType_propAtm corresponds to the value propAtm of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propAtm E_IETFInterfaces_InterfaceType = 203
+	// IETFInterfaces_InterfaceType_propBWAp2Mp corresponds to the value propBWAp2Mp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propBWAp2Mp E_IETFInterfaces_InterfaceType = 204
+	// IETFInterfaces_InterfaceType_propCnls corresponds to the value propCnls of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propCnls E_IETFInterfaces_InterfaceType = 205
+	// IETFInterfaces_InterfaceType_propDocsWirelessDownstream corresponds to the value propDocsWirelessDownstream of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propDocsWirelessDownstream E_IETFInterfaces_InterfaceType = 206
+	// IETFInterfaces_InterfaceType_propDocsWirelessMaclayer corresponds to the value propDocsWirelessMaclayer of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propDocsWirelessMaclayer E_IETFInterfaces_InterfaceType = 207
+	// IETFInterfaces_InterfaceType_propDocsWirelessUpstream corresponds to the value propDocsWirelessUpstream of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propDocsWirelessUpstream E_IETFInterfaces_InterfaceType = 208
+	// IETFInterfaces_InterfaceType_propMultiplexor corresponds to the value propMultiplexor of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propMultiplexor E_IETFInterfaces_InterfaceType = 209
+	// IETFInterfaces_InterfaceType_propPointToPointSerial corresponds to the value propPointToPointSerial of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propPointToPointSerial E_IETFInterfaces_InterfaceType = 210
+	// IETFInterfaces_InterfaceType_propVirtual corresponds to the value propVirtual of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propVirtual E_IETFInterfaces_InterfaceType = 211
+	// IETFInterfaces_InterfaceType_propWirelessP2P corresponds to the value propWirelessP2P of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_propWirelessP2P E_IETFInterfaces_InterfaceType = 212
+	// IETFInterfaces_InterfaceType_proteon10Mbit corresponds to the value proteon10Mbit of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_proteon10Mbit E_IETFInterfaces_InterfaceType = 213
+	// IETFInterfaces_InterfaceType_proteon80Mbit corresponds to the value proteon80Mbit of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_proteon80Mbit E_IETFInterfaces_InterfaceType = 214
+	// IETFInterfaces_InterfaceType_ptm corresponds to the value ptm of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ptm E_IETFInterfaces_InterfaceType = 215
+	// IETFInterfaces_InterfaceType_q2931 corresponds to the value q2931 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_q2931 E_IETFInterfaces_InterfaceType = 216
+	// IETFInterfaces_InterfaceType_qam corresponds to the value qam of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_qam E_IETFInterfaces_InterfaceType = 217
+	// IETFInterfaces_InterfaceType_qllc corresponds to the value qllc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_qllc E_IETFInterfaces_InterfaceType = 218
+	// IETFInterfaces_InterfaceType_radioMAC corresponds to the value radioMAC of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_radioMAC E_IETFInterfaces_InterfaceType = 219
+	// IETFInterfaces_InterfaceType_radsl corresponds to the value radsl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_radsl E_IETFInterfaces_InterfaceType = 220
+	// IETFInterfaces_InterfaceType_reachDSL corresponds to the value reachDSL of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_reachDSL E_IETFInterfaces_InterfaceType = 221
+	// IETFInterfaces_InterfaceType_regular1822 corresponds to the value regular1822 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_regular1822 E_IETFInterfaces_InterfaceType = 222
+	// IETFInterfaces_InterfaceType_rfc1483 corresponds to the value rfc1483 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_rfc1483 E_IETFInterfaces_InterfaceType = 223
+	// IETFInterfaces_InterfaceType_rfc877x25 corresponds to the value rfc877x25 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_rfc877x25 E_IETFInterfaces_InterfaceType = 224
+	// IETFInterfaces_InterfaceType_rpr corresponds to the value rpr of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_rpr E_IETFInterfaces_InterfaceType = 225
+	// IETFInterfaces_InterfaceType_rs232 corresponds to the value rs232 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_rs232 E_IETFInterfaces_InterfaceType = 226
+	// IETFInterfaces_InterfaceType_rsrb corresponds to the value rsrb of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_rsrb E_IETFInterfaces_InterfaceType = 227
+	// IETFInterfaces_InterfaceType_sdci corresponds to the value sdci of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sdci E_IETFInterfaces_InterfaceType = 228
+	// IETFInterfaces_InterfaceType_sdlc corresponds to the value sdlc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sdlc E_IETFInterfaces_InterfaceType = 229
+	// IETFInterfaces_InterfaceType_sdsl corresponds to the value sdsl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sdsl E_IETFInterfaces_InterfaceType = 230
+	// IETFInterfaces_InterfaceType_shdsl corresponds to the value shdsl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_shdsl E_IETFInterfaces_InterfaceType = 231
+	// IETFInterfaces_InterfaceType_sip corresponds to the value sip of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sip E_IETFInterfaces_InterfaceType = 232
+	// IETFInterfaces_InterfaceType_sipSig corresponds to the value sipSig of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sipSig E_IETFInterfaces_InterfaceType = 233
+	// IETFInterfaces_InterfaceType_sipTg corresponds to the value sipTg of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sipTg E_IETFInterfaces_InterfaceType = 234
+	// IETFInterfaces_InterfaceType_sixToFour corresponds to the value sixToFour of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sixToFour E_IETFInterfaces_InterfaceType = 235
+	// IETFInterfaces_InterfaceType_slip corresponds to the value slip of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_slip E_IETFInterfaces_InterfaceType = 236
+	// IETFInterfaces_InterfaceType_smdsDxi corresponds to the value smdsDxi of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_smdsDxi E_IETFInterfaces_InterfaceType = 237
+	// IETFInterfaces_InterfaceType_smdsIcip corresponds to the value smdsIcip of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_smdsIcip E_IETFInterfaces_InterfaceType = 238
+	// IETFInterfaces_InterfaceType_softwareLoopback corresponds to the value softwareLoopback of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_softwareLoopback E_IETFInterfaces_InterfaceType = 239
+	// IETFInterfaces_InterfaceType_sonet corresponds to the value sonet of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sonet E_IETFInterfaces_InterfaceType = 240
+	// IETFInterfaces_InterfaceType_sonetOverheadChannel corresponds to the value sonetOverheadChannel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sonetOverheadChannel E_IETFInterfaces_InterfaceType = 241
+	// IETFInterfaces_InterfaceType_sonetPath corresponds to the value sonetPath of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sonetPath E_IETFInterfaces_InterfaceType = 242
+	// IETFInterfaces_InterfaceType_sonetVT corresponds to the value sonetVT of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_sonetVT E_IETFInterfaces_InterfaceType = 243
+	// IETFInterfaces_InterfaceType_srp corresponds to the value srp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_srp E_IETFInterfaces_InterfaceType = 244
+	// IETFInterfaces_InterfaceType_ss7SigLink corresponds to the value ss7SigLink of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ss7SigLink E_IETFInterfaces_InterfaceType = 245
+	// IETFInterfaces_InterfaceType_stackToStack corresponds to the value stackToStack of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_stackToStack E_IETFInterfaces_InterfaceType = 246
+	// IETFInterfaces_InterfaceType_starLan corresponds to the value starLan of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_starLan E_IETFInterfaces_InterfaceType = 247
+	// IETFInterfaces_InterfaceType_tdlc corresponds to the value tdlc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_tdlc E_IETFInterfaces_InterfaceType = 248
+	// IETFInterfaces_InterfaceType_teLink corresponds to the value teLink of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_teLink E_IETFInterfaces_InterfaceType = 249
+	// IETFInterfaces_InterfaceType_termPad corresponds to the value termPad of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_termPad E_IETFInterfaces_InterfaceType = 250
+	// IETFInterfaces_InterfaceType_tr008 corresponds to the value tr008 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_tr008 E_IETFInterfaces_InterfaceType = 251
+	// IETFInterfaces_InterfaceType_transpHdlc corresponds to the value transpHdlc of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_transpHdlc E_IETFInterfaces_InterfaceType = 252
+	// IETFInterfaces_InterfaceType_tunnel corresponds to the value tunnel of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_tunnel E_IETFInterfaces_InterfaceType = 253
+	// IETFInterfaces_InterfaceType_ultra corresponds to the value ultra of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_ultra E_IETFInterfaces_InterfaceType = 254
+	// IETFInterfaces_InterfaceType_usb corresponds to the value usb of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_usb E_IETFInterfaces_InterfaceType = 255
+	// IETFInterfaces_InterfaceType_v11 corresponds to the value v11 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_v11 E_IETFInterfaces_InterfaceType = 256
+	// IETFInterfaces_InterfaceType_v35 corresponds to the value v35 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_v35 E_IETFInterfaces_InterfaceType = 257
+	// IETFInterfaces_InterfaceType_v36 corresponds to the value v36 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_v36 E_IETFInterfaces_InterfaceType = 258
+	// IETFInterfaces_InterfaceType_v37 corresponds to the value v37 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_v37 E_IETFInterfaces_InterfaceType = 259
+	// IETFInterfaces_InterfaceType_vdsl corresponds to the value vdsl of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_vdsl E_IETFInterfaces_InterfaceType = 260
+	// IETFInterfaces_InterfaceType_vdsl2 corresponds to the value vdsl2 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_vdsl2 E_IETFInterfaces_InterfaceType = 261
+	// IETFInterfaces_InterfaceType_virtualIpAddress corresponds to the value virtualIpAddress of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_virtualIpAddress E_IETFInterfaces_InterfaceType = 262
+	// IETFInterfaces_InterfaceType_virtualTg corresponds to the value virtualTg of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_virtualTg E_IETFInterfaces_InterfaceType = 263
+	// IETFInterfaces_InterfaceType_vmwareNicTeam corresponds to the value vmwareNicTeam of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_vmwareNicTeam E_IETFInterfaces_InterfaceType = 264
+	// IETFInterfaces_InterfaceType_vmwareVirtualNic corresponds to the value vmwareVirtualNic of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_vmwareVirtualNic E_IETFInterfaces_InterfaceType = 265
+	// IETFInterfaces_InterfaceType_voiceDID corresponds to the value voiceDID of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceDID E_IETFInterfaces_InterfaceType = 266
+	// IETFInterfaces_InterfaceType_voiceEBS corresponds to the value voiceEBS of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceEBS E_IETFInterfaces_InterfaceType = 267
+	// IETFInterfaces_InterfaceType_voiceEM corresponds to the value voiceEM of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceEM E_IETFInterfaces_InterfaceType = 268
+	// IETFInterfaces_InterfaceType_voiceEMFGD corresponds to the value voiceEMFGD of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceEMFGD E_IETFInterfaces_InterfaceType = 269
+	// IETFInterfaces_InterfaceType_voiceEncap corresponds to the value voiceEncap of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceEncap E_IETFInterfaces_InterfaceType = 270
+	// IETFInterfaces_InterfaceType_voiceFGDEANA corresponds to the value voiceFGDEANA of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceFGDEANA E_IETFInterfaces_InterfaceType = 271
+	// IETFInterfaces_InterfaceType_voiceFGDOS corresponds to the value voiceFGDOS of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceFGDOS E_IETFInterfaces_InterfaceType = 272
+	// IETFInterfaces_InterfaceType_voiceFXO corresponds to the value voiceFXO of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceFXO E_IETFInterfaces_InterfaceType = 273
+	// IETFInterfaces_InterfaceType_voiceFXS corresponds to the value voiceFXS of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceFXS E_IETFInterfaces_InterfaceType = 274
+	// IETFInterfaces_InterfaceType_voiceOverAtm corresponds to the value voiceOverAtm of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceOverAtm E_IETFInterfaces_InterfaceType = 275
+	// IETFInterfaces_InterfaceType_voiceOverCable corresponds to the value voiceOverCable of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceOverCable E_IETFInterfaces_InterfaceType = 276
+	// IETFInterfaces_InterfaceType_voiceOverFrameRelay corresponds to the value voiceOverFrameRelay of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceOverFrameRelay E_IETFInterfaces_InterfaceType = 277
+	// IETFInterfaces_InterfaceType_voiceOverIp corresponds to the value voiceOverIp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_voiceOverIp E_IETFInterfaces_InterfaceType = 278
+	// IETFInterfaces_InterfaceType_wwanPP corresponds to the value wwanPP of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_wwanPP E_IETFInterfaces_InterfaceType = 279
+	// IETFInterfaces_InterfaceType_wwanPP2 corresponds to the value wwanPP2 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_wwanPP2 E_IETFInterfaces_InterfaceType = 280
+	// IETFInterfaces_InterfaceType_x213 corresponds to the value x213 of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_x213 E_IETFInterfaces_InterfaceType = 281
+	// IETFInterfaces_InterfaceType_x25huntGroup corresponds to the value x25huntGroup of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_x25huntGroup E_IETFInterfaces_InterfaceType = 282
+	// IETFInterfaces_InterfaceType_x25mlp corresponds to the value x25mlp of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_x25mlp E_IETFInterfaces_InterfaceType = 283
+	// IETFInterfaces_InterfaceType_x25ple corresponds to the value x25ple of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_x25ple E_IETFInterfaces_InterfaceType = 284
+	// IETFInterfaces_InterfaceType_x86Laps corresponds to the value x86Laps of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_x86Laps E_IETFInterfaces_InterfaceType = 285
+	// IETFInterfaces_InterfaceType_xboxWireless corresponds to the value xboxWireless of IETFInterfaces_InterfaceType
+	IETFInterfaces_InterfaceType_xboxWireless E_IETFInterfaces_InterfaceType = 286
+)
+
+// E_Interface_AdminStatus is a derived int64 type which is used to represent
+// the enumerated node Interface_AdminStatus. An additional value named
+// Interface_AdminStatus_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_Interface_AdminStatus int64
+
+// IsYANGGoEnum ensures that Interface_AdminStatus implements the yang.GoEnum
+// interface. This ensures that Interface_AdminStatus can be identified as a
+// mapped type for a YANG enumeration.
+func (E_Interface_AdminStatus) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  Interface_AdminStatus.
+func (E_Interface_AdminStatus) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_Interface_AdminStatus.
+func (e E_Interface_AdminStatus) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_Interface_AdminStatus")
+}
+
+const (
+	// Interface_AdminStatus_UNSET corresponds to the value UNSET of Interface_AdminStatus
+	Interface_AdminStatus_UNSET E_Interface_AdminStatus = 0
+	// Interface_AdminStatus_UP corresponds to the value UP of Interface_AdminStatus
+	Interface_AdminStatus_UP E_Interface_AdminStatus = 1
+	// Interface_AdminStatus_DOWN corresponds to the value DOWN of Interface_AdminStatus
+	Interface_AdminStatus_DOWN E_Interface_AdminStatus = 2
+	// Interface_AdminStatus_TESTING corresponds to the value TESTING of Interface_AdminStatus
+	Interface_AdminStatus_TESTING E_Interface_AdminStatus = 3
+)
+
+// E_Interface_OperStatus is a derived int64 type which is used to represent
+// the enumerated node Interface_OperStatus. An additional value named
+// Interface_OperStatus_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_Interface_OperStatus int64
+
+// IsYANGGoEnum ensures that Interface_OperStatus implements the yang.GoEnum
+// interface. This ensures that Interface_OperStatus can be identified as a
+// mapped type for a YANG enumeration.
+func (E_Interface_OperStatus) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  Interface_OperStatus.
+func (E_Interface_OperStatus) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_Interface_OperStatus.
+func (e E_Interface_OperStatus) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_Interface_OperStatus")
+}
+
+const (
+	// Interface_OperStatus_UNSET corresponds to the value UNSET of Interface_OperStatus
+	Interface_OperStatus_UNSET E_Interface_OperStatus = 0
+	// Interface_OperStatus_UP corresponds to the value UP of Interface_OperStatus
+	Interface_OperStatus_UP E_Interface_OperStatus = 2
+	// Interface_OperStatus_DOWN corresponds to the value DOWN of Interface_OperStatus
+	Interface_OperStatus_DOWN E_Interface_OperStatus = 3
+	// Interface_OperStatus_TESTING corresponds to the value TESTING of Interface_OperStatus
+	Interface_OperStatus_TESTING E_Interface_OperStatus = 4
+	// Interface_OperStatus_UNKNOWN corresponds to the value UNKNOWN of Interface_OperStatus
+	Interface_OperStatus_UNKNOWN E_Interface_OperStatus = 5
+	// Interface_OperStatus_DORMANT corresponds to the value DORMANT of Interface_OperStatus
+	Interface_OperStatus_DORMANT E_Interface_OperStatus = 6
+	// Interface_OperStatus_NOT_PRESENT corresponds to the value NOT_PRESENT of Interface_OperStatus
+	Interface_OperStatus_NOT_PRESENT E_Interface_OperStatus = 7
+	// Interface_OperStatus_LOWER_LAYER_DOWN corresponds to the value LOWER_LAYER_DOWN of Interface_OperStatus
+	Interface_OperStatus_LOWER_LAYER_DOWN E_Interface_OperStatus = 8
+)
+
+// E_Neighbor_NeighborState is a derived int64 type which is used to represent
+// the enumerated node Neighbor_NeighborState. An additional value named
+// Neighbor_NeighborState_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_Neighbor_NeighborState int64
+
+// IsYANGGoEnum ensures that Neighbor_NeighborState implements the yang.GoEnum
+// interface. This ensures that Neighbor_NeighborState can be identified as a
+// mapped type for a YANG enumeration.
+func (E_Neighbor_NeighborState) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  Neighbor_NeighborState.
+func (E_Neighbor_NeighborState) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_Neighbor_NeighborState.
+func (e E_Neighbor_NeighborState) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_Neighbor_NeighborState")
+}
+
+const (
+	// Neighbor_NeighborState_UNSET corresponds to the value UNSET of Neighbor_NeighborState
+	Neighbor_NeighborState_UNSET E_Neighbor_NeighborState = 0
+	// Neighbor_NeighborState_INCOMPLETE corresponds to the value INCOMPLETE of Neighbor_NeighborState
+	Neighbor_NeighborState_INCOMPLETE E_Neighbor_NeighborState = 1
+	// Neighbor_NeighborState_REACHABLE corresponds to the value REACHABLE of Neighbor_NeighborState
+	Neighbor_NeighborState_REACHABLE E_Neighbor_NeighborState = 2
+	// Neighbor_NeighborState_STALE corresponds to the value STALE of Neighbor_NeighborState
+	Neighbor_NeighborState_STALE E_Neighbor_NeighborState = 3
+	// Neighbor_NeighborState_DELAY corresponds to the value DELAY of Neighbor_NeighborState
+	Neighbor_NeighborState_DELAY E_Neighbor_NeighborState = 4
+	// Neighbor_NeighborState_PROBE corresponds to the value PROBE of Neighbor_NeighborState
+	Neighbor_NeighborState_PROBE E_Neighbor_NeighborState = 5
+)
+
+// E_OpenconfigIfAggregate_AggregationType is a derived int64 type which is used to represent
+// the enumerated node OpenconfigIfAggregate_AggregationType. An additional value named
+// OpenconfigIfAggregate_AggregationType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigIfAggregate_AggregationType int64
+
+// IsYANGGoEnum ensures that OpenconfigIfAggregate_AggregationType implements the yang.GoEnum
+// interface. This ensures that OpenconfigIfAggregate_AggregationType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigIfAggregate_AggregationType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigIfAggregate_AggregationType.
+func (E_OpenconfigIfAggregate_AggregationType) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_OpenconfigIfAggregate_AggregationType.
+func (e E_OpenconfigIfAggregate_AggregationType) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OpenconfigIfAggregate_AggregationType")
+}
+
+const (
+	// OpenconfigIfAggregate_AggregationType_UNSET corresponds to the value UNSET of OpenconfigIfAggregate_AggregationType
+	OpenconfigIfAggregate_AggregationType_UNSET E_OpenconfigIfAggregate_AggregationType = 0
+	// OpenconfigIfAggregate_AggregationType_LACP corresponds to the value LACP of OpenconfigIfAggregate_AggregationType
+	OpenconfigIfAggregate_AggregationType_LACP E_OpenconfigIfAggregate_AggregationType = 1
+	// OpenconfigIfAggregate_AggregationType_STATIC corresponds to the value STATIC of OpenconfigIfAggregate_AggregationType
+	OpenconfigIfAggregate_AggregationType_STATIC E_OpenconfigIfAggregate_AggregationType = 2
+)
+
+// E_OpenconfigIfEthernet_ETHERNET_SPEED is a derived int64 type which is used to represent
+// the enumerated node OpenconfigIfEthernet_ETHERNET_SPEED. An additional value named
+// OpenconfigIfEthernet_ETHERNET_SPEED_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigIfEthernet_ETHERNET_SPEED int64
+
+// IsYANGGoEnum ensures that OpenconfigIfEthernet_ETHERNET_SPEED implements the yang.GoEnum
+// interface. This ensures that OpenconfigIfEthernet_ETHERNET_SPEED can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigIfEthernet_ETHERNET_SPEED) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigIfEthernet_ETHERNET_SPEED.
+func (E_OpenconfigIfEthernet_ETHERNET_SPEED) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_OpenconfigIfEthernet_ETHERNET_SPEED.
+func (e E_OpenconfigIfEthernet_ETHERNET_SPEED) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OpenconfigIfEthernet_ETHERNET_SPEED")
+}
+
+const (
+	// OpenconfigIfEthernet_ETHERNET_SPEED_UNSET corresponds to the value UNSET of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_UNSET E_OpenconfigIfEthernet_ETHERNET_SPEED = 0
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100GB corresponds to the value SPEED_100GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 1
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100MB corresponds to the value SPEED_100MB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100MB E_OpenconfigIfEthernet_ETHERNET_SPEED = 2
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10GB corresponds to the value SPEED_10GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 3
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10MB corresponds to the value SPEED_10MB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10MB E_OpenconfigIfEthernet_ETHERNET_SPEED = 4
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_1GB corresponds to the value SPEED_1GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_1GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 5
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_200GB corresponds to the value SPEED_200GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_200GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 6
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_2500MB corresponds to the value SPEED_2500MB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_2500MB E_OpenconfigIfEthernet_ETHERNET_SPEED = 7
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_25GB corresponds to the value SPEED_25GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_25GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 8
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_400GB corresponds to the value SPEED_400GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_400GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 9
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB corresponds to the value SPEED_40GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 10
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_50GB corresponds to the value SPEED_50GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_50GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 11
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_5GB corresponds to the value SPEED_5GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_5GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 12
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_600GB corresponds to the value SPEED_600GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_600GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 13
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_800GB corresponds to the value SPEED_800GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_800GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 14
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_UNKNOWN corresponds to the value SPEED_UNKNOWN of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_UNKNOWN E_OpenconfigIfEthernet_ETHERNET_SPEED = 15
+)
+
+// E_OpenconfigIfEthernet_INTERFACE_FEC is a derived int64 type which is used to represent
+// the enumerated node OpenconfigIfEthernet_INTERFACE_FEC. An additional value named
+// OpenconfigIfEthernet_INTERFACE_FEC_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigIfEthernet_INTERFACE_FEC int64
+
+// IsYANGGoEnum ensures that OpenconfigIfEthernet_INTERFACE_FEC implements the yang.GoEnum
+// interface. This ensures that OpenconfigIfEthernet_INTERFACE_FEC can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigIfEthernet_INTERFACE_FEC) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigIfEthernet_INTERFACE_FEC.
+func (E_OpenconfigIfEthernet_INTERFACE_FEC) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_OpenconfigIfEthernet_INTERFACE_FEC.
+func (e E_OpenconfigIfEthernet_INTERFACE_FEC) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OpenconfigIfEthernet_INTERFACE_FEC")
+}
+
+const (
+	// OpenconfigIfEthernet_INTERFACE_FEC_UNSET corresponds to the value UNSET of OpenconfigIfEthernet_INTERFACE_FEC
+	OpenconfigIfEthernet_INTERFACE_FEC_UNSET E_OpenconfigIfEthernet_INTERFACE_FEC = 0
+	// OpenconfigIfEthernet_INTERFACE_FEC_FEC_DISABLED corresponds to the value FEC_DISABLED of OpenconfigIfEthernet_INTERFACE_FEC
+	OpenconfigIfEthernet_INTERFACE_FEC_FEC_DISABLED E_OpenconfigIfEthernet_INTERFACE_FEC = 1
+	// OpenconfigIfEthernet_INTERFACE_FEC_FEC_FC corresponds to the value FEC_FC of OpenconfigIfEthernet_INTERFACE_FEC
+	OpenconfigIfEthernet_INTERFACE_FEC_FEC_FC E_OpenconfigIfEthernet_INTERFACE_FEC = 2
+	// OpenconfigIfEthernet_INTERFACE_FEC_FEC_RS528 corresponds to the value FEC_RS528 of OpenconfigIfEthernet_INTERFACE_FEC
+	OpenconfigIfEthernet_INTERFACE_FEC_FEC_RS528 E_OpenconfigIfEthernet_INTERFACE_FEC = 3
+	// OpenconfigIfEthernet_INTERFACE_FEC_FEC_RS544 corresponds to the value FEC_RS544 of OpenconfigIfEthernet_INTERFACE_FEC
+	OpenconfigIfEthernet_INTERFACE_FEC_FEC_RS544 E_OpenconfigIfEthernet_INTERFACE_FEC = 4
+	// OpenconfigIfEthernet_INTERFACE_FEC_FEC_RS544_2X_INTERLEAVE corresponds to the value FEC_RS544_2X_INTERLEAVE of OpenconfigIfEthernet_INTERFACE_FEC
+	OpenconfigIfEthernet_INTERFACE_FEC_FEC_RS544_2X_INTERLEAVE E_OpenconfigIfEthernet_INTERFACE_FEC = 5
+)
+
+// E_OpenconfigIfIp_IpAddressOrigin is a derived int64 type which is used to represent
+// the enumerated node OpenconfigIfIp_IpAddressOrigin. An additional value named
+// OpenconfigIfIp_IpAddressOrigin_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigIfIp_IpAddressOrigin int64
+
+// IsYANGGoEnum ensures that OpenconfigIfIp_IpAddressOrigin implements the yang.GoEnum
+// interface. This ensures that OpenconfigIfIp_IpAddressOrigin can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigIfIp_IpAddressOrigin) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigIfIp_IpAddressOrigin.
+func (E_OpenconfigIfIp_IpAddressOrigin) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_OpenconfigIfIp_IpAddressOrigin.
+func (e E_OpenconfigIfIp_IpAddressOrigin) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OpenconfigIfIp_IpAddressOrigin")
+}
+
+const (
+	// OpenconfigIfIp_IpAddressOrigin_UNSET corresponds to the value UNSET of OpenconfigIfIp_IpAddressOrigin
+	OpenconfigIfIp_IpAddressOrigin_UNSET E_OpenconfigIfIp_IpAddressOrigin = 0
+	// OpenconfigIfIp_IpAddressOrigin_OTHER corresponds to the value OTHER of OpenconfigIfIp_IpAddressOrigin
+	OpenconfigIfIp_IpAddressOrigin_OTHER E_OpenconfigIfIp_IpAddressOrigin = 1
+	// OpenconfigIfIp_IpAddressOrigin_STATIC corresponds to the value STATIC of OpenconfigIfIp_IpAddressOrigin
+	OpenconfigIfIp_IpAddressOrigin_STATIC E_OpenconfigIfIp_IpAddressOrigin = 2
+	// OpenconfigIfIp_IpAddressOrigin_DHCP corresponds to the value DHCP of OpenconfigIfIp_IpAddressOrigin
+	OpenconfigIfIp_IpAddressOrigin_DHCP E_OpenconfigIfIp_IpAddressOrigin = 3
+	// OpenconfigIfIp_IpAddressOrigin_LINK_LAYER corresponds to the value LINK_LAYER of OpenconfigIfIp_IpAddressOrigin
+	OpenconfigIfIp_IpAddressOrigin_LINK_LAYER E_OpenconfigIfIp_IpAddressOrigin = 4
+	// OpenconfigIfIp_IpAddressOrigin_RANDOM corresponds to the value RANDOM of OpenconfigIfIp_IpAddressOrigin
+	OpenconfigIfIp_IpAddressOrigin_RANDOM E_OpenconfigIfIp_IpAddressOrigin = 5
+)
+
+// E_OpenconfigIfIp_Ipv4AddressType is a derived int64 type which is used to represent
+// the enumerated node OpenconfigIfIp_Ipv4AddressType. An additional value named
+// OpenconfigIfIp_Ipv4AddressType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigIfIp_Ipv4AddressType int64
+
+// IsYANGGoEnum ensures that OpenconfigIfIp_Ipv4AddressType implements the yang.GoEnum
+// interface. This ensures that OpenconfigIfIp_Ipv4AddressType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigIfIp_Ipv4AddressType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigIfIp_Ipv4AddressType.
+func (E_OpenconfigIfIp_Ipv4AddressType) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_OpenconfigIfIp_Ipv4AddressType.
+func (e E_OpenconfigIfIp_Ipv4AddressType) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OpenconfigIfIp_Ipv4AddressType")
+}
+
+const (
+	// OpenconfigIfIp_Ipv4AddressType_UNSET corresponds to the value UNSET of OpenconfigIfIp_Ipv4AddressType
+	OpenconfigIfIp_Ipv4AddressType_UNSET E_OpenconfigIfIp_Ipv4AddressType = 0
+	// OpenconfigIfIp_Ipv4AddressType_PRIMARY corresponds to the value PRIMARY of OpenconfigIfIp_Ipv4AddressType
+	OpenconfigIfIp_Ipv4AddressType_PRIMARY E_OpenconfigIfIp_Ipv4AddressType = 1
+	// OpenconfigIfIp_Ipv4AddressType_SECONDARY corresponds to the value SECONDARY of OpenconfigIfIp_Ipv4AddressType
+	OpenconfigIfIp_Ipv4AddressType_SECONDARY E_OpenconfigIfIp_Ipv4AddressType = 2
+)
+
+// E_OpenconfigIfIp_Ipv6AddressType is a derived int64 type which is used to represent
+// the enumerated node OpenconfigIfIp_Ipv6AddressType. An additional value named
+// OpenconfigIfIp_Ipv6AddressType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigIfIp_Ipv6AddressType int64
+
+// IsYANGGoEnum ensures that OpenconfigIfIp_Ipv6AddressType implements the yang.GoEnum
+// interface. This ensures that OpenconfigIfIp_Ipv6AddressType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigIfIp_Ipv6AddressType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigIfIp_Ipv6AddressType.
+func (E_OpenconfigIfIp_Ipv6AddressType) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_OpenconfigIfIp_Ipv6AddressType.
+func (e E_OpenconfigIfIp_Ipv6AddressType) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OpenconfigIfIp_Ipv6AddressType")
+}
+
+const (
+	// OpenconfigIfIp_Ipv6AddressType_UNSET corresponds to the value UNSET of OpenconfigIfIp_Ipv6AddressType
+	OpenconfigIfIp_Ipv6AddressType_UNSET E_OpenconfigIfIp_Ipv6AddressType = 0
+	// OpenconfigIfIp_Ipv6AddressType_GLOBAL_UNICAST corresponds to the value GLOBAL_UNICAST of OpenconfigIfIp_Ipv6AddressType
+	OpenconfigIfIp_Ipv6AddressType_GLOBAL_UNICAST E_OpenconfigIfIp_Ipv6AddressType = 1
+	// OpenconfigIfIp_Ipv6AddressType_LINK_LOCAL_UNICAST corresponds to the value LINK_LOCAL_UNICAST of OpenconfigIfIp_Ipv6AddressType
+	OpenconfigIfIp_Ipv6AddressType_LINK_LOCAL_UNICAST E_OpenconfigIfIp_Ipv6AddressType = 2
+)
+
+// E_OpenconfigIfIp_NeighborOrigin is a derived int64 type which is used to represent
+// the enumerated node OpenconfigIfIp_NeighborOrigin. An additional value named
+// OpenconfigIfIp_NeighborOrigin_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigIfIp_NeighborOrigin int64
+
+// IsYANGGoEnum ensures that OpenconfigIfIp_NeighborOrigin implements the yang.GoEnum
+// interface. This ensures that OpenconfigIfIp_NeighborOrigin can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigIfIp_NeighborOrigin) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigIfIp_NeighborOrigin.
+func (E_OpenconfigIfIp_NeighborOrigin) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_OpenconfigIfIp_NeighborOrigin.
+func (e E_OpenconfigIfIp_NeighborOrigin) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OpenconfigIfIp_NeighborOrigin")
+}
+
+const (
+	// OpenconfigIfIp_NeighborOrigin_UNSET corresponds to the value UNSET of OpenconfigIfIp_NeighborOrigin
+	OpenconfigIfIp_NeighborOrigin_UNSET E_OpenconfigIfIp_NeighborOrigin = 0
+	// OpenconfigIfIp_NeighborOrigin_OTHER corresponds to the value OTHER of OpenconfigIfIp_NeighborOrigin
+	OpenconfigIfIp_NeighborOrigin_OTHER E_OpenconfigIfIp_NeighborOrigin = 1
+	// OpenconfigIfIp_NeighborOrigin_STATIC corresponds to the value STATIC of OpenconfigIfIp_NeighborOrigin
+	OpenconfigIfIp_NeighborOrigin_STATIC E_OpenconfigIfIp_NeighborOrigin = 2
+	// OpenconfigIfIp_NeighborOrigin_DYNAMIC corresponds to the value DYNAMIC of OpenconfigIfIp_NeighborOrigin
+	OpenconfigIfIp_NeighborOrigin_DYNAMIC E_OpenconfigIfIp_NeighborOrigin = 3
+)
+
+// E_OpenconfigInterfaces_LoopbackModeType is a derived int64 type which is used to represent
+// the enumerated node OpenconfigInterfaces_LoopbackModeType. An additional value named
+// OpenconfigInterfaces_LoopbackModeType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigInterfaces_LoopbackModeType int64
+
+// IsYANGGoEnum ensures that OpenconfigInterfaces_LoopbackModeType implements the yang.GoEnum
+// interface. This ensures that OpenconfigInterfaces_LoopbackModeType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigInterfaces_LoopbackModeType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigInterfaces_LoopbackModeType.
+func (E_OpenconfigInterfaces_LoopbackModeType) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_OpenconfigInterfaces_LoopbackModeType.
+func (e E_OpenconfigInterfaces_LoopbackModeType) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OpenconfigInterfaces_LoopbackModeType")
+}
+
+const (
+	// OpenconfigInterfaces_LoopbackModeType_UNSET corresponds to the value UNSET of OpenconfigInterfaces_LoopbackModeType
+	OpenconfigInterfaces_LoopbackModeType_UNSET E_OpenconfigInterfaces_LoopbackModeType = 0
+	// OpenconfigInterfaces_LoopbackModeType_NONE corresponds to the value NONE of OpenconfigInterfaces_LoopbackModeType
+	OpenconfigInterfaces_LoopbackModeType_NONE E_OpenconfigInterfaces_LoopbackModeType = 1
+	// OpenconfigInterfaces_LoopbackModeType_FACILITY corresponds to the value FACILITY of OpenconfigInterfaces_LoopbackModeType
+	OpenconfigInterfaces_LoopbackModeType_FACILITY E_OpenconfigInterfaces_LoopbackModeType = 2
+	// OpenconfigInterfaces_LoopbackModeType_TERMINAL corresponds to the value TERMINAL of OpenconfigInterfaces_LoopbackModeType
+	OpenconfigInterfaces_LoopbackModeType_TERMINAL E_OpenconfigInterfaces_LoopbackModeType = 3
+)
+
+// E_OpenconfigVlanTypes_TPID_TYPES is a derived int64 type which is used to represent
+// the enumerated node OpenconfigVlanTypes_TPID_TYPES. An additional value named
+// OpenconfigVlanTypes_TPID_TYPES_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigVlanTypes_TPID_TYPES int64
+
+// IsYANGGoEnum ensures that OpenconfigVlanTypes_TPID_TYPES implements the yang.GoEnum
+// interface. This ensures that OpenconfigVlanTypes_TPID_TYPES can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigVlanTypes_TPID_TYPES) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigVlanTypes_TPID_TYPES.
+func (E_OpenconfigVlanTypes_TPID_TYPES) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_OpenconfigVlanTypes_TPID_TYPES.
+func (e E_OpenconfigVlanTypes_TPID_TYPES) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OpenconfigVlanTypes_TPID_TYPES")
+}
+
+const (
+	// OpenconfigVlanTypes_TPID_TYPES_UNSET corresponds to the value UNSET of OpenconfigVlanTypes_TPID_TYPES
+	OpenconfigVlanTypes_TPID_TYPES_UNSET E_OpenconfigVlanTypes_TPID_TYPES = 0
+	// OpenconfigVlanTypes_TPID_TYPES_TPID_0X8100 corresponds to the value TPID_0X8100 of OpenconfigVlanTypes_TPID_TYPES
+	OpenconfigVlanTypes_TPID_TYPES_TPID_0X8100 E_OpenconfigVlanTypes_TPID_TYPES = 1
+	// OpenconfigVlanTypes_TPID_TYPES_TPID_0X88A8 corresponds to the value TPID_0X88A8 of OpenconfigVlanTypes_TPID_TYPES
+	OpenconfigVlanTypes_TPID_TYPES_TPID_0X88A8 E_OpenconfigVlanTypes_TPID_TYPES = 2
+	// OpenconfigVlanTypes_TPID_TYPES_TPID_0X9100 corresponds to the value TPID_0X9100 of OpenconfigVlanTypes_TPID_TYPES
+	OpenconfigVlanTypes_TPID_TYPES_TPID_0X9100 E_OpenconfigVlanTypes_TPID_TYPES = 3
+	// OpenconfigVlanTypes_TPID_TYPES_TPID_0X9200 corresponds to the value TPID_0X9200 of OpenconfigVlanTypes_TPID_TYPES
+	OpenconfigVlanTypes_TPID_TYPES_TPID_0X9200 E_OpenconfigVlanTypes_TPID_TYPES = 4
+	// OpenconfigVlanTypes_TPID_TYPES_TPID_ANY corresponds to the value TPID_ANY of OpenconfigVlanTypes_TPID_TYPES
+	OpenconfigVlanTypes_TPID_TYPES_TPID_ANY E_OpenconfigVlanTypes_TPID_TYPES = 5
+)
+
+// E_OpenconfigVlan_VlanModeType is a derived int64 type which is used to represent
+// the enumerated node OpenconfigVlan_VlanModeType. An additional value named
+// OpenconfigVlan_VlanModeType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigVlan_VlanModeType int64
+
+// IsYANGGoEnum ensures that OpenconfigVlan_VlanModeType implements the yang.GoEnum
+// interface. This ensures that OpenconfigVlan_VlanModeType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigVlan_VlanModeType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigVlan_VlanModeType.
+func (E_OpenconfigVlan_VlanModeType) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_OpenconfigVlan_VlanModeType.
+func (e E_OpenconfigVlan_VlanModeType) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OpenconfigVlan_VlanModeType")
+}
+
+const (
+	// OpenconfigVlan_VlanModeType_UNSET corresponds to the value UNSET of OpenconfigVlan_VlanModeType
+	OpenconfigVlan_VlanModeType_UNSET E_OpenconfigVlan_VlanModeType = 0
+	// OpenconfigVlan_VlanModeType_ACCESS corresponds to the value ACCESS of OpenconfigVlan_VlanModeType
+	OpenconfigVlan_VlanModeType_ACCESS E_OpenconfigVlan_VlanModeType = 1
+	// OpenconfigVlan_VlanModeType_TRUNK corresponds to the value TRUNK of OpenconfigVlan_VlanModeType
+	OpenconfigVlan_VlanModeType_TRUNK E_OpenconfigVlan_VlanModeType = 2
+)
+
+// E_OpenconfigVlan_VlanStackAction is a derived int64 type which is used to represent
+// the enumerated node OpenconfigVlan_VlanStackAction. An additional value named
+// OpenconfigVlan_VlanStackAction_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigVlan_VlanStackAction int64
+
+// IsYANGGoEnum ensures that OpenconfigVlan_VlanStackAction implements the yang.GoEnum
+// interface. This ensures that OpenconfigVlan_VlanStackAction can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigVlan_VlanStackAction) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigVlan_VlanStackAction.
+func (E_OpenconfigVlan_VlanStackAction) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_OpenconfigVlan_VlanStackAction.
+func (e E_OpenconfigVlan_VlanStackAction) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OpenconfigVlan_VlanStackAction")
+}
+
+const (
+	// OpenconfigVlan_VlanStackAction_UNSET corresponds to the value UNSET of OpenconfigVlan_VlanStackAction
+	OpenconfigVlan_VlanStackAction_UNSET E_OpenconfigVlan_VlanStackAction = 0
+	// OpenconfigVlan_VlanStackAction_PUSH corresponds to the value PUSH of OpenconfigVlan_VlanStackAction
+	OpenconfigVlan_VlanStackAction_PUSH E_OpenconfigVlan_VlanStackAction = 1
+	// OpenconfigVlan_VlanStackAction_POP corresponds to the value POP of OpenconfigVlan_VlanStackAction
+	OpenconfigVlan_VlanStackAction_POP E_OpenconfigVlan_VlanStackAction = 2
+	// OpenconfigVlan_VlanStackAction_SWAP corresponds to the value SWAP of OpenconfigVlan_VlanStackAction
+	OpenconfigVlan_VlanStackAction_SWAP E_OpenconfigVlan_VlanStackAction = 3
+)
+
+// E_ProxyArp_Mode is a derived int64 type which is used to represent
+// the enumerated node ProxyArp_Mode. An additional value named
+// ProxyArp_Mode_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_ProxyArp_Mode int64
+
+// IsYANGGoEnum ensures that ProxyArp_Mode implements the yang.GoEnum
+// interface. This ensures that ProxyArp_Mode can be identified as a
+// mapped type for a YANG enumeration.
+func (E_ProxyArp_Mode) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  ProxyArp_Mode.
+func (E_ProxyArp_Mode) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_ProxyArp_Mode.
+func (e E_ProxyArp_Mode) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_ProxyArp_Mode")
+}
+
+const (
+	// ProxyArp_Mode_UNSET corresponds to the value UNSET of ProxyArp_Mode
+	ProxyArp_Mode_UNSET E_ProxyArp_Mode = 0
+	// ProxyArp_Mode_DISABLE corresponds to the value DISABLE of ProxyArp_Mode
+	ProxyArp_Mode_DISABLE E_ProxyArp_Mode = 1
+	// ProxyArp_Mode_REMOTE_ONLY corresponds to the value REMOTE_ONLY of ProxyArp_Mode
+	ProxyArp_Mode_REMOTE_ONLY E_ProxyArp_Mode = 2
+	// ProxyArp_Mode_ALL corresponds to the value ALL of ProxyArp_Mode
+	ProxyArp_Mode_ALL E_ProxyArp_Mode = 3
+)
+
+// E_RouterAdvertisement_Mode is a derived int64 type which is used to represent
+// the enumerated node RouterAdvertisement_Mode. An additional value named
+// RouterAdvertisement_Mode_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_RouterAdvertisement_Mode int64
+
+// IsYANGGoEnum ensures that RouterAdvertisement_Mode implements the yang.GoEnum
+// interface. This ensures that RouterAdvertisement_Mode can be identified as a
+// mapped type for a YANG enumeration.
+func (E_RouterAdvertisement_Mode) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  RouterAdvertisement_Mode.
+func (E_RouterAdvertisement_Mode) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_RouterAdvertisement_Mode.
+func (e E_RouterAdvertisement_Mode) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_RouterAdvertisement_Mode")
+}
+
+const (
+	// RouterAdvertisement_Mode_UNSET corresponds to the value UNSET of RouterAdvertisement_Mode
+	RouterAdvertisement_Mode_UNSET E_RouterAdvertisement_Mode = 0
+	// RouterAdvertisement_Mode_ALL corresponds to the value ALL of RouterAdvertisement_Mode
+	RouterAdvertisement_Mode_ALL E_RouterAdvertisement_Mode = 1
+	// RouterAdvertisement_Mode_DISABLE_UNSOLICITED_RA corresponds to the value DISABLE_UNSOLICITED_RA of RouterAdvertisement_Mode
+	RouterAdvertisement_Mode_DISABLE_UNSOLICITED_RA E_RouterAdvertisement_Mode = 2
+)
+
+// ΛEnum is a map, keyed by the name of the type defined for each enum in the
+// generated Go code, which provides a mapping between the constant int64 value
+// of each value of the enumeration, and the string that is used to represent it
+// in the YANG schema. The map is named ΛEnum in order to avoid clash with any
+// valid YANG identifier.
+var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
+	"E_Address_Status": {
+		1: {Name: "PREFERRED"},
+		2: {Name: "DEPRECATED"},
+		3: {Name: "INVALID"},
+		4: {Name: "INACCESSIBLE"},
+		5: {Name: "UNKNOWN"},
+		6: {Name: "TENTATIVE"},
+		7: {Name: "DUPLICATE"},
+		8: {Name: "OPTIMISTIC"},
+	},
+	"E_Ethernet_DuplexMode": {
+		1: {Name: "FULL"},
+		2: {Name: "HALF"},
+	},
+	"E_Ethernet_NegotiatedDuplexMode": {
+		1: {Name: "FULL"},
+		2: {Name: "HALF"},
+	},
+	"E_IETFInterfaces_InterfaceType": {
+		1:   {Name: "a12MppSwitch", DefiningModule: "iana-if-type"},
+		2:   {Name: "aal2", DefiningModule: "iana-if-type"},
+		3:   {Name: "aal5", DefiningModule: "iana-if-type"},
+		4:   {Name: "actelisMetaLOOP", DefiningModule: "iana-if-type"},
+		5:   {Name: "adsl", DefiningModule: "iana-if-type"},
+		6:   {Name: "adsl2", DefiningModule: "iana-if-type"},
+		7:   {Name: "adsl2plus", DefiningModule: "iana-if-type"},
+		8:   {Name: "aflane8023", DefiningModule: "iana-if-type"},
+		9:   {Name: "aflane8025", DefiningModule: "iana-if-type"},
+		10:  {Name: "aluELP", DefiningModule: "iana-if-type"},
+		11:  {Name: "aluEpon", DefiningModule: "iana-if-type"},
+		12:  {Name: "aluEponLogicalLink", DefiningModule: "iana-if-type"},
+		13:  {Name: "aluEponOnu", DefiningModule: "iana-if-type"},
+		14:  {Name: "aluEponPhysicalUni", DefiningModule: "iana-if-type"},
+		15:  {Name: "aluGponOnu", DefiningModule: "iana-if-type"},
+		16:  {Name: "aluGponPhysicalUni", DefiningModule: "iana-if-type"},
+		17:  {Name: "arap", DefiningModule: "iana-if-type"},
+		18:  {Name: "arcnet", DefiningModule: "iana-if-type"},
+		19:  {Name: "arcnetPlus", DefiningModule: "iana-if-type"},
+		20:  {Name: "async", DefiningModule: "iana-if-type"},
+		21:  {Name: "atm", DefiningModule: "iana-if-type"},
+		22:  {Name: "atmDxi", DefiningModule: "iana-if-type"},
+		23:  {Name: "atmFuni", DefiningModule: "iana-if-type"},
+		24:  {Name: "atmIma", DefiningModule: "iana-if-type"},
+		25:  {Name: "atmLogical", DefiningModule: "iana-if-type"},
+		26:  {Name: "atmRadio", DefiningModule: "iana-if-type"},
+		27:  {Name: "atmSubInterface", DefiningModule: "iana-if-type"},
+		28:  {Name: "atmVciEndPt", DefiningModule: "iana-if-type"},
+		29:  {Name: "atmVirtual", DefiningModule: "iana-if-type"},
+		30:  {Name: "atmbond", DefiningModule: "iana-if-type"},
+		31:  {Name: "aviciOpticalEther", DefiningModule: "iana-if-type"},
+		32:  {Name: "basicISDN", DefiningModule: "iana-if-type"},
+		33:  {Name: "bgppolicyaccounting", DefiningModule: "iana-if-type"},
+		34:  {Name: "bits", DefiningModule: "iana-if-type"},
+		35:  {Name: "bridge", DefiningModule: "iana-if-type"},
+		36:  {Name: "bsc", DefiningModule: "iana-if-type"},
+		37:  {Name: "cableDownstreamRfPort", DefiningModule: "iana-if-type"},
+		38:  {Name: "capwapDot11Bss", DefiningModule: "iana-if-type"},
+		39:  {Name: "capwapDot11Profile", DefiningModule: "iana-if-type"},
+		40:  {Name: "capwapWtpVirtualRadio", DefiningModule: "iana-if-type"},
+		41:  {Name: "cblVectaStar", DefiningModule: "iana-if-type"},
+		42:  {Name: "cctEmul", DefiningModule: "iana-if-type"},
+		43:  {Name: "ces", DefiningModule: "iana-if-type"},
+		44:  {Name: "channel", DefiningModule: "iana-if-type"},
+		45:  {Name: "ciscoISLvlan", DefiningModule: "iana-if-type"},
+		46:  {Name: "cnr", DefiningModule: "iana-if-type"},
+		47:  {Name: "coffee", DefiningModule: "iana-if-type"},
+		48:  {Name: "compositeLink", DefiningModule: "iana-if-type"},
+		49:  {Name: "dcn", DefiningModule: "iana-if-type"},
+		50:  {Name: "ddnX25", DefiningModule: "iana-if-type"},
+		51:  {Name: "digitalPowerline", DefiningModule: "iana-if-type"},
+		52:  {Name: "digitalWrapperOverheadChannel", DefiningModule: "iana-if-type"},
+		53:  {Name: "dlsw", DefiningModule: "iana-if-type"},
+		54:  {Name: "docsCableDownstream", DefiningModule: "iana-if-type"},
+		55:  {Name: "docsCableMCmtsDownstream", DefiningModule: "iana-if-type"},
+		56:  {Name: "docsCableMaclayer", DefiningModule: "iana-if-type"},
+		57:  {Name: "docsCableNdf", DefiningModule: "iana-if-type"},
+		58:  {Name: "docsCableNdr", DefiningModule: "iana-if-type"},
+		59:  {Name: "docsCableScte55d1FwdOob", DefiningModule: "iana-if-type"},
+		60:  {Name: "docsCableScte55d1RetOob", DefiningModule: "iana-if-type"},
+		61:  {Name: "docsCableScte55d2DsOob", DefiningModule: "iana-if-type"},
+		62:  {Name: "docsCableScte55d2UsOob", DefiningModule: "iana-if-type"},
+		63:  {Name: "docsCableUpstream", DefiningModule: "iana-if-type"},
+		64:  {Name: "docsCableUpstreamChannel", DefiningModule: "iana-if-type"},
+		65:  {Name: "docsCableUpstreamRfPort", DefiningModule: "iana-if-type"},
+		66:  {Name: "docsOfdmDownstream", DefiningModule: "iana-if-type"},
+		67:  {Name: "docsOfdmaUpstream", DefiningModule: "iana-if-type"},
+		68:  {Name: "ds0", DefiningModule: "iana-if-type"},
+		69:  {Name: "ds0Bundle", DefiningModule: "iana-if-type"},
+		70:  {Name: "ds1", DefiningModule: "iana-if-type"},
+		71:  {Name: "ds1FDL", DefiningModule: "iana-if-type"},
+		72:  {Name: "ds3", DefiningModule: "iana-if-type"},
+		73:  {Name: "dtm", DefiningModule: "iana-if-type"},
+		74:  {Name: "dvbAsiIn", DefiningModule: "iana-if-type"},
+		75:  {Name: "dvbAsiOut", DefiningModule: "iana-if-type"},
+		76:  {Name: "dvbRccDownstream", DefiningModule: "iana-if-type"},
+		77:  {Name: "dvbRccMacLayer", DefiningModule: "iana-if-type"},
+		78:  {Name: "dvbRccUpstream", DefiningModule: "iana-if-type"},
+		79:  {Name: "dvbRcsMacLayer", DefiningModule: "iana-if-type"},
+		80:  {Name: "dvbRcsTdma", DefiningModule: "iana-if-type"},
+		81:  {Name: "dvbTdm", DefiningModule: "iana-if-type"},
+		82:  {Name: "e1", DefiningModule: "iana-if-type"},
+		83:  {Name: "econet", DefiningModule: "iana-if-type"},
+		84:  {Name: "eon", DefiningModule: "iana-if-type"},
+		85:  {Name: "eplrs", DefiningModule: "iana-if-type"},
+		86:  {Name: "escon", DefiningModule: "iana-if-type"},
+		87:  {Name: "ethernet3Mbit", DefiningModule: "iana-if-type"},
+		88:  {Name: "ethernetCsmacd", DefiningModule: "iana-if-type"},
+		89:  {Name: "fast", DefiningModule: "iana-if-type"},
+		90:  {Name: "fastEther", DefiningModule: "iana-if-type"},
+		91:  {Name: "fastEtherFX", DefiningModule: "iana-if-type"},
+		92:  {Name: "fastdsl", DefiningModule: "iana-if-type"},
+		93:  {Name: "fcipLink", DefiningModule: "iana-if-type"},
+		94:  {Name: "fddi", DefiningModule: "iana-if-type"},
+		95:  {Name: "fibreChannel", DefiningModule: "iana-if-type"},
+		96:  {Name: "frDlciEndPt", DefiningModule: "iana-if-type"},
+		97:  {Name: "frForward", DefiningModule: "iana-if-type"},
+		98:  {Name: "frameRelay", DefiningModule: "iana-if-type"},
+		99:  {Name: "frameRelayInterconnect", DefiningModule: "iana-if-type"},
+		100: {Name: "frameRelayMPI", DefiningModule: "iana-if-type"},
+		101: {Name: "frameRelayService", DefiningModule: "iana-if-type"},
+		102: {Name: "frf16MfrBundle", DefiningModule: "iana-if-type"},
+		103: {Name: "g703at2mb", DefiningModule: "iana-if-type"},
+		104: {Name: "g703at64k", DefiningModule: "iana-if-type"},
+		105: {Name: "g9981", DefiningModule: "iana-if-type"},
+		106: {Name: "g9982", DefiningModule: "iana-if-type"},
+		107: {Name: "g9983", DefiningModule: "iana-if-type"},
+		108: {Name: "gfast", DefiningModule: "iana-if-type"},
+		109: {Name: "gfp", DefiningModule: "iana-if-type"},
+		110: {Name: "gigabitEthernet", DefiningModule: "iana-if-type"},
+		111: {Name: "gpon", DefiningModule: "iana-if-type"},
+		112: {Name: "gr303IDT", DefiningModule: "iana-if-type"},
+		113: {Name: "gr303RDT", DefiningModule: "iana-if-type"},
+		114: {Name: "gtp", DefiningModule: "iana-if-type"},
+		115: {Name: "h323Gatekeeper", DefiningModule: "iana-if-type"},
+		116: {Name: "h323Proxy", DefiningModule: "iana-if-type"},
+		117: {Name: "hdh1822", DefiningModule: "iana-if-type"},
+		118: {Name: "hdlc", DefiningModule: "iana-if-type"},
+		119: {Name: "hdsl2", DefiningModule: "iana-if-type"},
+		120: {Name: "hiperlan2", DefiningModule: "iana-if-type"},
+		121: {Name: "hippi", DefiningModule: "iana-if-type"},
+		122: {Name: "hippiInterface", DefiningModule: "iana-if-type"},
+		123: {Name: "homepna", DefiningModule: "iana-if-type"},
+		124: {Name: "hostPad", DefiningModule: "iana-if-type"},
+		125: {Name: "hssi", DefiningModule: "iana-if-type"},
+		126: {Name: "hyperchannel", DefiningModule: "iana-if-type"},
+		127: {Name: "iana-interface-type", DefiningModule: "iana-if-type"},
+		128: {Name: "ibm370parChan", DefiningModule: "iana-if-type"},
+		129: {Name: "idsl", DefiningModule: "iana-if-type"},
+		130: {Name: "ieee1394", DefiningModule: "iana-if-type"},
+		131: {Name: "ieee80211", DefiningModule: "iana-if-type"},
+		132: {Name: "ieee80212", DefiningModule: "iana-if-type"},
+		133: {Name: "ieee802154", DefiningModule: "iana-if-type"},
+		134: {Name: "ieee80216WMAN", DefiningModule: "iana-if-type"},
+		135: {Name: "ieee8023adLag", DefiningModule: "iana-if-type"},
+		136: {Name: "if-gsn", DefiningModule: "iana-if-type"},
+		137: {Name: "ifPwType", DefiningModule: "iana-if-type"},
+		138: {Name: "ifVfiType", DefiningModule: "iana-if-type"},
+		139: {Name: "ilan", DefiningModule: "iana-if-type"},
+		140: {Name: "imt", DefiningModule: "iana-if-type"},
+		141: {Name: "infiniband", DefiningModule: "iana-if-type"},
+		142: {Name: "interleave", DefiningModule: "iana-if-type"},
+		143: {Name: "ip", DefiningModule: "iana-if-type"},
+		144: {Name: "ipForward", DefiningModule: "iana-if-type"},
+		145: {Name: "ipOverAtm", DefiningModule: "iana-if-type"},
+		146: {Name: "ipOverCdlc", DefiningModule: "iana-if-type"},
+		147: {Name: "ipOverClaw", DefiningModule: "iana-if-type"},
+		148: {Name: "ipSwitch", DefiningModule: "iana-if-type"},
+		149: {Name: "isdn", DefiningModule: "iana-if-type"},
+		150: {Name: "isdns", DefiningModule: "iana-if-type"},
+		151: {Name: "isdnu", DefiningModule: "iana-if-type"},
+		152: {Name: "iso88022llc", DefiningModule: "iana-if-type"},
+		153: {Name: "iso88023Csmacd", DefiningModule: "iana-if-type"},
+		154: {Name: "iso88024TokenBus", DefiningModule: "iana-if-type"},
+		155: {Name: "iso88025CRFPInt", DefiningModule: "iana-if-type"},
+		156: {Name: "iso88025Dtr", DefiningModule: "iana-if-type"},
+		157: {Name: "iso88025Fiber", DefiningModule: "iana-if-type"},
+		158: {Name: "iso88025TokenRing", DefiningModule: "iana-if-type"},
+		159: {Name: "iso88026Man", DefiningModule: "iana-if-type"},
+		160: {Name: "isup", DefiningModule: "iana-if-type"},
+		161: {Name: "l2vlan", DefiningModule: "iana-if-type"},
+		162: {Name: "l3ipvlan", DefiningModule: "iana-if-type"},
+		163: {Name: "l3ipxvlan", DefiningModule: "iana-if-type"},
+		164: {Name: "lapb", DefiningModule: "iana-if-type"},
+		165: {Name: "lapd", DefiningModule: "iana-if-type"},
+		166: {Name: "lapf", DefiningModule: "iana-if-type"},
+		167: {Name: "linegroup", DefiningModule: "iana-if-type"},
+		168: {Name: "lmp", DefiningModule: "iana-if-type"},
+		169: {Name: "localTalk", DefiningModule: "iana-if-type"},
+		170: {Name: "macSecControlledIF", DefiningModule: "iana-if-type"},
+		171: {Name: "macSecUncontrolledIF", DefiningModule: "iana-if-type"},
+		172: {Name: "mediaMailOverIp", DefiningModule: "iana-if-type"},
+		173: {Name: "mfSigLink", DefiningModule: "iana-if-type"},
+		174: {Name: "miox25", DefiningModule: "iana-if-type"},
+		175: {Name: "mocaVersion1", DefiningModule: "iana-if-type"},
+		176: {Name: "modem", DefiningModule: "iana-if-type"},
+		177: {Name: "mpc", DefiningModule: "iana-if-type"},
+		178: {Name: "mpegTransport", DefiningModule: "iana-if-type"},
+		179: {Name: "mpls", DefiningModule: "iana-if-type"},
+		180: {Name: "mplsTunnel", DefiningModule: "iana-if-type"},
+		181: {Name: "msdsl", DefiningModule: "iana-if-type"},
+		182: {Name: "mvl", DefiningModule: "iana-if-type"},
+		183: {Name: "myrinet", DefiningModule: "iana-if-type"},
+		184: {Name: "nfas", DefiningModule: "iana-if-type"},
+		185: {Name: "nsip", DefiningModule: "iana-if-type"},
+		186: {Name: "opticalChannel", DefiningModule: "iana-if-type"},
+		187: {Name: "opticalChannelGroup", DefiningModule: "iana-if-type"},
+		188: {Name: "opticalTransport", DefiningModule: "iana-if-type"},
+		189: {Name: "other", DefiningModule: "iana-if-type"},
+		190: {Name: "otnOdu", DefiningModule: "iana-if-type"},
+		191: {Name: "otnOtu", DefiningModule: "iana-if-type"},
+		192: {Name: "para", DefiningModule: "iana-if-type"},
+		193: {Name: "pdnEtherLoop1", DefiningModule: "iana-if-type"},
+		194: {Name: "pdnEtherLoop2", DefiningModule: "iana-if-type"},
+		195: {Name: "pip", DefiningModule: "iana-if-type"},
+		196: {Name: "plc", DefiningModule: "iana-if-type"},
+		197: {Name: "pon155", DefiningModule: "iana-if-type"},
+		198: {Name: "pon622", DefiningModule: "iana-if-type"},
+		199: {Name: "pos", DefiningModule: "iana-if-type"},
+		200: {Name: "ppp", DefiningModule: "iana-if-type"},
+		201: {Name: "pppMultilinkBundle", DefiningModule: "iana-if-type"},
+		202: {Name: "primaryISDN", DefiningModule: "iana-if-type"},
+		203: {Name: "propAtm", DefiningModule: "iana-if-type"},
+		204: {Name: "propBWAp2Mp", DefiningModule: "iana-if-type"},
+		205: {Name: "propCnls", DefiningModule: "iana-if-type"},
+		206: {Name: "propDocsWirelessDownstream", DefiningModule: "iana-if-type"},
+		207: {Name: "propDocsWirelessMaclayer", DefiningModule: "iana-if-type"},
+		208: {Name: "propDocsWirelessUpstream", DefiningModule: "iana-if-type"},
+		209: {Name: "propMultiplexor", DefiningModule: "iana-if-type"},
+		210: {Name: "propPointToPointSerial", DefiningModule: "iana-if-type"},
+		211: {Name: "propVirtual", DefiningModule: "iana-if-type"},
+		212: {Name: "propWirelessP2P", DefiningModule: "iana-if-type"},
+		213: {Name: "proteon10Mbit", DefiningModule: "iana-if-type"},
+		214: {Name: "proteon80Mbit", DefiningModule: "iana-if-type"},
+		215: {Name: "ptm", DefiningModule: "iana-if-type"},
+		216: {Name: "q2931", DefiningModule: "iana-if-type"},
+		217: {Name: "qam", DefiningModule: "iana-if-type"},
+		218: {Name: "qllc", DefiningModule: "iana-if-type"},
+		219: {Name: "radioMAC", DefiningModule: "iana-if-type"},
+		220: {Name: "radsl", DefiningModule: "iana-if-type"},
+		221: {Name: "reachDSL", DefiningModule: "iana-if-type"},
+		222: {Name: "regular1822", DefiningModule: "iana-if-type"},
+		223: {Name: "rfc1483", DefiningModule: "iana-if-type"},
+		224: {Name: "rfc877x25", DefiningModule: "iana-if-type"},
+		225: {Name: "rpr", DefiningModule: "iana-if-type"},
+		226: {Name: "rs232", DefiningModule: "iana-if-type"},
+		227: {Name: "rsrb", DefiningModule: "iana-if-type"},
+		228: {Name: "sdci", DefiningModule: "iana-if-type"},
+		229: {Name: "sdlc", DefiningModule: "iana-if-type"},
+		230: {Name: "sdsl", DefiningModule: "iana-if-type"},
+		231: {Name: "shdsl", DefiningModule: "iana-if-type"},
+		232: {Name: "sip", DefiningModule: "iana-if-type"},
+		233: {Name: "sipSig", DefiningModule: "iana-if-type"},
+		234: {Name: "sipTg", DefiningModule: "iana-if-type"},
+		235: {Name: "sixToFour", DefiningModule: "iana-if-type"},
+		236: {Name: "slip", DefiningModule: "iana-if-type"},
+		237: {Name: "smdsDxi", DefiningModule: "iana-if-type"},
+		238: {Name: "smdsIcip", DefiningModule: "iana-if-type"},
+		239: {Name: "softwareLoopback", DefiningModule: "iana-if-type"},
+		240: {Name: "sonet", DefiningModule: "iana-if-type"},
+		241: {Name: "sonetOverheadChannel", DefiningModule: "iana-if-type"},
+		242: {Name: "sonetPath", DefiningModule: "iana-if-type"},
+		243: {Name: "sonetVT", DefiningModule: "iana-if-type"},
+		244: {Name: "srp", DefiningModule: "iana-if-type"},
+		245: {Name: "ss7SigLink", DefiningModule: "iana-if-type"},
+		246: {Name: "stackToStack", DefiningModule: "iana-if-type"},
+		247: {Name: "starLan", DefiningModule: "iana-if-type"},
+		248: {Name: "tdlc", DefiningModule: "iana-if-type"},
+		249: {Name: "teLink", DefiningModule: "iana-if-type"},
+		250: {Name: "termPad", DefiningModule: "iana-if-type"},
+		251: {Name: "tr008", DefiningModule: "iana-if-type"},
+		252: {Name: "transpHdlc", DefiningModule: "iana-if-type"},
+		253: {Name: "tunnel", DefiningModule: "iana-if-type"},
+		254: {Name: "ultra", DefiningModule: "iana-if-type"},
+		255: {Name: "usb", DefiningModule: "iana-if-type"},
+		256: {Name: "v11", DefiningModule: "iana-if-type"},
+		257: {Name: "v35", DefiningModule: "iana-if-type"},
+		258: {Name: "v36", DefiningModule: "iana-if-type"},
+		259: {Name: "v37", DefiningModule: "iana-if-type"},
+		260: {Name: "vdsl", DefiningModule: "iana-if-type"},
+		261: {Name: "vdsl2", DefiningModule: "iana-if-type"},
+		262: {Name: "virtualIpAddress", DefiningModule: "iana-if-type"},
+		263: {Name: "virtualTg", DefiningModule: "iana-if-type"},
+		264: {Name: "vmwareNicTeam", DefiningModule: "iana-if-type"},
+		265: {Name: "vmwareVirtualNic", DefiningModule: "iana-if-type"},
+		266: {Name: "voiceDID", DefiningModule: "iana-if-type"},
+		267: {Name: "voiceEBS", DefiningModule: "iana-if-type"},
+		268: {Name: "voiceEM", DefiningModule: "iana-if-type"},
+		269: {Name: "voiceEMFGD", DefiningModule: "iana-if-type"},
+		270: {Name: "voiceEncap", DefiningModule: "iana-if-type"},
+		271: {Name: "voiceFGDEANA", DefiningModule: "iana-if-type"},
+		272: {Name: "voiceFGDOS", DefiningModule: "iana-if-type"},
+		273: {Name: "voiceFXO", DefiningModule: "iana-if-type"},
+		274: {Name: "voiceFXS", DefiningModule: "iana-if-type"},
+		275: {Name: "voiceOverAtm", DefiningModule: "iana-if-type"},
+		276: {Name: "voiceOverCable", DefiningModule: "iana-if-type"},
+		277: {Name: "voiceOverFrameRelay", DefiningModule: "iana-if-type"},
+		278: {Name: "voiceOverIp", DefiningModule: "iana-if-type"},
+		279: {Name: "wwanPP", DefiningModule: "iana-if-type"},
+		280: {Name: "wwanPP2", DefiningModule: "iana-if-type"},
+		281: {Name: "x213", DefiningModule: "iana-if-type"},
+		282: {Name: "x25huntGroup", DefiningModule: "iana-if-type"},
+		283: {Name: "x25mlp", DefiningModule: "iana-if-type"},
+		284: {Name: "x25ple", DefiningModule: "iana-if-type"},
+		285: {Name: "x86Laps", DefiningModule: "iana-if-type"},
+		286: {Name: "xboxWireless", DefiningModule: "iana-if-type"},
+	},
+	"E_Interface_AdminStatus": {
+		1: {Name: "UP"},
+		2: {Name: "DOWN"},
+		3: {Name: "TESTING"},
+	},
+	"E_Interface_OperStatus": {
+		2: {Name: "UP"},
+		3: {Name: "DOWN"},
+		4: {Name: "TESTING"},
+		5: {Name: "UNKNOWN"},
+		6: {Name: "DORMANT"},
+		7: {Name: "NOT_PRESENT"},
+		8: {Name: "LOWER_LAYER_DOWN"},
+	},
+	"E_Neighbor_NeighborState": {
+		1: {Name: "INCOMPLETE"},
+		2: {Name: "REACHABLE"},
+		3: {Name: "STALE"},
+		4: {Name: "DELAY"},
+		5: {Name: "PROBE"},
+	},
+	"E_OpenconfigIfAggregate_AggregationType": {
+		1: {Name: "LACP"},
+		2: {Name: "STATIC"},
+	},
+	"E_OpenconfigIfEthernet_ETHERNET_SPEED": {
+		1:  {Name: "SPEED_100GB", DefiningModule: "openconfig-if-ethernet"},
+		2:  {Name: "SPEED_100MB", DefiningModule: "openconfig-if-ethernet"},
+		3:  {Name: "SPEED_10GB", DefiningModule: "openconfig-if-ethernet"},
+		4:  {Name: "SPEED_10MB", DefiningModule: "openconfig-if-ethernet"},
+		5:  {Name: "SPEED_1GB", DefiningModule: "openconfig-if-ethernet"},
+		6:  {Name: "SPEED_200GB", DefiningModule: "openconfig-if-ethernet"},
+		7:  {Name: "SPEED_2500MB", DefiningModule: "openconfig-if-ethernet"},
+		8:  {Name: "SPEED_25GB", DefiningModule: "openconfig-if-ethernet"},
+		9:  {Name: "SPEED_400GB", DefiningModule: "openconfig-if-ethernet"},
+		10: {Name: "SPEED_40GB", DefiningModule: "openconfig-if-ethernet"},
+		11: {Name: "SPEED_50GB", DefiningModule: "openconfig-if-ethernet"},
+		12: {Name: "SPEED_5GB", DefiningModule: "openconfig-if-ethernet"},
+		13: {Name: "SPEED_600GB", DefiningModule: "openconfig-if-ethernet"},
+		14: {Name: "SPEED_800GB", DefiningModule: "openconfig-if-ethernet"},
+		15: {Name: "SPEED_UNKNOWN", DefiningModule: "openconfig-if-ethernet"},
+	},
+	"E_OpenconfigIfEthernet_INTERFACE_FEC": {
+		1: {Name: "FEC_DISABLED", DefiningModule: "openconfig-if-ethernet"},
+		2: {Name: "FEC_FC", DefiningModule: "openconfig-if-ethernet"},
+		3: {Name: "FEC_RS528", DefiningModule: "openconfig-if-ethernet"},
+		4: {Name: "FEC_RS544", DefiningModule: "openconfig-if-ethernet"},
+		5: {Name: "FEC_RS544_2X_INTERLEAVE", DefiningModule: "openconfig-if-ethernet"},
+	},
+	"E_OpenconfigIfIp_IpAddressOrigin": {
+		1: {Name: "OTHER"},
+		2: {Name: "STATIC"},
+		3: {Name: "DHCP"},
+		4: {Name: "LINK_LAYER"},
+		5: {Name: "RANDOM"},
+	},
+	"E_OpenconfigIfIp_Ipv4AddressType": {
+		1: {Name: "PRIMARY"},
+		2: {Name: "SECONDARY"},
+	},
+	"E_OpenconfigIfIp_Ipv6AddressType": {
+		1: {Name: "GLOBAL_UNICAST"},
+		2: {Name: "LINK_LOCAL_UNICAST"},
+	},
+	"E_OpenconfigIfIp_NeighborOrigin": {
+		1: {Name: "OTHER"},
+		2: {Name: "STATIC"},
+		3: {Name: "DYNAMIC"},
+	},
+	"E_OpenconfigInterfaces_LoopbackModeType": {
+		1: {Name: "NONE"},
+		2: {Name: "FACILITY"},
+		3: {Name: "TERMINAL"},
+	},
+	"E_OpenconfigVlanTypes_TPID_TYPES": {
+		1: {Name: "TPID_0X8100", DefiningModule: "openconfig-vlan-types"},
+		2: {Name: "TPID_0X88A8", DefiningModule: "openconfig-vlan-types"},
+		3: {Name: "TPID_0X9100", DefiningModule: "openconfig-vlan-types"},
+		4: {Name: "TPID_0X9200", DefiningModule: "openconfig-vlan-types"},
+		5: {Name: "TPID_ANY", DefiningModule: "openconfig-vlan-types"},
+	},
+	"E_OpenconfigVlan_VlanModeType": {
+		1: {Name: "ACCESS"},
+		2: {Name: "TRUNK"},
+	},
+	"E_OpenconfigVlan_VlanStackAction": {
+		1: {Name: "PUSH"},
+		2: {Name: "POP"},
+		3: {Name: "SWAP"},
+	},
+	"E_ProxyArp_Mode": {
+		1: {Name: "DISABLE"},
+		2: {Name: "REMOTE_ONLY"},
+		3: {Name: "ALL"},
+	},
+	"E_RouterAdvertisement_Mode": {
+		1: {Name: "ALL"},
+		2: {Name: "DISABLE_UNSOLICITED_RA"},
+	},
+}
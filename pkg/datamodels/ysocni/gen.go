@@ -0,0 +1,507 @@
+/*
+Package ysocni is a generated package which contains definitions
+of structs which represent a YANG schema. The generated schema can be
+compressed by a series of transformations (compression was true
+in this case).
+
+This package was generated by /Users/luca/go/pkg/mod/github.com/openconfig/ygot@v0.29.20/genutil/names.go
+using the following YANG input files:
+  - openconfig-network-instance.yang
+
+Imported modules were sourced from:
+  - yang/...
+*/
+package ysocni
+
+import (
+	"fmt"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// Binary is a type that is used for fields that have a YANG type of
+// binary. It is used such that binary fields can be distinguished from
+// leaf-lists of uint8s (which are mapped to []uint8, equivalent to
+// []byte in reflection).
+type Binary []byte
+
+// YANGEmpty is a type that is used for fields that have a YANG type of
+// empty. It is used such that empty fields can be distinguished from boolean fields
+// in the generated code.
+type YANGEmpty bool
+
+// NetworkInstance represents the /openconfig-network-instance/network-instances/network-instance YANG schema element.
+type NetworkInstance struct {
+	Description        *string                                                `path:"state/description" module:"openconfig-network-instance/openconfig-network-instance" shadow-path:"config/description" shadow-module:"openconfig-network-instance/openconfig-network-instance"`
+	Enabled            *bool                                                  `path:"state/enabled" module:"openconfig-network-instance/openconfig-network-instance" shadow-path:"config/enabled" shadow-module:"openconfig-network-instance/openconfig-network-instance"`
+	Interface          map[string]*NetworkInstance_Interface                  `path:"interfaces/interface" module:"openconfig-network-instance/openconfig-network-instance"`
+	Name               *string                                                `path:"state/name|name" module:"openconfig-network-instance/openconfig-network-instance|openconfig-network-instance" shadow-path:"config/name|name" shadow-module:"openconfig-network-instance/openconfig-network-instance|openconfig-network-instance"`
+	RouteDistinguisher *string                                                `path:"state/route-distinguisher" module:"openconfig-network-instance/openconfig-network-instance" shadow-path:"config/route-distinguisher" shadow-module:"openconfig-network-instance/openconfig-network-instance"`
+	RouterId           *string                                                `path:"state/router-id" module:"openconfig-network-instance/openconfig-network-instance" shadow-path:"config/router-id" shadow-module:"openconfig-network-instance/openconfig-network-instance"`
+	Type               E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE `path:"state/type" module:"openconfig-network-instance/openconfig-network-instance" shadow-path:"config/type" shadow-module:"openconfig-network-instance/openconfig-network-instance"`
+}
+
+// IsYANGGoStruct ensures that NetworkInstance implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*NetworkInstance) IsYANGGoStruct() {}
+
+// NewInterface creates a new entry in the Interface list of the
+// NetworkInstance struct. The keys of the list are populated from the input
+// arguments.
+func (t *NetworkInstance) NewInterface(Id string) (*NetworkInstance_Interface, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Interface == nil {
+		t.Interface = make(map[string]*NetworkInstance_Interface)
+	}
+
+	key := Id
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Interface[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Interface", key)
+	}
+
+	t.Interface[key] = &NetworkInstance_Interface{
+		Id: &Id,
+	}
+
+	return t.Interface[key], nil
+}
+
+// GetOrCreateInterfaceMap returns the list (map) from NetworkInstance.
+//
+// It initializes the field if not already initialized.
+func (t *NetworkInstance) GetOrCreateInterfaceMap() map[string]*NetworkInstance_Interface {
+	if t.Interface == nil {
+		t.Interface = make(map[string]*NetworkInstance_Interface)
+	}
+	return t.Interface
+}
+
+// GetOrCreateInterface retrieves the value with the specified keys from
+// the receiver NetworkInstance. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *NetworkInstance) GetOrCreateInterface(Id string) *NetworkInstance_Interface {
+
+	key := Id
+
+	if v, ok := t.Interface[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewInterface(Id)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateInterface got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetInterface retrieves the value with the specified key from
+// the Interface map field of NetworkInstance. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *NetworkInstance) GetInterface(Id string) *NetworkInstance_Interface {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Id
+
+	if lm, ok := t.Interface[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteInterface deletes the value with the specified keys from
+// the receiver NetworkInstance. If there is no such element, the function
+// is a no-op.
+func (t *NetworkInstance) DeleteInterface(Id string) {
+	key := Id
+
+	delete(t.Interface, key)
+}
+
+// GetDescription retrieves the value of the leaf Description from the NetworkInstance
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Description is set, it can
+// safely use t.GetDescription() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Description == nil' before retrieving the leaf's value.
+func (t *NetworkInstance) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetEnabled retrieves the value of the leaf Enabled from the NetworkInstance
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Enabled is set, it can
+// safely use t.GetEnabled() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Enabled == nil' before retrieving the leaf's value.
+func (t *NetworkInstance) GetEnabled() bool {
+	if t == nil || t.Enabled == nil {
+		return false
+	}
+	return *t.Enabled
+}
+
+// GetName retrieves the value of the leaf Name from the NetworkInstance
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Name is set, it can
+// safely use t.GetName() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Name == nil' before retrieving the leaf's value.
+func (t *NetworkInstance) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+	return *t.Name
+}
+
+// GetRouteDistinguisher retrieves the value of the leaf RouteDistinguisher from the NetworkInstance
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if RouteDistinguisher is set, it can
+// safely use t.GetRouteDistinguisher() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.RouteDistinguisher == nil' before retrieving the leaf's value.
+func (t *NetworkInstance) GetRouteDistinguisher() string {
+	if t == nil || t.RouteDistinguisher == nil {
+		return ""
+	}
+	return *t.RouteDistinguisher
+}
+
+// GetRouterId retrieves the value of the leaf RouterId from the NetworkInstance
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if RouterId is set, it can
+// safely use t.GetRouterId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.RouterId == nil' before retrieving the leaf's value.
+func (t *NetworkInstance) GetRouterId() string {
+	if t == nil || t.RouterId == nil {
+		return ""
+	}
+	return *t.RouterId
+}
+
+// GetType retrieves the value of the leaf Type from the NetworkInstance
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Type is set, it can
+// safely use t.GetType() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Type == nil' before retrieving the leaf's value.
+func (t *NetworkInstance) GetType() E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE {
+	if t == nil || t.Type == 0 {
+		return 0
+	}
+	return t.Type
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the NetworkInstance
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *NetworkInstance) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	for _, e := range t.Interface {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛListKeyMap returns the keys of the NetworkInstance struct, which is a YANG list entry.
+func (t *NetworkInstance) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of NetworkInstance.
+func (*NetworkInstance) ΛBelongingModule() string {
+	return "openconfig-network-instance"
+}
+
+// NetworkInstance_Interface represents the /openconfig-network-instance/network-instances/network-instance/interfaces/interface YANG schema element.
+type NetworkInstance_Interface struct {
+	Id           *string `path:"state/id|id" module:"openconfig-network-instance/openconfig-network-instance|openconfig-network-instance" shadow-path:"config/id|id" shadow-module:"openconfig-network-instance/openconfig-network-instance|openconfig-network-instance"`
+	Interface    *string `path:"state/interface" module:"openconfig-network-instance/openconfig-network-instance" shadow-path:"config/interface" shadow-module:"openconfig-network-instance/openconfig-network-instance"`
+	Subinterface *uint32 `path:"state/subinterface" module:"openconfig-network-instance/openconfig-network-instance" shadow-path:"config/subinterface" shadow-module:"openconfig-network-instance/openconfig-network-instance"`
+}
+
+// IsYANGGoStruct ensures that NetworkInstance_Interface implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*NetworkInstance_Interface) IsYANGGoStruct() {}
+
+// GetId retrieves the value of the leaf Id from the NetworkInstance_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Id is set, it can
+// safely use t.GetId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Id == nil' before retrieving the leaf's value.
+func (t *NetworkInstance_Interface) GetId() string {
+	if t == nil || t.Id == nil {
+		return ""
+	}
+	return *t.Id
+}
+
+// GetInterface retrieves the value of the leaf Interface from the NetworkInstance_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Interface is set, it can
+// safely use t.GetInterface() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Interface == nil' before retrieving the leaf's value.
+func (t *NetworkInstance_Interface) GetInterface() string {
+	if t == nil || t.Interface == nil {
+		return ""
+	}
+	return *t.Interface
+}
+
+// GetSubinterface retrieves the value of the leaf Subinterface from the NetworkInstance_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Subinterface is set, it can
+// safely use t.GetSubinterface() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Subinterface == nil' before retrieving the leaf's value.
+func (t *NetworkInstance_Interface) GetSubinterface() uint32 {
+	if t == nil || t.Subinterface == nil {
+		return 0
+	}
+	return *t.Subinterface
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the NetworkInstance_Interface
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *NetworkInstance_Interface) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛListKeyMap returns the keys of the NetworkInstance_Interface struct, which is a YANG list entry.
+func (t *NetworkInstance_Interface) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Id == nil {
+		return nil, fmt.Errorf("nil value for key Id")
+	}
+
+	return map[string]interface{}{
+		"id": *t.Id,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of NetworkInstance_Interface.
+func (*NetworkInstance_Interface) ΛBelongingModule() string {
+	return "openconfig-network-instance"
+}
+
+// Root represents the /root YANG schema element.
+type Root struct {
+	NetworkInstance map[string]*NetworkInstance `path:"network-instances/network-instance" module:"openconfig-network-instance/openconfig-network-instance"`
+}
+
+// IsYANGGoStruct ensures that Root implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Root) IsYANGGoStruct() {}
+
+// NewNetworkInstance creates a new entry in the NetworkInstance list of the
+// Root struct. The keys of the list are populated from the input
+// arguments.
+func (t *Root) NewNetworkInstance(Name string) (*NetworkInstance, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.NetworkInstance == nil {
+		t.NetworkInstance = make(map[string]*NetworkInstance)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.NetworkInstance[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list NetworkInstance", key)
+	}
+
+	t.NetworkInstance[key] = &NetworkInstance{
+		Name: &Name,
+	}
+
+	return t.NetworkInstance[key], nil
+}
+
+// GetOrCreateNetworkInstanceMap returns the list (map) from Root.
+//
+// It initializes the field if not already initialized.
+func (t *Root) GetOrCreateNetworkInstanceMap() map[string]*NetworkInstance {
+	if t.NetworkInstance == nil {
+		t.NetworkInstance = make(map[string]*NetworkInstance)
+	}
+	return t.NetworkInstance
+}
+
+// GetOrCreateNetworkInstance retrieves the value with the specified keys from
+// the receiver Root. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Root) GetOrCreateNetworkInstance(Name string) *NetworkInstance {
+
+	key := Name
+
+	if v, ok := t.NetworkInstance[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewNetworkInstance(Name)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateNetworkInstance got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetNetworkInstance retrieves the value with the specified key from
+// the NetworkInstance map field of Root. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Root) GetNetworkInstance(Name string) *NetworkInstance {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Name
+
+	if lm, ok := t.NetworkInstance[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteNetworkInstance deletes the value with the specified keys from
+// the receiver Root. If there is no such element, the function
+// is a no-op.
+func (t *Root) DeleteNetworkInstance(Name string) {
+	key := Name
+
+	delete(t.NetworkInstance, key)
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Root
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Root) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	for _, e := range t.NetworkInstance {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Root.
+func (*Root) ΛBelongingModule() string {
+	return ""
+}
+
+// E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE is a derived int64 type which is used to represent
+// the enumerated node OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE. An additional value named
+// OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE int64
+
+// IsYANGGoEnum ensures that OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE implements the yang.GoEnum
+// interface. This ensures that OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE can be identified as a
+// mapped type for a YANG enumeration.
+func (E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE.
+func (E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE.
+func (e E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE")
+}
+
+const (
+	// OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE_UNSET corresponds to the value UNSET of OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE
+	OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE_UNSET E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE = 0
+	// OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE_DEFAULT_INSTANCE corresponds to the value DEFAULT_INSTANCE of OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE
+	OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE_DEFAULT_INSTANCE E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE = 1
+	// OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE_L2L3 corresponds to the value L2L3 of OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE
+	OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE_L2L3 E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE = 2
+	// OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE_L2P2P corresponds to the value L2P2P of OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE
+	OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE_L2P2P E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE = 3
+	// OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE_L2VSI corresponds to the value L2VSI of OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE
+	OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE_L2VSI E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE = 4
+	// OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE_L3VRF corresponds to the value L3VRF of OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE
+	OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE_L3VRF E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE = 5
+)
+
+// ΛEnum is a map, keyed by the name of the type defined for each enum in the
+// generated Go code, which provides a mapping between the constant int64 value
+// of each value of the enumeration, and the string that is used to represent it
+// in the YANG schema. The map is named ΛEnum in order to avoid clash with any
+// valid YANG identifier.
+var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
+	"E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE": {
+		1: {Name: "DEFAULT_INSTANCE", DefiningModule: "openconfig-network-instance-types"},
+		2: {Name: "L2L3", DefiningModule: "openconfig-network-instance-types"},
+		3: {Name: "L2P2P", DefiningModule: "openconfig-network-instance-types"},
+		4: {Name: "L2VSI", DefiningModule: "openconfig-network-instance-types"},
+		5: {Name: "L3VRF", DefiningModule: "openconfig-network-instance-types"},
+	},
+}
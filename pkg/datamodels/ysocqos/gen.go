@@ -0,0 +1,532 @@
+/*
+Package ysocqos is a generated package which contains definitions
+of structs which represent a YANG schema. The generated schema can be
+compressed by a series of transformations (compression was true
+in this case).
+
+This package was generated by /Users/luca/go/pkg/mod/github.com/openconfig/ygot@v0.29.20/genutil/names.go
+using the following YANG input files:
+  - openconfig-qos.yang
+
+Imported modules were sourced from:
+  - yang/...
+*/
+package ysocqos
+
+import (
+	"fmt"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// Binary is a type that is used for fields that have a YANG type of
+// binary. It is used such that binary fields can be distinguished from
+// leaf-lists of uint8s (which are mapped to []uint8, equivalent to
+// []byte in reflection).
+type Binary []byte
+
+// YANGEmpty is a type that is used for fields that have a YANG type of
+// empty. It is used such that empty fields can be distinguished from boolean fields
+// in the generated code.
+type YANGEmpty bool
+
+// Qos represents the /openconfig-qos/qos YANG schema element.
+type Qos struct {
+	Interface map[string]*Qos_Interface `path:"interfaces/interface" module:"openconfig-qos/openconfig-qos"`
+}
+
+// IsYANGGoStruct ensures that Qos implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Qos) IsYANGGoStruct() {}
+
+// NewInterface creates a new entry in the Interface list of the
+// Qos struct. The keys of the list are populated from the input
+// arguments.
+func (t *Qos) NewInterface(InterfaceId string) (*Qos_Interface, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Interface == nil {
+		t.Interface = make(map[string]*Qos_Interface)
+	}
+
+	key := InterfaceId
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Interface[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Interface", key)
+	}
+
+	t.Interface[key] = &Qos_Interface{
+		InterfaceId: &InterfaceId,
+	}
+
+	return t.Interface[key], nil
+}
+
+// GetOrCreateInterfaceMap returns the list (map) from Qos.
+//
+// It initializes the field if not already initialized.
+func (t *Qos) GetOrCreateInterfaceMap() map[string]*Qos_Interface {
+	if t.Interface == nil {
+		t.Interface = make(map[string]*Qos_Interface)
+	}
+	return t.Interface
+}
+
+// GetOrCreateInterface retrieves the value with the specified keys from
+// the receiver Qos. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Qos) GetOrCreateInterface(InterfaceId string) *Qos_Interface {
+
+	key := InterfaceId
+
+	if v, ok := t.Interface[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewInterface(InterfaceId)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateInterface got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetInterface retrieves the value with the specified key from
+// the Interface map field of Qos. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Qos) GetInterface(InterfaceId string) *Qos_Interface {
+
+	if t == nil {
+		return nil
+	}
+
+	key := InterfaceId
+
+	if lm, ok := t.Interface[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteInterface deletes the value with the specified keys from
+// the receiver Qos. If there is no such element, the function
+// is a no-op.
+func (t *Qos) DeleteInterface(InterfaceId string) {
+	key := InterfaceId
+
+	delete(t.Interface, key)
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Qos
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Qos) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	for _, e := range t.Interface {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Qos.
+func (*Qos) ΛBelongingModule() string {
+	return "openconfig-qos"
+}
+
+// Qos_Interface represents the /openconfig-qos/qos/interfaces/interface YANG schema element.
+type Qos_Interface struct {
+	InterfaceId *string               `path:"state/interface-id|interface-id" module:"openconfig-qos/openconfig-qos|openconfig-qos" shadow-path:"config/interface-id|interface-id" shadow-module:"openconfig-qos/openconfig-qos|openconfig-qos"`
+	Output      *Qos_Interface_Output `path:"output" module:"openconfig-qos"`
+}
+
+// IsYANGGoStruct ensures that Qos_Interface implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Qos_Interface) IsYANGGoStruct() {}
+
+// GetOrCreateOutput retrieves the value of the Output field
+// or returns the existing field if it already exists.
+func (t *Qos_Interface) GetOrCreateOutput() *Qos_Interface_Output {
+	if t.Output != nil {
+		return t.Output
+	}
+	t.Output = &Qos_Interface_Output{}
+	return t.Output
+}
+
+// GetOutput returns the value of the Output struct pointer
+// from Qos_Interface. If the receiver or the field Output is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Qos_Interface) GetOutput() *Qos_Interface_Output {
+	if t != nil && t.Output != nil {
+		return t.Output
+	}
+	return nil
+}
+
+// GetInterfaceId retrieves the value of the leaf InterfaceId from the Qos_Interface
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if InterfaceId is set, it can
+// safely use t.GetInterfaceId() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.InterfaceId == nil' before retrieving the leaf's value.
+func (t *Qos_Interface) GetInterfaceId() string {
+	if t == nil || t.InterfaceId == nil {
+		return ""
+	}
+	return *t.InterfaceId
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Qos_Interface
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Qos_Interface) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	t.Output.PopulateDefaults()
+}
+
+// ΛListKeyMap returns the keys of the Qos_Interface struct, which is a YANG list entry.
+func (t *Qos_Interface) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.InterfaceId == nil {
+		return nil, fmt.Errorf("nil value for key InterfaceId")
+	}
+
+	return map[string]interface{}{
+		"interface-id": *t.InterfaceId,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Qos_Interface.
+func (*Qos_Interface) ΛBelongingModule() string {
+	return "openconfig-qos"
+}
+
+// Qos_Interface_Output represents the /openconfig-qos/qos/interfaces/interface/output YANG schema element.
+type Qos_Interface_Output struct {
+	Queue map[string]*Qos_Interface_Output_Queue `path:"queues/queue" module:"openconfig-qos/openconfig-qos"`
+}
+
+// IsYANGGoStruct ensures that Qos_Interface_Output implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Qos_Interface_Output) IsYANGGoStruct() {}
+
+// NewQueue creates a new entry in the Queue list of the
+// Qos_Interface_Output struct. The keys of the list are populated from the input
+// arguments.
+func (t *Qos_Interface_Output) NewQueue(Name string) (*Qos_Interface_Output_Queue, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Queue == nil {
+		t.Queue = make(map[string]*Qos_Interface_Output_Queue)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Queue[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Queue", key)
+	}
+
+	t.Queue[key] = &Qos_Interface_Output_Queue{
+		Name: &Name,
+	}
+
+	return t.Queue[key], nil
+}
+
+// GetOrCreateQueueMap returns the list (map) from Qos_Interface_Output.
+//
+// It initializes the field if not already initialized.
+func (t *Qos_Interface_Output) GetOrCreateQueueMap() map[string]*Qos_Interface_Output_Queue {
+	if t.Queue == nil {
+		t.Queue = make(map[string]*Qos_Interface_Output_Queue)
+	}
+	return t.Queue
+}
+
+// GetOrCreateQueue retrieves the value with the specified keys from
+// the receiver Qos_Interface_Output. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Qos_Interface_Output) GetOrCreateQueue(Name string) *Qos_Interface_Output_Queue {
+
+	key := Name
+
+	if v, ok := t.Queue[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewQueue(Name)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateQueue got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetQueue retrieves the value with the specified key from
+// the Queue map field of Qos_Interface_Output. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Qos_Interface_Output) GetQueue(Name string) *Qos_Interface_Output_Queue {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Name
+
+	if lm, ok := t.Queue[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteQueue deletes the value with the specified keys from
+// the receiver Qos_Interface_Output. If there is no such element, the function
+// is a no-op.
+func (t *Qos_Interface_Output) DeleteQueue(Name string) {
+	key := Name
+
+	delete(t.Queue, key)
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Qos_Interface_Output
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Qos_Interface_Output) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	for _, e := range t.Queue {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Qos_Interface_Output.
+func (*Qos_Interface_Output) ΛBelongingModule() string {
+	return "openconfig-qos"
+}
+
+// Qos_Interface_Output_Queue represents the /openconfig-qos/qos/interfaces/interface/output/queues/queue YANG schema element.
+type Qos_Interface_Output_Queue struct {
+	AvgQueueLen    *uint64 `path:"state/avg-queue-len" module:"openconfig-qos/openconfig-qos"`
+	DroppedOctets  *uint64 `path:"state/dropped-octets" module:"openconfig-qos/openconfig-qos"`
+	DroppedPkts    *uint64 `path:"state/dropped-pkts" module:"openconfig-qos/openconfig-qos"`
+	MaxQueueLen    *uint64 `path:"state/max-queue-len" module:"openconfig-qos/openconfig-qos"`
+	Name           *string `path:"state/name|name" module:"openconfig-qos/openconfig-qos|openconfig-qos" shadow-path:"config/name|name" shadow-module:"openconfig-qos/openconfig-qos|openconfig-qos"`
+	TransmitOctets *uint64 `path:"state/transmit-octets" module:"openconfig-qos/openconfig-qos"`
+	TransmitPkts   *uint64 `path:"state/transmit-pkts" module:"openconfig-qos/openconfig-qos"`
+}
+
+// IsYANGGoStruct ensures that Qos_Interface_Output_Queue implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Qos_Interface_Output_Queue) IsYANGGoStruct() {}
+
+// GetAvgQueueLen retrieves the value of the leaf AvgQueueLen from the Qos_Interface_Output_Queue
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if AvgQueueLen is set, it can
+// safely use t.GetAvgQueueLen() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.AvgQueueLen == nil' before retrieving the leaf's value.
+func (t *Qos_Interface_Output_Queue) GetAvgQueueLen() uint64 {
+	if t == nil || t.AvgQueueLen == nil {
+		return 0
+	}
+	return *t.AvgQueueLen
+}
+
+// GetDroppedOctets retrieves the value of the leaf DroppedOctets from the Qos_Interface_Output_Queue
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DroppedOctets is set, it can
+// safely use t.GetDroppedOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DroppedOctets == nil' before retrieving the leaf's value.
+func (t *Qos_Interface_Output_Queue) GetDroppedOctets() uint64 {
+	if t == nil || t.DroppedOctets == nil {
+		return 0
+	}
+	return *t.DroppedOctets
+}
+
+// GetDroppedPkts retrieves the value of the leaf DroppedPkts from the Qos_Interface_Output_Queue
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if DroppedPkts is set, it can
+// safely use t.GetDroppedPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.DroppedPkts == nil' before retrieving the leaf's value.
+func (t *Qos_Interface_Output_Queue) GetDroppedPkts() uint64 {
+	if t == nil || t.DroppedPkts == nil {
+		return 0
+	}
+	return *t.DroppedPkts
+}
+
+// GetMaxQueueLen retrieves the value of the leaf MaxQueueLen from the Qos_Interface_Output_Queue
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if MaxQueueLen is set, it can
+// safely use t.GetMaxQueueLen() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.MaxQueueLen == nil' before retrieving the leaf's value.
+func (t *Qos_Interface_Output_Queue) GetMaxQueueLen() uint64 {
+	if t == nil || t.MaxQueueLen == nil {
+		return 0
+	}
+	return *t.MaxQueueLen
+}
+
+// GetName retrieves the value of the leaf Name from the Qos_Interface_Output_Queue
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Name is set, it can
+// safely use t.GetName() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Name == nil' before retrieving the leaf's value.
+func (t *Qos_Interface_Output_Queue) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+	return *t.Name
+}
+
+// GetTransmitOctets retrieves the value of the leaf TransmitOctets from the Qos_Interface_Output_Queue
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if TransmitOctets is set, it can
+// safely use t.GetTransmitOctets() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.TransmitOctets == nil' before retrieving the leaf's value.
+func (t *Qos_Interface_Output_Queue) GetTransmitOctets() uint64 {
+	if t == nil || t.TransmitOctets == nil {
+		return 0
+	}
+	return *t.TransmitOctets
+}
+
+// GetTransmitPkts retrieves the value of the leaf TransmitPkts from the Qos_Interface_Output_Queue
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if TransmitPkts is set, it can
+// safely use t.GetTransmitPkts() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.TransmitPkts == nil' before retrieving the leaf's value.
+func (t *Qos_Interface_Output_Queue) GetTransmitPkts() uint64 {
+	if t == nil || t.TransmitPkts == nil {
+		return 0
+	}
+	return *t.TransmitPkts
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Qos_Interface_Output_Queue
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Qos_Interface_Output_Queue) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛListKeyMap returns the keys of the Qos_Interface_Output_Queue struct, which is a YANG list entry.
+func (t *Qos_Interface_Output_Queue) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Qos_Interface_Output_Queue.
+func (*Qos_Interface_Output_Queue) ΛBelongingModule() string {
+	return "openconfig-qos"
+}
+
+// Root represents the /root YANG schema element.
+type Root struct {
+	Qos *Qos `path:"qos" module:"openconfig-qos"`
+}
+
+// IsYANGGoStruct ensures that Root implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Root) IsYANGGoStruct() {}
+
+// GetOrCreateQos retrieves the value of the Qos field
+// or returns the existing field if it already exists.
+func (t *Root) GetOrCreateQos() *Qos {
+	if t.Qos != nil {
+		return t.Qos
+	}
+	t.Qos = &Qos{}
+	return t.Qos
+}
+
+// GetQos returns the value of the Qos struct pointer
+// from Root. If the receiver or the field Qos is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Root) GetQos() *Qos {
+	if t != nil && t.Qos != nil {
+		return t.Qos
+	}
+	return nil
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Root
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Root) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	t.Qos.PopulateDefaults()
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Root.
+func (*Root) ΛBelongingModule() string {
+	return ""
+}
@@ -0,0 +1,16 @@
+package ysocqos
+
+import (
+	"github.com/openconfig/ygot/ygot"
+)
+
+// Generate OpenConfig qos GoStruct code
+//go:generate generator -output_file=gen.go -compress_paths=true -path=yang -package_name=ysocqos -fakeroot_name=root -prefer_operational_state=true -ignore_shadow_schema_paths=true -shorten_enum_leaf_names=true -generate_fakeroot=true -include_schema=false -generate_getters=true -generate_leaf_getters=true -generate_delete=true -generate_populate_defaults=true openconfig-qos.yang
+
+// GoStructToOcQos converts a GoStruct interface to a pointer of a Root struct.
+func GoStructToOcQos(ys ygot.GoStruct) *Root {
+	if root, ok := ys.(*Root); ok {
+		return root
+	}
+	panic("not an ygot qos GoStruct")
+}
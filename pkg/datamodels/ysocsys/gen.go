@@ -0,0 +1,634 @@
+/*
+Package ysocsys is a generated package which contains definitions
+of structs which represent a YANG schema. The generated schema can be
+compressed by a series of transformations (compression was true
+in this case).
+
+This package was generated by /Users/luca/go/pkg/mod/github.com/openconfig/ygot@v0.29.20/genutil/names.go
+using the following YANG input files:
+  - openconfig-system.yang
+
+Imported modules were sourced from:
+  - yang/...
+*/
+package ysocsys
+
+import (
+	"fmt"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// Binary is a type that is used for fields that have a YANG type of
+// binary. It is used such that binary fields can be distinguished from
+// leaf-lists of uint8s (which are mapped to []uint8, equivalent to
+// []byte in reflection).
+type Binary []byte
+
+// YANGEmpty is a type that is used for fields that have a YANG type of
+// empty. It is used such that empty fields can be distinguished from boolean fields
+// in the generated code.
+type YANGEmpty bool
+
+// Root represents the /root YANG schema element.
+type Root struct {
+	System *System `path:"system" module:"openconfig-system"`
+}
+
+// IsYANGGoStruct ensures that Root implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Root) IsYANGGoStruct() {}
+
+// GetOrCreateSystem retrieves the value of the System field
+// or returns the existing field if it already exists.
+func (t *Root) GetOrCreateSystem() *System {
+	if t.System != nil {
+		return t.System
+	}
+	t.System = &System{}
+	return t.System
+}
+
+// GetSystem returns the value of the System struct pointer
+// from Root. If the receiver or the field System is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Root) GetSystem() *System {
+	if t != nil && t.System != nil {
+		return t.System
+	}
+	return nil
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Root
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Root) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	t.System.PopulateDefaults()
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Root.
+func (*Root) ΛBelongingModule() string {
+	return ""
+}
+
+// System represents the /openconfig-system/system YANG schema element.
+type System struct {
+	Hostname        *string                    `path:"state/hostname" module:"openconfig-system/openconfig-system"`
+	Ntp             *System_Ntp                `path:"ntp" module:"openconfig-system"`
+	Process         map[uint64]*System_Process `path:"processes/process" module:"openconfig-system/openconfig-system"`
+	SoftwareVersion *string                    `path:"state/software-version" module:"openconfig-system/openconfig-system"`
+}
+
+// IsYANGGoStruct ensures that System implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*System) IsYANGGoStruct() {}
+
+// NewProcess creates a new entry in the Process list of the
+// System struct. The keys of the list are populated from the input
+// arguments.
+func (t *System) NewProcess(Pid uint64) (*System_Process, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Process == nil {
+		t.Process = make(map[uint64]*System_Process)
+	}
+
+	key := Pid
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Process[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Process", key)
+	}
+
+	t.Process[key] = &System_Process{
+		Pid: &Pid,
+	}
+
+	return t.Process[key], nil
+}
+
+// GetOrCreateProcessMap returns the list (map) from System.
+//
+// It initializes the field if not already initialized.
+func (t *System) GetOrCreateProcessMap() map[uint64]*System_Process {
+	if t.Process == nil {
+		t.Process = make(map[uint64]*System_Process)
+	}
+	return t.Process
+}
+
+// GetOrCreateProcess retrieves the value with the specified keys from
+// the receiver System. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *System) GetOrCreateProcess(Pid uint64) *System_Process {
+
+	key := Pid
+
+	if v, ok := t.Process[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewProcess(Pid)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateProcess got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetProcess retrieves the value with the specified key from
+// the Process map field of System. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *System) GetProcess(Pid uint64) *System_Process {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Pid
+
+	if lm, ok := t.Process[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteProcess deletes the value with the specified keys from
+// the receiver System. If there is no such element, the function
+// is a no-op.
+func (t *System) DeleteProcess(Pid uint64) {
+	key := Pid
+
+	delete(t.Process, key)
+}
+
+// GetOrCreateNtp retrieves the value of the Ntp field
+// or returns the existing field if it already exists.
+func (t *System) GetOrCreateNtp() *System_Ntp {
+	if t.Ntp != nil {
+		return t.Ntp
+	}
+	t.Ntp = &System_Ntp{}
+	return t.Ntp
+}
+
+// GetNtp returns the value of the Ntp struct pointer
+// from System. If the receiver or the field Ntp is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *System) GetNtp() *System_Ntp {
+	if t != nil && t.Ntp != nil {
+		return t.Ntp
+	}
+	return nil
+}
+
+// GetHostname retrieves the value of the leaf Hostname from the System
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Hostname is set, it can
+// safely use t.GetHostname() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Hostname == nil' before retrieving the leaf's value.
+func (t *System) GetHostname() string {
+	if t == nil || t.Hostname == nil {
+		return ""
+	}
+	return *t.Hostname
+}
+
+// GetSoftwareVersion retrieves the value of the leaf SoftwareVersion from the System
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if SoftwareVersion is set, it can
+// safely use t.GetSoftwareVersion() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.SoftwareVersion == nil' before retrieving the leaf's value.
+func (t *System) GetSoftwareVersion() string {
+	if t == nil || t.SoftwareVersion == nil {
+		return ""
+	}
+	return *t.SoftwareVersion
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the System
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *System) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	t.Ntp.PopulateDefaults()
+	for _, e := range t.Process {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of System.
+func (*System) ΛBelongingModule() string {
+	return "openconfig-system"
+}
+
+// System_Ntp represents the /openconfig-system/system/ntp YANG schema element.
+type System_Ntp struct {
+	Server map[string]*System_Ntp_Server `path:"servers/server" module:"openconfig-system/openconfig-system"`
+}
+
+// IsYANGGoStruct ensures that System_Ntp implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*System_Ntp) IsYANGGoStruct() {}
+
+// NewServer creates a new entry in the Server list of the
+// System_Ntp struct. The keys of the list are populated from the input
+// arguments.
+func (t *System_Ntp) NewServer(Address string) (*System_Ntp_Server, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Server == nil {
+		t.Server = make(map[string]*System_Ntp_Server)
+	}
+
+	key := Address
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Server[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Server", key)
+	}
+
+	t.Server[key] = &System_Ntp_Server{
+		Address: &Address,
+	}
+
+	return t.Server[key], nil
+}
+
+// GetOrCreateServerMap returns the list (map) from System_Ntp.
+//
+// It initializes the field if not already initialized.
+func (t *System_Ntp) GetOrCreateServerMap() map[string]*System_Ntp_Server {
+	if t.Server == nil {
+		t.Server = make(map[string]*System_Ntp_Server)
+	}
+	return t.Server
+}
+
+// GetOrCreateServer retrieves the value with the specified keys from
+// the receiver System_Ntp. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *System_Ntp) GetOrCreateServer(Address string) *System_Ntp_Server {
+
+	key := Address
+
+	if v, ok := t.Server[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewServer(Address)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateServer got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetServer retrieves the value with the specified key from
+// the Server map field of System_Ntp. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *System_Ntp) GetServer(Address string) *System_Ntp_Server {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Address
+
+	if lm, ok := t.Server[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteServer deletes the value with the specified keys from
+// the receiver System_Ntp. If there is no such element, the function
+// is a no-op.
+func (t *System_Ntp) DeleteServer(Address string) {
+	key := Address
+
+	delete(t.Server, key)
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the System_Ntp
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *System_Ntp) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	for _, e := range t.Server {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of System_Ntp.
+func (*System_Ntp) ΛBelongingModule() string {
+	return "openconfig-system"
+}
+
+// System_Ntp_Server represents the /openconfig-system/system/ntp/servers/server YANG schema element.
+type System_Ntp_Server struct {
+	Address        *string `path:"state/address|address" module:"openconfig-system/openconfig-system|openconfig-system" shadow-path:"address" shadow-module:"openconfig-system"`
+	Offset         *uint64 `path:"state/offset" module:"openconfig-system/openconfig-system"`
+	PollInterval   *uint32 `path:"state/poll-interval" module:"openconfig-system/openconfig-system"`
+	Port           *uint16 `path:"state/port" module:"openconfig-system/openconfig-system"`
+	RootDelay      *uint32 `path:"state/root-delay" module:"openconfig-system/openconfig-system"`
+	RootDispersion *uint64 `path:"state/root-dispersion" module:"openconfig-system/openconfig-system"`
+	Stratum        *uint8  `path:"state/stratum" module:"openconfig-system/openconfig-system"`
+}
+
+// IsYANGGoStruct ensures that System_Ntp_Server implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*System_Ntp_Server) IsYANGGoStruct() {}
+
+// GetAddress retrieves the value of the leaf Address from the System_Ntp_Server
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Address is set, it can
+// safely use t.GetAddress() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Address == nil' before retrieving the leaf's value.
+func (t *System_Ntp_Server) GetAddress() string {
+	if t == nil || t.Address == nil {
+		return ""
+	}
+	return *t.Address
+}
+
+// GetOffset retrieves the value of the leaf Offset from the System_Ntp_Server
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Offset is set, it can
+// safely use t.GetOffset() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Offset == nil' before retrieving the leaf's value.
+func (t *System_Ntp_Server) GetOffset() uint64 {
+	if t == nil || t.Offset == nil {
+		return 0
+	}
+	return *t.Offset
+}
+
+// GetPollInterval retrieves the value of the leaf PollInterval from the System_Ntp_Server
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if PollInterval is set, it can
+// safely use t.GetPollInterval() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.PollInterval == nil' before retrieving the leaf's value.
+func (t *System_Ntp_Server) GetPollInterval() uint32 {
+	if t == nil || t.PollInterval == nil {
+		return 0
+	}
+	return *t.PollInterval
+}
+
+// GetPort retrieves the value of the leaf Port from the System_Ntp_Server
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Port is set, it can
+// safely use t.GetPort() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Port == nil' before retrieving the leaf's value.
+func (t *System_Ntp_Server) GetPort() uint16 {
+	if t == nil || t.Port == nil {
+		return 0
+	}
+	return *t.Port
+}
+
+// GetRootDelay retrieves the value of the leaf RootDelay from the System_Ntp_Server
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if RootDelay is set, it can
+// safely use t.GetRootDelay() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.RootDelay == nil' before retrieving the leaf's value.
+func (t *System_Ntp_Server) GetRootDelay() uint32 {
+	if t == nil || t.RootDelay == nil {
+		return 0
+	}
+	return *t.RootDelay
+}
+
+// GetRootDispersion retrieves the value of the leaf RootDispersion from the System_Ntp_Server
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if RootDispersion is set, it can
+// safely use t.GetRootDispersion() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.RootDispersion == nil' before retrieving the leaf's value.
+func (t *System_Ntp_Server) GetRootDispersion() uint64 {
+	if t == nil || t.RootDispersion == nil {
+		return 0
+	}
+	return *t.RootDispersion
+}
+
+// GetStratum retrieves the value of the leaf Stratum from the System_Ntp_Server
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Stratum is set, it can
+// safely use t.GetStratum() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Stratum == nil' before retrieving the leaf's value.
+func (t *System_Ntp_Server) GetStratum() uint8 {
+	if t == nil || t.Stratum == nil {
+		return 0
+	}
+	return *t.Stratum
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the System_Ntp_Server
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *System_Ntp_Server) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛListKeyMap returns the keys of the System_Ntp_Server struct, which is a YANG list entry.
+func (t *System_Ntp_Server) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Address == nil {
+		return nil, fmt.Errorf("nil value for key Address")
+	}
+
+	return map[string]interface{}{
+		"address": *t.Address,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of System_Ntp_Server.
+func (*System_Ntp_Server) ΛBelongingModule() string {
+	return "openconfig-system"
+}
+
+// System_Process represents the /openconfig-system/system/processes/process YANG schema element.
+type System_Process struct {
+	CpuUtilization    *uint8  `path:"state/cpu-utilization" module:"openconfig-system/openconfig-system"`
+	MemoryUsage       *uint64 `path:"state/memory-usage" module:"openconfig-system/openconfig-system"`
+	MemoryUtilization *uint8  `path:"state/memory-utilization" module:"openconfig-system/openconfig-system"`
+	Name              *string `path:"state/name" module:"openconfig-system/openconfig-system"`
+	Pid               *uint64 `path:"state/pid|pid" module:"openconfig-system/openconfig-system|openconfig-system" shadow-path:"pid" shadow-module:"openconfig-system"`
+}
+
+// IsYANGGoStruct ensures that System_Process implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*System_Process) IsYANGGoStruct() {}
+
+// GetCpuUtilization retrieves the value of the leaf CpuUtilization from the System_Process
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if CpuUtilization is set, it can
+// safely use t.GetCpuUtilization() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.CpuUtilization == nil' before retrieving the leaf's value.
+func (t *System_Process) GetCpuUtilization() uint8 {
+	if t == nil || t.CpuUtilization == nil {
+		return 0
+	}
+	return *t.CpuUtilization
+}
+
+// GetMemoryUsage retrieves the value of the leaf MemoryUsage from the System_Process
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if MemoryUsage is set, it can
+// safely use t.GetMemoryUsage() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.MemoryUsage == nil' before retrieving the leaf's value.
+func (t *System_Process) GetMemoryUsage() uint64 {
+	if t == nil || t.MemoryUsage == nil {
+		return 0
+	}
+	return *t.MemoryUsage
+}
+
+// GetMemoryUtilization retrieves the value of the leaf MemoryUtilization from the System_Process
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if MemoryUtilization is set, it can
+// safely use t.GetMemoryUtilization() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.MemoryUtilization == nil' before retrieving the leaf's value.
+func (t *System_Process) GetMemoryUtilization() uint8 {
+	if t == nil || t.MemoryUtilization == nil {
+		return 0
+	}
+	return *t.MemoryUtilization
+}
+
+// GetName retrieves the value of the leaf Name from the System_Process
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Name is set, it can
+// safely use t.GetName() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Name == nil' before retrieving the leaf's value.
+func (t *System_Process) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+	return *t.Name
+}
+
+// GetPid retrieves the value of the leaf Pid from the System_Process
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Pid is set, it can
+// safely use t.GetPid() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Pid == nil' before retrieving the leaf's value.
+func (t *System_Process) GetPid() uint64 {
+	if t == nil || t.Pid == nil {
+		return 0
+	}
+	return *t.Pid
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the System_Process
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *System_Process) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛListKeyMap returns the keys of the System_Process struct, which is a YANG list entry.
+func (t *System_Process) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Pid == nil {
+		return nil, fmt.Errorf("nil value for key Pid")
+	}
+
+	return map[string]interface{}{
+		"pid": *t.Pid,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of System_Process.
+func (*System_Process) ΛBelongingModule() string {
+	return "openconfig-system"
+}
@@ -0,0 +1,34 @@
+package ysocsys
+
+import (
+	"github.com/openconfig/ygot/ygot"
+)
+
+// Generate OpenConfig system GoStruct code
+//go:generate generator -output_file=gen.go -compress_paths=true -path=yang -package_name=ysocsys -fakeroot_name=root -prefer_operational_state=true -ignore_shadow_schema_paths=true -shorten_enum_leaf_names=true -generate_fakeroot=true -include_schema=false -generate_getters=true -generate_leaf_getters=true -generate_delete=true -generate_populate_defaults=true openconfig-system.yang
+
+// NtpOffsetRoot is a Root that also carries the NTP servers offset, in milliseconds. The upstream model declares
+// the offset leaf as uint64, while devices report signed and decimal values: parsers keep them out of the GoStruct.
+type NtpOffsetRoot struct {
+	*Root
+	NtpOffsets map[string]float64 // Key: NTP server address
+}
+
+// GoStructToNtpOffsets returns the NTP servers offset carried by a NtpOffsetRoot, or nil for a plain Root.
+func GoStructToNtpOffsets(ys ygot.GoStruct) map[string]float64 {
+	if root, ok := ys.(*NtpOffsetRoot); ok {
+		return root.NtpOffsets
+	}
+	return nil
+}
+
+// GoStructToOcSys converts a GoStruct interface to a pointer of a Root struct.
+func GoStructToOcSys(ys ygot.GoStruct) *Root {
+	switch root := ys.(type) {
+	case *Root:
+		return root
+	case *NtpOffsetRoot:
+		return root.Root
+	}
+	panic("not an ygot system GoStruct")
+}
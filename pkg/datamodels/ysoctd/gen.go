@@ -0,0 +1,669 @@
+/*
+Package ysoctd is a generated package which contains definitions
+of structs which represent a YANG schema. The generated schema can be
+compressed by a series of transformations (compression was true
+in this case).
+
+This package was generated by /Users/luca/go/pkg/mod/github.com/openconfig/ygot@v0.29.20/genutil/names.go
+using the following YANG input files:
+  - openconfig-terminal-device.yang
+
+Imported modules were sourced from:
+  - yang/...
+*/
+package ysoctd
+
+import (
+	"fmt"
+
+	"github.com/openconfig/ygot/ygot"
+)
+
+// Binary is a type that is used for fields that have a YANG type of
+// binary. It is used such that binary fields can be distinguished from
+// leaf-lists of uint8s (which are mapped to []uint8, equivalent to
+// []byte in reflection).
+type Binary []byte
+
+// YANGEmpty is a type that is used for fields that have a YANG type of
+// empty. It is used such that empty fields can be distinguished from boolean fields
+// in the generated code.
+type YANGEmpty bool
+
+// Root represents the /root YANG schema element.
+type Root struct {
+	TerminalDevice *TerminalDevice `path:"terminal-device" module:"openconfig-terminal-device"`
+}
+
+// IsYANGGoStruct ensures that Root implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Root) IsYANGGoStruct() {}
+
+// GetOrCreateTerminalDevice retrieves the value of the TerminalDevice field
+// or returns the existing field if it already exists.
+func (t *Root) GetOrCreateTerminalDevice() *TerminalDevice {
+	if t.TerminalDevice != nil {
+		return t.TerminalDevice
+	}
+	t.TerminalDevice = &TerminalDevice{}
+	return t.TerminalDevice
+}
+
+// GetTerminalDevice returns the value of the TerminalDevice struct pointer
+// from Root. If the receiver or the field TerminalDevice is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Root) GetTerminalDevice() *TerminalDevice {
+	if t != nil && t.TerminalDevice != nil {
+		return t.TerminalDevice
+	}
+	return nil
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the Root
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *Root) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	t.TerminalDevice.PopulateDefaults()
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Root.
+func (*Root) ΛBelongingModule() string {
+	return ""
+}
+
+// TerminalDevice represents the /openconfig-terminal-device/terminal-device YANG schema element.
+type TerminalDevice struct {
+	Channel map[uint32]*TerminalDevice_Channel `path:"logical-channels/channel" module:"openconfig-terminal-device/openconfig-terminal-device"`
+}
+
+// IsYANGGoStruct ensures that TerminalDevice implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*TerminalDevice) IsYANGGoStruct() {}
+
+// NewChannel creates a new entry in the Channel list of the
+// TerminalDevice struct. The keys of the list are populated from the input
+// arguments.
+func (t *TerminalDevice) NewChannel(Index uint32) (*TerminalDevice_Channel, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Channel == nil {
+		t.Channel = make(map[uint32]*TerminalDevice_Channel)
+	}
+
+	key := Index
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Channel[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Channel", key)
+	}
+
+	t.Channel[key] = &TerminalDevice_Channel{
+		Index: &Index,
+	}
+
+	return t.Channel[key], nil
+}
+
+// GetOrCreateChannelMap returns the list (map) from TerminalDevice.
+//
+// It initializes the field if not already initialized.
+func (t *TerminalDevice) GetOrCreateChannelMap() map[uint32]*TerminalDevice_Channel {
+	if t.Channel == nil {
+		t.Channel = make(map[uint32]*TerminalDevice_Channel)
+	}
+	return t.Channel
+}
+
+// GetOrCreateChannel retrieves the value with the specified keys from
+// the receiver TerminalDevice. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *TerminalDevice) GetOrCreateChannel(Index uint32) *TerminalDevice_Channel {
+
+	key := Index
+
+	if v, ok := t.Channel[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewChannel(Index)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateChannel got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetChannel retrieves the value with the specified key from
+// the Channel map field of TerminalDevice. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *TerminalDevice) GetChannel(Index uint32) *TerminalDevice_Channel {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Index
+
+	if lm, ok := t.Channel[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteChannel deletes the value with the specified keys from
+// the receiver TerminalDevice. If there is no such element, the function
+// is a no-op.
+func (t *TerminalDevice) DeleteChannel(Index uint32) {
+	key := Index
+
+	delete(t.Channel, key)
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the TerminalDevice
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *TerminalDevice) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	for _, e := range t.Channel {
+		e.PopulateDefaults()
+	}
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of TerminalDevice.
+func (*TerminalDevice) ΛBelongingModule() string {
+	return "openconfig-terminal-device"
+}
+
+// TerminalDevice_Channel represents the /openconfig-terminal-device/terminal-device/logical-channels/channel YANG schema element.
+type TerminalDevice_Channel struct {
+	Description *string                     `path:"state/description" module:"openconfig-terminal-device/openconfig-terminal-device" shadow-path:"config/description" shadow-module:"openconfig-terminal-device/openconfig-terminal-device"`
+	Index       *uint32                     `path:"state/index|index" module:"openconfig-terminal-device/openconfig-terminal-device|openconfig-terminal-device" shadow-path:"config/index|index" shadow-module:"openconfig-terminal-device/openconfig-terminal-device|openconfig-terminal-device"`
+	Otn         *TerminalDevice_Channel_Otn `path:"otn" module:"openconfig-terminal-device"`
+}
+
+// IsYANGGoStruct ensures that TerminalDevice_Channel implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*TerminalDevice_Channel) IsYANGGoStruct() {}
+
+// GetOrCreateOtn retrieves the value of the Otn field
+// or returns the existing field if it already exists.
+func (t *TerminalDevice_Channel) GetOrCreateOtn() *TerminalDevice_Channel_Otn {
+	if t.Otn != nil {
+		return t.Otn
+	}
+	t.Otn = &TerminalDevice_Channel_Otn{}
+	return t.Otn
+}
+
+// GetOtn returns the value of the Otn struct pointer
+// from TerminalDevice_Channel. If the receiver or the field Otn is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *TerminalDevice_Channel) GetOtn() *TerminalDevice_Channel_Otn {
+	if t != nil && t.Otn != nil {
+		return t.Otn
+	}
+	return nil
+}
+
+// GetDescription retrieves the value of the leaf Description from the TerminalDevice_Channel
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Description is set, it can
+// safely use t.GetDescription() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Description == nil' before retrieving the leaf's value.
+func (t *TerminalDevice_Channel) GetDescription() string {
+	if t == nil || t.Description == nil {
+		return ""
+	}
+	return *t.Description
+}
+
+// GetIndex retrieves the value of the leaf Index from the TerminalDevice_Channel
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Index is set, it can
+// safely use t.GetIndex() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Index == nil' before retrieving the leaf's value.
+func (t *TerminalDevice_Channel) GetIndex() uint32 {
+	if t == nil || t.Index == nil {
+		return 0
+	}
+	return *t.Index
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the TerminalDevice_Channel
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *TerminalDevice_Channel) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	t.Otn.PopulateDefaults()
+}
+
+// ΛListKeyMap returns the keys of the TerminalDevice_Channel struct, which is a YANG list entry.
+func (t *TerminalDevice_Channel) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Index == nil {
+		return nil, fmt.Errorf("nil value for key Index")
+	}
+
+	return map[string]interface{}{
+		"index": *t.Index,
+	}, nil
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of TerminalDevice_Channel.
+func (*TerminalDevice_Channel) ΛBelongingModule() string {
+	return "openconfig-terminal-device"
+}
+
+// TerminalDevice_Channel_Otn represents the /openconfig-terminal-device/terminal-device/logical-channels/channel/otn YANG schema element.
+type TerminalDevice_Channel_Otn struct {
+	Esnr                   *TerminalDevice_Channel_Otn_Esnr      `path:"state/esnr" module:"openconfig-terminal-device/openconfig-terminal-device"`
+	FecUncorrectableBlocks *uint64                               `path:"state/fec-uncorrectable-blocks" module:"openconfig-terminal-device/openconfig-terminal-device"`
+	PreFecBer              *TerminalDevice_Channel_Otn_PreFecBer `path:"state/pre-fec-ber" module:"openconfig-terminal-device/openconfig-terminal-device"`
+	QValue                 *TerminalDevice_Channel_Otn_QValue    `path:"state/q-value" module:"openconfig-terminal-device/openconfig-terminal-device"`
+}
+
+// IsYANGGoStruct ensures that TerminalDevice_Channel_Otn implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*TerminalDevice_Channel_Otn) IsYANGGoStruct() {}
+
+// GetOrCreateEsnr retrieves the value of the Esnr field
+// or returns the existing field if it already exists.
+func (t *TerminalDevice_Channel_Otn) GetOrCreateEsnr() *TerminalDevice_Channel_Otn_Esnr {
+	if t.Esnr != nil {
+		return t.Esnr
+	}
+	t.Esnr = &TerminalDevice_Channel_Otn_Esnr{}
+	return t.Esnr
+}
+
+// GetOrCreatePreFecBer retrieves the value of the PreFecBer field
+// or returns the existing field if it already exists.
+func (t *TerminalDevice_Channel_Otn) GetOrCreatePreFecBer() *TerminalDevice_Channel_Otn_PreFecBer {
+	if t.PreFecBer != nil {
+		return t.PreFecBer
+	}
+	t.PreFecBer = &TerminalDevice_Channel_Otn_PreFecBer{}
+	return t.PreFecBer
+}
+
+// GetOrCreateQValue retrieves the value of the QValue field
+// or returns the existing field if it already exists.
+func (t *TerminalDevice_Channel_Otn) GetOrCreateQValue() *TerminalDevice_Channel_Otn_QValue {
+	if t.QValue != nil {
+		return t.QValue
+	}
+	t.QValue = &TerminalDevice_Channel_Otn_QValue{}
+	return t.QValue
+}
+
+// GetEsnr returns the value of the Esnr struct pointer
+// from TerminalDevice_Channel_Otn. If the receiver or the field Esnr is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *TerminalDevice_Channel_Otn) GetEsnr() *TerminalDevice_Channel_Otn_Esnr {
+	if t != nil && t.Esnr != nil {
+		return t.Esnr
+	}
+	return nil
+}
+
+// GetPreFecBer returns the value of the PreFecBer struct pointer
+// from TerminalDevice_Channel_Otn. If the receiver or the field PreFecBer is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *TerminalDevice_Channel_Otn) GetPreFecBer() *TerminalDevice_Channel_Otn_PreFecBer {
+	if t != nil && t.PreFecBer != nil {
+		return t.PreFecBer
+	}
+	return nil
+}
+
+// GetQValue returns the value of the QValue struct pointer
+// from TerminalDevice_Channel_Otn. If the receiver or the field QValue is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *TerminalDevice_Channel_Otn) GetQValue() *TerminalDevice_Channel_Otn_QValue {
+	if t != nil && t.QValue != nil {
+		return t.QValue
+	}
+	return nil
+}
+
+// GetFecUncorrectableBlocks retrieves the value of the leaf FecUncorrectableBlocks from the TerminalDevice_Channel_Otn
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if FecUncorrectableBlocks is set, it can
+// safely use t.GetFecUncorrectableBlocks() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.FecUncorrectableBlocks == nil' before retrieving the leaf's value.
+func (t *TerminalDevice_Channel_Otn) GetFecUncorrectableBlocks() uint64 {
+	if t == nil || t.FecUncorrectableBlocks == nil {
+		return 0
+	}
+	return *t.FecUncorrectableBlocks
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the TerminalDevice_Channel_Otn
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *TerminalDevice_Channel_Otn) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+	t.Esnr.PopulateDefaults()
+	t.PreFecBer.PopulateDefaults()
+	t.QValue.PopulateDefaults()
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of TerminalDevice_Channel_Otn.
+func (*TerminalDevice_Channel_Otn) ΛBelongingModule() string {
+	return "openconfig-terminal-device"
+}
+
+// TerminalDevice_Channel_Otn_Esnr represents the /openconfig-terminal-device/terminal-device/logical-channels/channel/otn/state/esnr YANG schema element.
+type TerminalDevice_Channel_Otn_Esnr struct {
+	Avg     *float64 `path:"avg" module:"openconfig-terminal-device"`
+	Instant *float64 `path:"instant" module:"openconfig-terminal-device"`
+	Max     *float64 `path:"max" module:"openconfig-terminal-device"`
+	Min     *float64 `path:"min" module:"openconfig-terminal-device"`
+}
+
+// IsYANGGoStruct ensures that TerminalDevice_Channel_Otn_Esnr implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*TerminalDevice_Channel_Otn_Esnr) IsYANGGoStruct() {}
+
+// GetAvg retrieves the value of the leaf Avg from the TerminalDevice_Channel_Otn_Esnr
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Avg is set, it can
+// safely use t.GetAvg() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Avg == nil' before retrieving the leaf's value.
+func (t *TerminalDevice_Channel_Otn_Esnr) GetAvg() float64 {
+	if t == nil || t.Avg == nil {
+		return 0.0
+	}
+	return *t.Avg
+}
+
+// GetInstant retrieves the value of the leaf Instant from the TerminalDevice_Channel_Otn_Esnr
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Instant is set, it can
+// safely use t.GetInstant() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Instant == nil' before retrieving the leaf's value.
+func (t *TerminalDevice_Channel_Otn_Esnr) GetInstant() float64 {
+	if t == nil || t.Instant == nil {
+		return 0.0
+	}
+	return *t.Instant
+}
+
+// GetMax retrieves the value of the leaf Max from the TerminalDevice_Channel_Otn_Esnr
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Max is set, it can
+// safely use t.GetMax() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Max == nil' before retrieving the leaf's value.
+func (t *TerminalDevice_Channel_Otn_Esnr) GetMax() float64 {
+	if t == nil || t.Max == nil {
+		return 0.0
+	}
+	return *t.Max
+}
+
+// GetMin retrieves the value of the leaf Min from the TerminalDevice_Channel_Otn_Esnr
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Min is set, it can
+// safely use t.GetMin() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Min == nil' before retrieving the leaf's value.
+func (t *TerminalDevice_Channel_Otn_Esnr) GetMin() float64 {
+	if t == nil || t.Min == nil {
+		return 0.0
+	}
+	return *t.Min
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the TerminalDevice_Channel_Otn_Esnr
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *TerminalDevice_Channel_Otn_Esnr) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of TerminalDevice_Channel_Otn_Esnr.
+func (*TerminalDevice_Channel_Otn_Esnr) ΛBelongingModule() string {
+	return "openconfig-terminal-device"
+}
+
+// TerminalDevice_Channel_Otn_PreFecBer represents the /openconfig-terminal-device/terminal-device/logical-channels/channel/otn/state/pre-fec-ber YANG schema element.
+type TerminalDevice_Channel_Otn_PreFecBer struct {
+	Avg     *float64 `path:"avg" module:"openconfig-terminal-device"`
+	Instant *float64 `path:"instant" module:"openconfig-terminal-device"`
+	Max     *float64 `path:"max" module:"openconfig-terminal-device"`
+	Min     *float64 `path:"min" module:"openconfig-terminal-device"`
+}
+
+// IsYANGGoStruct ensures that TerminalDevice_Channel_Otn_PreFecBer implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*TerminalDevice_Channel_Otn_PreFecBer) IsYANGGoStruct() {}
+
+// GetAvg retrieves the value of the leaf Avg from the TerminalDevice_Channel_Otn_PreFecBer
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Avg is set, it can
+// safely use t.GetAvg() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Avg == nil' before retrieving the leaf's value.
+func (t *TerminalDevice_Channel_Otn_PreFecBer) GetAvg() float64 {
+	if t == nil || t.Avg == nil {
+		return 0.0
+	}
+	return *t.Avg
+}
+
+// GetInstant retrieves the value of the leaf Instant from the TerminalDevice_Channel_Otn_PreFecBer
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Instant is set, it can
+// safely use t.GetInstant() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Instant == nil' before retrieving the leaf's value.
+func (t *TerminalDevice_Channel_Otn_PreFecBer) GetInstant() float64 {
+	if t == nil || t.Instant == nil {
+		return 0.0
+	}
+	return *t.Instant
+}
+
+// GetMax retrieves the value of the leaf Max from the TerminalDevice_Channel_Otn_PreFecBer
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Max is set, it can
+// safely use t.GetMax() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Max == nil' before retrieving the leaf's value.
+func (t *TerminalDevice_Channel_Otn_PreFecBer) GetMax() float64 {
+	if t == nil || t.Max == nil {
+		return 0.0
+	}
+	return *t.Max
+}
+
+// GetMin retrieves the value of the leaf Min from the TerminalDevice_Channel_Otn_PreFecBer
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Min is set, it can
+// safely use t.GetMin() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Min == nil' before retrieving the leaf's value.
+func (t *TerminalDevice_Channel_Otn_PreFecBer) GetMin() float64 {
+	if t == nil || t.Min == nil {
+		return 0.0
+	}
+	return *t.Min
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the TerminalDevice_Channel_Otn_PreFecBer
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *TerminalDevice_Channel_Otn_PreFecBer) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of TerminalDevice_Channel_Otn_PreFecBer.
+func (*TerminalDevice_Channel_Otn_PreFecBer) ΛBelongingModule() string {
+	return "openconfig-terminal-device"
+}
+
+// TerminalDevice_Channel_Otn_QValue represents the /openconfig-terminal-device/terminal-device/logical-channels/channel/otn/state/q-value YANG schema element.
+type TerminalDevice_Channel_Otn_QValue struct {
+	Avg     *float64 `path:"avg" module:"openconfig-terminal-device"`
+	Instant *float64 `path:"instant" module:"openconfig-terminal-device"`
+	Max     *float64 `path:"max" module:"openconfig-terminal-device"`
+	Min     *float64 `path:"min" module:"openconfig-terminal-device"`
+}
+
+// IsYANGGoStruct ensures that TerminalDevice_Channel_Otn_QValue implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*TerminalDevice_Channel_Otn_QValue) IsYANGGoStruct() {}
+
+// GetAvg retrieves the value of the leaf Avg from the TerminalDevice_Channel_Otn_QValue
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Avg is set, it can
+// safely use t.GetAvg() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Avg == nil' before retrieving the leaf's value.
+func (t *TerminalDevice_Channel_Otn_QValue) GetAvg() float64 {
+	if t == nil || t.Avg == nil {
+		return 0.0
+	}
+	return *t.Avg
+}
+
+// GetInstant retrieves the value of the leaf Instant from the TerminalDevice_Channel_Otn_QValue
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Instant is set, it can
+// safely use t.GetInstant() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Instant == nil' before retrieving the leaf's value.
+func (t *TerminalDevice_Channel_Otn_QValue) GetInstant() float64 {
+	if t == nil || t.Instant == nil {
+		return 0.0
+	}
+	return *t.Instant
+}
+
+// GetMax retrieves the value of the leaf Max from the TerminalDevice_Channel_Otn_QValue
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Max is set, it can
+// safely use t.GetMax() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Max == nil' before retrieving the leaf's value.
+func (t *TerminalDevice_Channel_Otn_QValue) GetMax() float64 {
+	if t == nil || t.Max == nil {
+		return 0.0
+	}
+	return *t.Max
+}
+
+// GetMin retrieves the value of the leaf Min from the TerminalDevice_Channel_Otn_QValue
+// struct. If the field is unset but has a default value in the YANG schema,
+// then the default value will be returned.
+// Caution should be exercised whilst using this method since when without a
+// default value, it will return the Go zero value if the field is explicitly
+// unset. If the caller explicitly does not care if Min is set, it can
+// safely use t.GetMin() to retrieve the value. In the case that the
+// caller has different actions based on whether the leaf is set or unset, it
+// should use 'if t.Min == nil' before retrieving the leaf's value.
+func (t *TerminalDevice_Channel_Otn_QValue) GetMin() float64 {
+	if t == nil || t.Min == nil {
+		return 0.0
+	}
+	return *t.Min
+}
+
+// PopulateDefaults recursively populates unset leaf fields in the TerminalDevice_Channel_Otn_QValue
+// with default values as specified in the YANG schema, instantiating any nil
+// container fields.
+func (t *TerminalDevice_Channel_Otn_QValue) PopulateDefaults() {
+	if t == nil {
+		return
+	}
+	ygot.BuildEmptyTree(t)
+}
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of TerminalDevice_Channel_Otn_QValue.
+func (*TerminalDevice_Channel_Otn_QValue) ΛBelongingModule() string {
+	return "openconfig-terminal-device"
+}
@@ -0,0 +1,58 @@
+package exporter
+
+import (
+	"crypto/subtle"
+	log "github.com/automixer/gtexporter/pkg/logger"
+	"net/http"
+	"strings"
+)
+
+// Admin endpoints http paths.
+const (
+	pausePath  = "/admin/pause"
+	resumePath = "/admin/resume"
+)
+
+// pausedValue returns 1 if the telemetry collection is paused, 0 otherwise.
+func (p *promExporter) pausedValue() float64 {
+	if p.paused.Load() {
+		return 1
+	}
+	return 0
+}
+
+// authorized checks the bearer token of an admin request against the configured one.
+func (p *promExporter) authorized(r *http.Request) bool {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(p.config.AdminToken)) == 1
+}
+
+// newAdminHandler returns the http handler pausing (pause true) or resuming the telemetry collection.
+// While paused, the telemetry metrics are not collected, so Prometheus marks them as stale.
+// Self-monitoring and runtime metrics are still served.
+func (p *promExporter) newAdminHandler(pause bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if !p.authorized(r) {
+			log.Warningf("unauthorized admin request from %s", r.RemoteAddr)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		state := "resumed"
+		if pause {
+			state = "paused"
+		}
+		if p.paused.Swap(pause) != pause {
+			log.Infof("telemetry collection %s by %s", state, r.RemoteAddr)
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write([]byte(state + "\n"))
+	})
+}
@@ -0,0 +1,99 @@
+package exporter
+
+import (
+	"encoding/json"
+	log "github.com/automixer/gtexporter/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// describePath is the http path of the metrics describe endpoint.
+const describePath = "/metrics/describe"
+
+// PluginsPath is the http path of the registered plugins endpoint.
+const PluginsPath = "/plugins"
+
+// pluginDescription represents a registered plugin, as returned by the plugins endpoint.
+type pluginDescription struct {
+	Name      string `json:"name"`
+	DataModel string `json:"data_model"`
+}
+
+// metricDescription represents a registered metric descriptor, as returned by the describe endpoint.
+type metricDescription struct {
+	Name   string   `json:"name"`
+	Help   string   `json:"help"`
+	Type   string   `json:"type"`
+	Group  string   `json:"group"`
+	Labels []string `json:"labels"`
+}
+
+// metricTypeName returns the Prometheus type name of the metric described by mc.
+func metricTypeName(mc MetricCommons) string {
+	switch {
+	case mc.Info:
+		return "info"
+	case mc.Histogram != nil:
+		return "histogram"
+	}
+	switch mc.Type {
+	case prometheus.CounterValue:
+		return "counter"
+	case prometheus.GaugeValue:
+		return "gauge"
+	default:
+		return "untyped"
+	}
+}
+
+// describe returns the descriptions of all the registered metrics, sorted by name.
+func (p *promExporter) describe() []metricDescription {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	descs := make([]metricDescription, 0, len(p.descLabels))
+	for fqName, dl := range p.descLabels {
+		descs = append(descs, metricDescription{
+			Name:   fqName,
+			Help:   dl.help,
+			Type:   dl.mType,
+			Group:  dl.group,
+			Labels: slices.Clone(dl.keys),
+		})
+	}
+	slices.SortFunc(descs, func(a, b metricDescription) int { return strings.Compare(a.Name, b.Name) })
+	return descs
+}
+
+// newDescribeHandler returns the http handler of the describe endpoint.
+// It serves every registered metric descriptor with its help text, type and label keys, as JSON.
+// Metrics registered directly with Prometheus (Go runtime, exporter self-monitoring) are not included.
+func (p *promExporter) newDescribeHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(p.describe()); err != nil {
+			log.Error(err)
+		}
+	})
+}
+
+// newPluginsHandler returns the http handler of the plugins endpoint.
+// It serves the name and the declared data model of every registered plugin, as JSON.
+func (p *promExporter) newPluginsHandler() http.Handler {
+	descs := make([]pluginDescription, 0, len(p.config.Plugins))
+	for _, name := range slices.Sorted(maps.Keys(p.config.Plugins)) {
+		descs = append(descs, pluginDescription{Name: name, DataModel: p.config.Plugins[name]})
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(descs); err != nil {
+			log.Error(err)
+		}
+	})
+}
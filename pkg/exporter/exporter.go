@@ -3,17 +3,32 @@ package exporter
 import (
 	"context"
 	"errors"
-	log "github.com/golang/glog"
+	"fmt"
+	log "github.com/automixer/gtexporter/pkg/logger"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/client_golang/prometheus/push"
+	"github.com/prometheus/common/expfmt"
+	"io"
+	"net"
 	"net/http"
+	"slices"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
+// ReservedLabels lists the label keys automatically added to all metrics by the exporter.
+var ReservedLabels = []string{"instance_name", "device"}
+
 // Registry is a variable of type func(src GMetricSource, metrics []GMetric) error.
-// It is used to register metric sources with the promExporter.
+// It is used to register metric sources with the promExporter, into the DefaultGroup.
 var Registry func(src GMetricSource, metrics []GMetric) error
 
+// GroupRegistry is used to register metric sources with the promExporter, into a named group.
+var GroupRegistry func(group string, src GMetricSource, metrics []GMetric) error
+
 // GMetricSource is an interface for objects that provide metrics.
 type GMetricSource interface {
 	GetMetrics(ch chan<- GMetric)
@@ -25,30 +40,88 @@ type StaticLabel struct {
 }
 
 type Config struct {
-	ListenAddress string
-	ListenPort    string
-	ListenPath    string
-	InstanceName  string
-	MetricPrefix  string
-	StaticLabels  []StaticLabel
+	ListenAddress    string
+	ListenPort       string
+	ListenPath       string
+	InstanceName     string
+	DevInstances     map[string]string // Per device instance_name overrides. Key: device name
+	MetricPrefix     string
+	GaugeSuffix      string
+	StaticLabels     []StaticLabel
+	PushURL          string
+	PushInterval     time.Duration
+	AppVersion       string            // Shown on the landing page
+	Devices          []string          // Configured device names. Shown on the landing page
+	Plugins          map[string]string // Registered plugins data models. Key: plugin name. Served on PluginsPath
+	GroupPaths       map[string]string // Key: group name. Http path serving the group. Defaults to ListenPath
+	MaxScrapes       int               // Max concurrent in-flight scrape requests. Zero means no limit
+	CacheTTL         time.Duration     // Collections within this time from the previous one reuse its metrics
+	UseSystemdSocket bool              // Listen on the socket passed by systemd socket activation, if any
+	DebugEndpoints   bool              // Serve the debug endpoints (e.g.: metrics descriptors dump)
+	DisableRuntime   bool              // Go runtime and process metrics are not exported
+	AdminToken       string            // Bearer token of the admin endpoints. They are disabled if empty
 }
 
 type promExporter struct {
 	config     Config
 	httpServer *http.Server
 	mutex      sync.Mutex
+	stopPusher func()
+
+	descLabels map[string]descLabelSet // Key: metric FQName
+	groups     map[string]*sourceGroup // Key: group name
 
-	descriptors   map[string]*prometheus.Desc // Key: metric FQName
-	metricSources map[GMetricSource]bool      // Key: metric source
+	paused       atomic.Bool            // Telemetry collection is paused by the admin endpoints
+	sourcePanics prometheus.Counter     // Panics recovered while collecting metric sources
+	reloadMon    reloadMon              // Configuration reload metrics
+	capacityMon  []prometheus.Collector // Registered descriptors and metric sources gauges
+}
+
+// descLabelSet records the label keys of a registered descriptor, the source that registered it first,
+// the source type and its group. Help and metric type are kept for the describe endpoint.
+type descLabelSet struct {
+	keys    []string
+	owner   string
+	srcType string
+	group   string
+	help    string
+	mType   string
 }
 
 // New creates a new promExporter instance with the provided configuration.
 func New(cfg Config) (*promExporter, error) {
 	pExp := &promExporter{config: cfg}
 	Registry = pExp.registerSource
-	pExp.descriptors = make(map[string]*prometheus.Desc)
-	// Note: SelfMon sources are collected after Metric sources
-	pExp.metricSources = make(map[GMetricSource]bool)
+	GroupRegistry = pExp.registerGroupSource
+	pExp.descLabels = make(map[string]descLabelSet)
+	pExp.groups = make(map[string]*sourceGroup)
+	pExp.sourcePanics = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace:   cfg.MetricPrefix,
+		Name:        "source_panics_total",
+		Help:        "Panics recovered while collecting metric sources",
+		ConstLabels: prometheus.Labels{"instance_name": cfg.InstanceName},
+	})
+	pExp.reloadMon = newReloadMon(cfg)
+	pExp.capacityMon = []prometheus.Collector{
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace:   cfg.MetricPrefix,
+			Name:        "exporter_registered_descriptors",
+			Help:        "Metric descriptors registered by the metric sources",
+			ConstLabels: prometheus.Labels{"instance_name": cfg.InstanceName},
+		}, pExp.countDescriptors),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace:   cfg.MetricPrefix,
+			Name:        "exporter_metric_sources",
+			Help:        "Registered metric sources (plugins and gNMI clients)",
+			ConstLabels: prometheus.Labels{"instance_name": cfg.InstanceName},
+		}, pExp.countSources),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace:   cfg.MetricPrefix,
+			Name:        "exporter_collection_paused",
+			Help:        "Telemetry collection paused by the admin endpoints (1) or running (0)",
+			ConstLabels: prometheus.Labels{"instance_name": cfg.InstanceName},
+		}, pExp.pausedValue),
+	}
 	return pExp, nil
 }
 
@@ -56,20 +129,158 @@ func New(cfg Config) (*promExporter, error) {
 // It must be called after metric sources registration and is non-blocking
 func (p *promExporter) Start() error {
 	lAddr := p.config.ListenAddress + ":" + p.config.ListenPort
-	if err := prometheus.Register(p); err != nil {
-		log.Errorf(
-			"cannot register Prometheus metric descriptors. " +
-				"please check configured static labels against plugins labels. ")
+
+	// One registry per http path. Groups served on ListenPath share the default registry (Go runtime metrics)
+	registries := map[string]*prometheus.Registry{}
+	for name, group := range p.groups {
+		path := p.groupPath(name)
+		var reg prometheus.Registerer = prometheus.DefaultRegisterer
+		if path != p.config.ListenPath {
+			if _, ok := registries[path]; !ok {
+				registries[path] = prometheus.NewRegistry()
+			}
+			reg = registries[path]
+		}
+		if err := reg.Register(group); err != nil {
+			log.Errorf(
+				"cannot register Prometheus metric descriptors. " +
+					"please check configured static labels against plugins labels. ")
+			return err
+		}
+	}
+	// Go runtime and process collectors are registered by default into the default registry
+	if p.config.DisableRuntime {
+		prometheus.Unregister(collectors.NewGoCollector())
+		prometheus.Unregister(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+	}
+	if err := prometheus.Register(p.sourcePanics); err != nil {
+		return err
+	}
+	if err := p.reloadMon.register(); err != nil {
 		return err
 	}
-	http.Handle(p.config.ListenPath, promhttp.Handler())
+	for _, c := range p.capacityMon {
+		if err := prometheus.Register(c); err != nil {
+			return err
+		}
+	}
+	limit := p.newScrapeLimiter()
+	http.Handle(p.config.ListenPath, limit(promhttp.Handler()))
+	for path, reg := range registries {
+		http.Handle(path, limit(promhttp.HandlerFor(reg, promhttp.HandlerOpts{})))
+	}
+	if p.config.ListenPath != "/" {
+		http.Handle("/", p.newLandingHandler())
+	}
+	if p.config.ListenPath != PluginsPath {
+		http.Handle(PluginsPath, p.newPluginsHandler())
+	}
+	if p.config.DebugEndpoints {
+		http.Handle(describePath, p.newDescribeHandler())
+		if p.config.AdminToken != "" {
+			http.Handle(pausePath, p.newAdminHandler(true))
+			http.Handle(resumePath, p.newAdminHandler(false))
+		}
+	}
 	p.httpServer = &http.Server{Addr: lAddr}
-	go func() { log.Info(p.httpServer.ListenAndServe()) }()
+	var listener net.Listener
+	if p.config.UseSystemdSocket {
+		var err error
+		if listener, err = systemdListener(); err != nil {
+			return err
+		}
+		if listener == nil {
+			log.Warningf("no systemd socket provided, listening on %s", lAddr)
+		}
+	}
+	if listener != nil {
+		log.Infof("listening on systemd socket %s", listener.Addr())
+		go func() { log.Info(p.httpServer.Serve(listener)) }()
+	} else {
+		go func() { log.Info(p.httpServer.ListenAndServe()) }()
+	}
+
+	// Pushgateway
+	if p.config.PushURL != "" {
+		p.startPusher()
+	}
+	return nil
+}
+
+// newScrapeLimiter returns a middleware limiting the concurrent in-flight scrape requests to MaxScrapes.
+// Collections are serialized by the exporter mutex, so requests exceeding the limit are answered with
+// 503 instead of queueing up. The limit is shared among all the metric paths.
+func (p *promExporter) newScrapeLimiter() func(http.Handler) http.Handler {
+	if p.config.MaxScrapes <= 0 {
+		return func(h http.Handler) http.Handler { return h }
+	}
+	sem := make(chan struct{}, p.config.MaxScrapes)
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+				h.ServeHTTP(w, r)
+			default:
+				http.Error(w, "too many concurrent scrapes", http.StatusServiceUnavailable)
+			}
+		})
+	}
+}
+
+// startPusher starts a goroutine that periodically pushes the collected metrics to the configured Pushgateway.
+// The instance name is used as the Pushgateway job name.
+func (p *promExporter) startPusher() {
+	pusher := push.New(p.config.PushURL, p.config.InstanceName).Collector(p)
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(p.config.PushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := pusher.PushContext(ctx); err != nil && ctx.Err() == nil {
+					log.Errorf("cannot push metrics to %s: %s", p.config.PushURL, err)
+				}
+			}
+		}
+	}()
+	p.stopPusher = func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
+// WriteText collects the metrics once and writes them to w using the Prometheus text exposition format.
+// It does not require the exporter to be started.
+func (p *promExporter) WriteText(w io.Writer) error {
+	reg := prometheus.NewRegistry()
+	if err := reg.Register(p); err != nil {
+		return err
+	}
+	mfs, err := reg.Gather()
+	if err != nil {
+		return err
+	}
+	enc := expfmt.NewEncoder(w, expfmt.NewFormat(expfmt.TypeTextPlain))
+	for _, mf := range mfs {
+		if err := enc.Encode(mf); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // Close stops the Prometheus exporter and unregisters all metric sources.
 func (p *promExporter) Close() {
+	if p.stopPusher != nil {
+		p.stopPusher()
+	}
 	if p.httpServer != nil {
 		p.unRegisterAllSources()
 		err := p.httpServer.Shutdown(context.Background())
@@ -80,22 +291,45 @@ func (p *promExporter) Close() {
 }
 
 // Describe implements the Prometheus collector interface
-// The method iterates over the descriptors map of the promExporter and sends each descriptor to the channel.
+// The method iterates over the descriptors of all the groups and sends each descriptor to the channel.
 // The purpose of this method is to allow Prometheus to collect the metadata about the metrics.
-// This method is automatically called by Prometheus when the exporter is registered to Prom.
+// It is used when all the groups are collected at once (e.g.: Pushgateway).
 func (p *promExporter) Describe(ch chan<- *prometheus.Desc) {
-	for _, desc := range p.descriptors {
-		ch <- desc
+	for _, group := range p.groups {
+		group.Describe(ch)
 	}
 }
 
 // Collect implements the Prometheus collector interface
-// It starts a goroutine to handle the gathering of metrics from the sources concurrently.
-// The goroutine receives metrics from a channel, validates them, and prepares them for sending to Prometheus.
-// This method is called by Prometheus when a scrape event occurs.
+// It collects the metrics of all the groups.
 func (p *promExporter) Collect(ch chan<- prometheus.Metric) {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
+	for _, group := range p.groups {
+		p.collectGroup(group, ch)
+	}
+}
+
+// collectGroup collects the metrics of the sources of the given group.
+// It starts a goroutine to handle the gathering of metrics from the sources concurrently.
+// The goroutine receives metrics from a channel, validates them, and prepares them for sending to Prometheus.
+// If CacheTTL is set and the previous collection is recent enough, its metrics are sent instead.
+// While paused by the admin endpoints, the telemetry group returns no metrics, whatever the consumer
+// (scrape, Pushgateway or -once).
+// The caller must hold the exporter mutex.
+func (p *promExporter) collectGroup(g *sourceGroup, ch chan<- prometheus.Metric) {
+	if g.name == DefaultGroup && p.paused.Load() {
+		return
+	}
+	// Serve the snapshot if still valid
+	useCache := p.config.CacheTTL > 0
+	if useCache && time.Since(g.snapTime) < p.config.CacheTTL {
+		for _, m := range g.snapshot {
+			ch <- m
+		}
+		return
+	}
+	var snapshot []prometheus.Metric
 
 	// Start gatherer goroutine
 	mChan := make(chan GMetric)
@@ -112,23 +346,41 @@ func (p *promExporter) Collect(ch chan<- prometheus.Metric) {
 				log.Error(err)
 				continue
 			}
-			desc, ok := p.descriptors[buildFQName(p.config.MetricPrefix, commons)]
+			desc, ok := g.descriptors[buildFQName(p.config.MetricPrefix, p.config.GaugeSuffix, commons)]
 			if !ok {
 				log.Error("metric descriptor not found")
 				continue
 			}
 			// Prepare labels
-			lv := []string{p.config.InstanceName, commons.Device}
+			instanceName := p.config.InstanceName
+			if devInstance, ok := p.config.DevInstances[commons.Device]; ok {
+				instanceName = devInstance
+			}
+			lv := []string{instanceName, commons.Device}
 			for _, slv := range p.config.StaticLabels {
 				lv = append(lv, slv.Value)
 			}
 			lv = append(lv, getLabelValues(gMetric)...)
 			// Send metric to Prom
-			pMetric, err := prometheus.NewConstMetric(desc, commons.Type, commons.Value, lv...)
+			var pMetric prometheus.Metric
+			var err error
+			if h := commons.Histogram; h != nil {
+				pMetric, err = prometheus.NewConstHistogram(desc, h.Count, h.Sum, h.Buckets, lv...)
+			} else {
+				mType, mValue := commons.Type, commons.Value
+				if commons.Info {
+					// Info metrics are constant gauges
+					mType, mValue = prometheus.GaugeValue, 1
+				}
+				pMetric, err = prometheus.NewConstMetric(desc, mType, mValue, lv...)
+			}
 			if err != nil {
 				log.Error("cannot send a malformed metric to prometheus")
 				continue
 			}
+			if useCache {
+				snapshot = append(snapshot, pMetric)
+			}
 			ch <- pMetric
 		}
 		close(done)
@@ -136,11 +388,18 @@ func (p *promExporter) Collect(ch chan<- prometheus.Metric) {
 
 	var wg sync.WaitGroup
 	// Gather data from metric sources
-	for mSource := range p.metricSources {
+	for mSource := range g.sources {
 		wg.Add(1)
 		go func(s GMetricSource) {
+			defer wg.Done()
+			// A panicking source must not take down the whole scrape
+			defer func() {
+				if r := recover(); r != nil {
+					log.Errorf("metric source %T panicked during collection: %v", s, r)
+					p.sourcePanics.Inc()
+				}
+			}()
 			s.GetMetrics(mChan)
-			wg.Done()
 		}(mSource)
 	}
 	wg.Wait()
@@ -148,11 +407,20 @@ func (p *promExporter) Collect(ch chan<- prometheus.Metric) {
 	// End collection
 	close(mChan)
 	<-done
+	if useCache {
+		g.snapshot, g.snapTime = snapshot, time.Now()
+	}
 }
 
 // registerSource registers a metric source and its corresponding metrics with the promExporter.
 // This method is assigned to the global Registry variable
 func (p *promExporter) registerSource(src GMetricSource, metrics []GMetric) error {
+	return p.registerGroupSource(DefaultGroup, src, metrics)
+}
+
+// registerGroupSource registers a metric source and its corresponding metrics into the named group.
+// This method is assigned to the global GroupRegistry variable
+func (p *promExporter) registerGroupSource(groupName string, src GMetricSource, metrics []GMetric) error {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
 
@@ -165,7 +433,8 @@ func (p *promExporter) registerSource(src GMetricSource, metrics []GMetric) erro
 	}
 
 	// Metric source registration
-	p.metricSources[src] = true
+	group := p.getGroup(groupName)
+	group.sources[src] = true
 
 	// Prometheus descriptor creation
 	for _, m := range metrics {
@@ -176,24 +445,85 @@ func (p *promExporter) registerSource(src GMetricSource, metrics []GMetric) erro
 		if err := commons.validate(); err != nil {
 			return err
 		}
-		fqName := buildFQName(p.config.MetricPrefix, commons)
-		if _, ok := p.descriptors[fqName]; ok {
-			// This is the case where different sources register the same metric. (e.g.: Self monitoring)
-			continue
-		}
-		labelKeys := []string{"instance_name", "device"}
+		fqName := buildFQName(p.config.MetricPrefix, p.config.GaugeSuffix, commons)
+		labelKeys := slices.Clone(ReservedLabels)
 		for _, lk := range p.config.StaticLabels {
 			labelKeys = append(labelKeys, lk.Key)
 		}
 		labelKeys = append(labelKeys, getLabelKeys(m)...)
-		p.descriptors[fqName] = prometheus.NewDesc(fqName, commons.Help, labelKeys, nil)
+		srcType := fmt.Sprintf("%T", src)
+		owner := fmt.Sprintf("%s (device %s)", srcType, commons.Device)
+		if dup := duplicateKey(labelKeys); dup != "" {
+			return fmt.Errorf("metric %s from %s: label %s is set more than once. "+
+				"Please check the configured static labels", fqName, owner, dup)
+		}
+		if registered, ok := p.descLabels[fqName]; ok {
+			// This is the case where different sources register the same metric. (e.g.: Self monitoring)
+			// Only instances of the same source type can share a metric (e.g.: gNMI clients of different devices)
+			if registered.srcType != srcType {
+				return fmt.Errorf("metric %s from %s collides with the same metric from %s",
+					fqName, owner, registered.owner)
+			}
+			// The label set must be the same
+			if !slices.Equal(registered.keys, labelKeys) {
+				return fmt.Errorf("metric %s: label keys %v from %s conflict with label keys %v from %s",
+					fqName, labelKeys, owner, registered.keys, registered.owner)
+			}
+			// The metric must belong to a single group
+			if registered.group != groupName {
+				return fmt.Errorf("metric %s: group %s from %s conflicts with group %s from %s",
+					fqName, groupName, owner, registered.group, registered.owner)
+			}
+			continue
+		}
+		p.descLabels[fqName] = descLabelSet{
+			keys:    labelKeys,
+			owner:   owner,
+			srcType: srcType,
+			group:   groupName,
+			help:    commons.Help,
+			mType:   metricTypeName(commons),
+		}
+		group.descriptors[fqName] = prometheus.NewDesc(fqName, commons.Help, labelKeys, nil)
 	}
 	return nil
 }
 
+// countDescriptors returns the number of registered metric descriptors.
+func (p *promExporter) countDescriptors() float64 {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return float64(len(p.descLabels))
+}
+
+// countSources returns the number of registered metric sources, across all the groups.
+func (p *promExporter) countSources() float64 {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	var n int
+	for _, group := range p.groups {
+		n += len(group.sources)
+	}
+	return float64(n)
+}
+
+// duplicateKey returns the first key found more than once in keys, or an empty string.
+func duplicateKey(keys []string) string {
+	seen := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		if seen[k] {
+			return k
+		}
+		seen[k] = true
+	}
+	return ""
+}
+
 // unRegisterAllSources method removes all metric sources from the promExporter.
 func (p *promExporter) unRegisterAllSources() {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
-	p.metricSources = nil
+	for _, group := range p.groups {
+		group.sources = nil
+	}
 }
@@ -0,0 +1,70 @@
+package exporter
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// goodSource sends a single testMetric.
+type goodSource struct{}
+
+func (s *goodSource) GetMetrics(ch chan<- GMetric) {
+	ch <- newTestMetric()
+}
+
+// panicSource sends a metric, then panics.
+type panicSource struct{}
+
+func (s *panicSource) GetMetrics(ch chan<- GMetric) {
+	ch <- newPanicMetric()
+	var m map[string]int
+	m["boom"]++ // Assignment to entry in nil map
+}
+
+func newPanicMetric() testMetric {
+	m := newTestMetric()
+	m.Name = "panic"
+	m.Device = "dev2"
+	return m
+}
+
+// collect returns the FQNames of the metrics collected by the exporter.
+func collect(p *promExporter) []string {
+	ch := make(chan prometheus.Metric, 16)
+	p.Collect(ch)
+	close(ch)
+	var out []string
+	for m := range ch {
+		desc := m.Desc().String()
+		_, after, _ := strings.Cut(desc, `fqName: "`)
+		name, _, _ := strings.Cut(after, `"`)
+		out = append(out, name)
+	}
+	return out
+}
+
+func TestCollectSourcePanic(t *testing.T) {
+	p, err := New(Config{MetricPrefix: "gtexporter", InstanceName: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = p.registerSource(&goodSource{}, []GMetric{newTestMetric()}); err != nil {
+		t.Fatal(err)
+	}
+	if err = p.registerSource(&panicSource{}, []GMetric{newPanicMetric()}); err != nil {
+		t.Fatal(err)
+	}
+
+	for scrape := 1; scrape <= 2; scrape++ {
+		names := collect(p)
+		if !slices.Contains(names, "gtexporter_test_total") {
+			t.Errorf("scrape %d: metrics of the other sources are missing: %v", scrape, names)
+		}
+		if got := testutil.ToFloat64(p.sourcePanics); got != float64(scrape) {
+			t.Errorf("scrape %d: source_panics_total is %v, want %d", scrape, got, scrape)
+		}
+	}
+}
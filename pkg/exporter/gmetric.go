@@ -4,6 +4,7 @@ import (
 	"errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"reflect"
+	"strings"
 )
 
 // GMetric is an interface that represents a generic metric.
@@ -20,7 +21,18 @@ type MetricCommons struct {
 	Help   string // Help string for Prom metric description
 	Device string // Device name (gnmi client)
 	Type   prometheus.ValueType
+	Info   bool // Info metric. Exported as a gauge with the "_info" suffix and value 1
 	Value  float64
+	// Histogram metric. If not nil, the metric is exported as a histogram and Type and Value are ignored.
+	// It must be set (even if empty) on the metrics provided at registration time.
+	Histogram *Histogram
+}
+
+// Histogram holds the content of a histogram metric.
+type Histogram struct {
+	Count   uint64             // Number of observations
+	Sum     float64            // Sum of the observed values
+	Buckets map[float64]uint64 // Key: bucket upper bound. Value: cumulative count of observations
 }
 
 // getCommons returns a copy of the MetricCommons object on which it is invoked.
@@ -37,56 +49,230 @@ func (m MetricCommons) validate() error {
 	if m.Device == "" {
 		return errors.New("device is required")
 	}
+	if m.Info && m.Histogram != nil {
+		return errors.New("info metrics cannot be histograms")
+	}
 	return nil
 }
 
-// getLabelKeys retrieves the keys of the labeled fields in the provided GMetric object.
-// Fields key names from user defined metrics are extracted by this method using reflection and the "label" tag.
-func getLabelKeys(m GMetric) []string {
-	rType := reflect.TypeOf(m)
-	labelKeys := make([]string, 0, rType.NumField())
+// typedMetric wraps a GMetric overriding its metric type.
+type typedMetric struct {
+	GMetric
+	mType prometheus.ValueType
+}
+
+// getCommons returns the wrapped metric commons with the overridden type.
+func (m typedMetric) getCommons() MetricCommons {
+	mc := m.GMetric.getCommons()
+	mc.Type = m.mType
+	return mc
+}
+
+// OverrideType returns a GMetric whose type is replaced according to the given map (Key: metric name).
+// If the metric name is not in the map, the metric is returned unchanged.
+func OverrideType(m GMetric, types map[string]prometheus.ValueType) GMetric {
+	if m == nil || len(types) == 0 {
+		return m
+	}
+	if t, ok := types[m.getCommons().Name]; ok {
+		return typedMetric{GMetric: m, mType: t}
+	}
+	return m
+}
+
+// valuedMetric wraps a GMetric overriding its value.
+type valuedMetric struct {
+	GMetric
+	value float64
+}
+
+// getCommons returns the wrapped metric commons with the overridden value.
+func (m valuedMetric) getCommons() MetricCommons {
+	mc := m.GMetric.getCommons()
+	mc.Value = m.value
+	return mc
+}
+
+// OverrideValue returns a GMetric whose value is replaced by the given one.
+func OverrideValue(m GMetric, value float64) GMetric {
+	if m == nil {
+		return m
+	}
+	return valuedMetric{GMetric: m, value: value}
+}
+
+// labeledMetric wraps a GMetric overriding the value of one of its labels.
+type labeledMetric struct {
+	GMetric
+	key   string
+	value string
+}
+
+// OverrideLabel returns a GMetric whose label value of the given key is replaced by the given one.
+// If the metric has no such label, the override has no effect.
+func OverrideLabel(m GMetric, key, value string) GMetric {
+	if m == nil {
+		return m
+	}
+	return labeledMetric{GMetric: m, key: key, value: value}
+}
+
+// Commons returns a copy of the MetricCommons of the provided GMetric object, overrides included.
+func Commons(m GMetric) MetricCommons {
+	return m.getCommons()
+}
+
+// LabelValue returns the value of the given label key of the provided GMetric object.
+// It returns an empty string if the metric has no such label.
+func LabelValue(m GMetric, key string) string {
+	overrides := labelOverrides(m)
+	if value, ok := overrides[key]; ok {
+		return value
+	}
+	m = unwrap(m)
+	rValue := reflect.ValueOf(m)
+	for _, f := range getLabelFields(rValue.Type()) {
+		if f.key == key {
+			return rValue.Field(f.index).String()
+		}
+	}
+	return ""
+}
+
+// unwrap returns the user defined metric, removing any type, value or label override wrapper.
+func unwrap(m GMetric) GMetric {
+	for {
+		switch wm := m.(type) {
+		case typedMetric:
+			m = wm.GMetric
+		case valuedMetric:
+			m = wm.GMetric
+		case labeledMetric:
+			m = wm.GMetric
+		default:
+			return m
+		}
+	}
+}
+
+// labelOverrides returns the label values overridden by the wrappers of the provided GMetric object.
+// The outermost override of a label wins. It returns nil if there are none.
+func labelOverrides(m GMetric) map[string]string {
+	var out map[string]string
+	for {
+		switch wm := m.(type) {
+		case typedMetric:
+			m = wm.GMetric
+		case valuedMetric:
+			m = wm.GMetric
+		case labeledMetric:
+			if out == nil {
+				out = make(map[string]string)
+			}
+			if _, ok := out[wm.key]; !ok {
+				out[wm.key] = wm.value
+			}
+			m = wm.GMetric
+		default:
+			return out
+		}
+	}
+}
+
+// LabelKeys returns the label keys of the provided GMetric object, as found in its "label" tags.
+// Automatic labels (instance_name, device) and static labels are not included.
+func LabelKeys(m GMetric) []string {
+	return getLabelKeys(m)
+}
+
+// labelField pairs a label key with the index of the struct field carrying its value.
+type labelField struct {
+	key   string
+	index int
+}
+
+// SeriesSample returns the identity of the series carried by the provided GMetric object
+// (name, device and label values) with its value.
+// It returns false for histograms, whose samples cannot be compared by a single value.
+func SeriesSample(m GMetric) (string, float64, bool) {
+	mc := m.getCommons()
+	if mc.Histogram != nil {
+		return "", 0, false
+	}
+	key := mc.Name + "\xff" + mc.Device + "\xff" + strings.Join(getLabelValues(m), "\xff")
+	return key, mc.Value, true
+}
+
+// getLabelFields retrieves the labeled fields of the provided GMetric type, in struct declaration order.
+// Both label keys and label values are derived from this single walk, so that a key can never be paired
+// with the value of a different field.
+func getLabelFields(rType reflect.Type) []labelField {
+	fields := make([]labelField, 0, rType.NumField())
 	for i := 0; i < rType.NumField(); i++ {
 		if lk, ok := rType.Field(i).Tag.Lookup("label"); ok {
-			labelKeys = append(labelKeys, lk)
+			fields = append(fields, labelField{key: lk, index: i})
 		}
 	}
-	if len(labelKeys) == 0 {
+	return fields
+}
+
+// getLabelKeys retrieves the keys of the labeled fields in the provided GMetric object.
+// Fields key names from user defined metrics are extracted by this method using reflection and the "label" tag.
+func getLabelKeys(m GMetric) []string {
+	fields := getLabelFields(reflect.TypeOf(unwrap(m)))
+	if len(fields) == 0 {
 		return nil
 	}
+	labelKeys := make([]string, 0, len(fields))
+	for _, f := range fields {
+		labelKeys = append(labelKeys, f.key)
+	}
 	return labelKeys
 }
 
 // getLabelValues retrieves the string values of the labeled fields in the provided GMetric object.
 // Fields key values from user defined metrics are extracted by this method using reflection and the "label" tag.
+// Values are emitted in the order of the getLabelFields walk, the same one getLabelKeys relies on.
+// Label overrides take precedence over the struct field values.
 func getLabelValues(m GMetric) []string {
-	rType := reflect.TypeOf(m)
-	rValue := reflect.ValueOf(m)
-	labelValues := make([]string, 0, rValue.NumField())
-	for i := 0; i < rValue.NumField(); i++ {
-		if _, ok := rType.Field(i).Tag.Lookup("label"); ok {
-			lv := rValue.Field(i).String()
+	overrides := labelOverrides(m)
+	rValue := reflect.ValueOf(unwrap(m))
+	fields := getLabelFields(rValue.Type())
+	if len(fields) == 0 {
+		return nil
+	}
+	labelValues := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if lv, ok := overrides[f.key]; ok {
 			labelValues = append(labelValues, lv)
+			continue
 		}
-	}
-	if len(labelValues) == 0 {
-		return nil
+		labelValues = append(labelValues, rValue.Field(f.index).String())
 	}
 	return labelValues
 }
 
 // buildFQName builds a fully qualified metric name using the provided prefix and MetricCommons.
-// It appends "_counters" or "_gauges" to the metric name based on its Type.
+// It appends "_total" or the gauge suffix to the metric name based on its Type, or "_info" for info metrics.
+// Histogram names are left untouched: Prometheus appends the _bucket, _sum and _count suffixes.
 // Parameters:
 // - pfx: the prefix for the metric name
+// - gaugeSfx: the suffix for gauge metrics
 // - mc: the MetricCommons object containing the metric name and type
 // Returns the fully qualified metric name as a string.
-func buildFQName(pfx string, mc MetricCommons) string {
+func buildFQName(pfx, gaugeSfx string, mc MetricCommons) string {
 	fqName := prometheus.BuildFQName(pfx, "", mc.Name)
+	if mc.Info {
+		return fqName + "_info"
+	}
+	if mc.Histogram != nil {
+		return fqName
+	}
 	switch mc.getCommons().Type {
 	case prometheus.CounterValue:
 		fqName += "_total"
 	case prometheus.GaugeValue:
-		fqName += "_gauges"
+		fqName += gaugeSfx
 	case prometheus.UntypedValue:
 	}
 	return fqName
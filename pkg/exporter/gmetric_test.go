@@ -0,0 +1,91 @@
+package exporter
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// testMetric is a user defined metric. Unlabeled fields are interleaved to the labeled ones on purpose.
+type testMetric struct {
+	MetricCommons
+	Metric string `label:"metric"`
+	Unused string
+	IfName string `label:"name"`
+	Desc   string `label:"description"`
+}
+
+func newTestMetric() testMetric {
+	m := testMetric{Metric: "in-octets", Unused: "unused", IfName: "eth0", Desc: "uplink"}
+	m.Name = "test"
+	m.Device = "dev1"
+	m.Type = prometheus.CounterValue
+	return m
+}
+
+// TestLabelPairing checks that each label key is paired with the value of the struct field carrying its tag.
+func TestLabelPairing(t *testing.T) {
+	m := newTestMetric()
+	keys := getLabelKeys(m)
+	values := getLabelValues(m)
+	if len(keys) != len(values) {
+		t.Fatalf("got %d keys and %d values", len(keys), len(values))
+	}
+	byTag := make(map[string]string) // Key: label tag. Value: field value
+	rValue := reflect.ValueOf(m)
+	for i := 0; i < rValue.NumField(); i++ {
+		if tag, ok := rValue.Type().Field(i).Tag.Lookup("label"); ok {
+			byTag[tag] = rValue.Field(i).String()
+		}
+	}
+	for i, key := range keys {
+		if values[i] != byTag[key] {
+			t.Errorf("label %q: got %q, want %q", key, values[i], byTag[key])
+		}
+	}
+}
+
+func TestLabelValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		metric GMetric
+		want   []string
+	}{
+		{
+			name:   "plain",
+			metric: newTestMetric(),
+			want:   []string{"in-octets", "eth0", "uplink"},
+		},
+		{
+			name:   "override",
+			metric: OverrideLabel(newTestMetric(), "metric", "in_octets"),
+			want:   []string{"in_octets", "eth0", "uplink"},
+		},
+		{
+			name:   "outermost override wins",
+			metric: OverrideLabel(OverrideLabel(newTestMetric(), "name", "eth1"), "name", "eth2"),
+			want:   []string{"in-octets", "eth2", "uplink"},
+		},
+		{
+			name:   "unknown label override",
+			metric: OverrideLabel(newTestMetric(), "unknown", "value"),
+			want:   []string{"in-octets", "eth0", "uplink"},
+		},
+		{
+			name:   "other wrappers",
+			metric: OverrideValue(OverrideLabel(newTestMetric(), "description", ""), 10),
+			want:   []string{"in-octets", "eth0", ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getLabelValues(tt.metric); !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			if got := getLabelKeys(tt.metric); !slices.Equal(got, []string{"metric", "name", "description"}) {
+				t.Errorf("unexpected label keys %q", got)
+			}
+		})
+	}
+}
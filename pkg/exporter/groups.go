@@ -0,0 +1,63 @@
+package exporter
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"time"
+)
+
+// Metric source groups. Each group can be served on its own http path.
+const (
+	DefaultGroup = "default" // Telemetry metrics
+	SelfMonGroup = "selfmon" // Self-monitoring metrics
+)
+
+// sourceGroup represents a named group of metric sources and their Prometheus descriptors.
+// It implements the Prometheus collector interface.
+type sourceGroup struct {
+	exp         *promExporter
+	name        string
+	descriptors map[string]*prometheus.Desc // Key: metric FQName
+	sources     map[GMetricSource]bool      // Key: metric source
+	snapshot    []prometheus.Metric         // Metrics of the last collection. Used if CacheTTL is set
+	snapTime    time.Time                   // Time of the last collection
+}
+
+// newSourceGroup creates a new empty sourceGroup bound to the given exporter.
+func newSourceGroup(exp *promExporter, name string) *sourceGroup {
+	return &sourceGroup{
+		exp:         exp,
+		name:        name,
+		descriptors: make(map[string]*prometheus.Desc),
+		sources:     make(map[GMetricSource]bool),
+	}
+}
+
+// Describe implements the Prometheus collector interface
+func (g *sourceGroup) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range g.descriptors {
+		ch <- desc
+	}
+}
+
+// Collect implements the Prometheus collector interface
+func (g *sourceGroup) Collect(ch chan<- prometheus.Metric) {
+	g.exp.mutex.Lock()
+	defer g.exp.mutex.Unlock()
+	g.exp.collectGroup(g, ch)
+}
+
+// groupPath returns the http path serving the given group.
+func (p *promExporter) groupPath(group string) string {
+	if path, ok := p.config.GroupPaths[group]; ok && path != "" {
+		return path
+	}
+	return p.config.ListenPath
+}
+
+// getGroup returns the named group, creating it if missing.
+func (p *promExporter) getGroup(name string) *sourceGroup {
+	if _, ok := p.groups[name]; !ok {
+		p.groups[name] = newSourceGroup(p, name)
+	}
+	return p.groups[name]
+}
@@ -0,0 +1,55 @@
+package exporter
+
+import (
+	log "github.com/automixer/gtexporter/pkg/logger"
+	"html/template"
+	"net/http"
+	"slices"
+)
+
+// landingTemplate is the HTML template of the exporter landing page.
+var landingTemplate = template.Must(template.New("landing").Parse(`<!DOCTYPE html>
+<html>
+<head><title>GtExporter</title></head>
+<body>
+<h1>GtExporter</h1>
+<p>Instance: {{.InstanceName}}<br>Version: {{.AppVersion}}</p>
+<p><a href="{{.ListenPath}}">Metrics</a></p>
+<h2>Devices</h2>
+<ul>
+{{- range .Devices}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+</body>
+</html>
+`))
+
+// landingData represents the values rendered by the landing page template.
+type landingData struct {
+	InstanceName string
+	AppVersion   string
+	ListenPath   string
+	Devices      []string
+}
+
+// newLandingHandler returns the http handler of the exporter landing page.
+// Only the root path is served, any other path returns 404.
+func (p *promExporter) newLandingHandler() http.Handler {
+	data := landingData{
+		InstanceName: p.config.InstanceName,
+		AppVersion:   p.config.AppVersion,
+		ListenPath:   p.config.ListenPath,
+		Devices:      slices.Sorted(slices.Values(p.config.Devices)),
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := landingTemplate.Execute(w, data); err != nil {
+			log.Error(err)
+		}
+	})
+}
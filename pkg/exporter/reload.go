@@ -0,0 +1,101 @@
+package exporter
+
+import (
+	"errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// reloadMon holds the configuration reload self-monitoring metrics.
+type reloadMon struct {
+	success    prometheus.Counter // Successful configuration reloads
+	errors     prometheus.Counter // Failed configuration reloads
+	lastReload prometheus.Gauge   // Time of the last successful configuration load
+}
+
+// newReloadMon creates the configuration reload metrics. The last reload time is set to the startup time.
+func newReloadMon(cfg Config) reloadMon {
+	m := reloadMon{}
+	constLabels := prometheus.Labels{"instance_name": cfg.InstanceName}
+	m.success = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace:   cfg.MetricPrefix,
+		Name:        "config_reload_success_total",
+		Help:        "Successful configuration reloads",
+		ConstLabels: constLabels,
+	})
+	m.errors = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace:   cfg.MetricPrefix,
+		Name:        "config_reload_errors_total",
+		Help:        "Failed configuration reloads. The previous configuration is kept active",
+		ConstLabels: constLabels,
+	})
+	m.lastReload = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace:   cfg.MetricPrefix,
+		Name:        "config_last_reload_timestamp_seconds",
+		Help:        "Timestamp of the last successful configuration load",
+		ConstLabels: constLabels,
+	})
+	m.lastReload.SetToCurrentTime()
+	return m
+}
+
+// register registers the reload metrics to the Prometheus default registry.
+func (m reloadMon) register() error {
+	for _, c := range []prometheus.Collector{m.success, m.errors, m.lastReload} {
+		if err := prometheus.Register(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReloadDone records the outcome of a configuration reload.
+func (p *promExporter) ReloadDone(err error) {
+	if err != nil {
+		p.reloadMon.errors.Inc()
+		return
+	}
+	p.reloadMon.success.Inc()
+	p.reloadMon.lastReload.SetToCurrentTime()
+}
+
+// CheckConfig verifies that cfg can be applied by Reconfigure.
+// Only the device related keys can change at runtime: the http server and the metric names are set up by Start.
+func (p *promExporter) CheckConfig(cfg Config) error {
+	if !reflect.DeepEqual(staticConfig(p.config), staticConfig(cfg)) {
+		return errors.New("global section changes require a restart")
+	}
+	return nil
+}
+
+// Reconfigure applies the device related keys of cfg and unregisters all the metric sources with
+// their descriptors. The caller is expected to register the sources of the new configuration.
+func (p *promExporter) Reconfigure(cfg Config) error {
+	if err := p.CheckConfig(cfg); err != nil {
+		return err
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.config.Devices = cfg.Devices
+	p.config.DevInstances = cfg.DevInstances
+	p.descLabels = make(map[string]descLabelSet)
+	for _, group := range p.groups {
+		group.descriptors = make(map[string]*prometheus.Desc)
+		group.sources = make(map[GMetricSource]bool)
+		group.snapshot, group.snapTime = nil, time.Time{}
+	}
+	return nil
+}
+
+// staticConfig returns a copy of cfg without the keys that can change at runtime.
+// Static labels are sorted, as their order follows the configuration map.
+func staticConfig(cfg Config) Config {
+	cfg.Devices = nil
+	cfg.DevInstances = nil
+	cfg.StaticLabels = slices.Clone(cfg.StaticLabels)
+	slices.SortFunc(cfg.StaticLabels, func(a, b StaticLabel) int { return strings.Compare(a.Key, b.Key) })
+	return cfg
+}
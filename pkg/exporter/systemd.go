@@ -0,0 +1,37 @@
+package exporter
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+)
+
+// sdListenFdsStart is the first file descriptor passed by systemd socket activation (SD_LISTEN_FDS_START).
+const sdListenFdsStart = 3
+
+// systemdListener returns the first listener passed by systemd socket activation.
+// It returns nil if the process has not been socket activated. The activation environment variables
+// are unset, so they are not inherited by child processes.
+func systemdListener() (net.Listener, error) {
+	defer func() {
+		_ = os.Unsetenv("LISTEN_PID")
+		_ = os.Unsetenv("LISTEN_FDS")
+		_ = os.Unsetenv("LISTEN_FDNAMES")
+	}()
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, nil
+	}
+	fds, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || fds < 1 {
+		return nil, nil
+	}
+	f := os.NewFile(uintptr(sdListenFdsStart), "systemd-socket")
+	defer func() { _ = f.Close() }()
+	l, err := net.FileListener(f)
+	if err != nil {
+		return nil, fmt.Errorf("cannot use the systemd socket: %w", err)
+	}
+	return l, nil
+}
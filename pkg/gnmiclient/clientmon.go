@@ -4,6 +4,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"reflect"
 	"sync"
+	"time"
 
 	// Local packages
 	"github.com/automixer/gtexporter/pkg/exporter"
@@ -16,43 +17,111 @@ import (
 // - Deletes: counter for the number of GNMI deletes received
 // - DialErrors: counter for the number of dial errors encountered
 // - CheckCapsErrors: counter for the number of capabilities check errors encountered
+// - CheckCapsRetries: counter for the number of transient capabilities check failures retried
 // - SubscribeErrors: counter for the number of subscribe errors encountered
 // - Disconnections: counter for the number of disconnections
 // - SrRoutingErrors: counter for the number of Subscribe Response messages routing errors
 type cmCounters struct {
-	Notifications   uint64 `label:"gnmi_notifications"`
-	Updates         uint64 `label:"gnmi_updates"`
-	Deletes         uint64 `label:"gnmi_deletes"`
-	DialErrors      uint64 `label:"dial_errors"`
-	CheckCapsErrors uint64 `label:"capabilities_errors"`
-	SubscribeErrors uint64 `label:"subscribe_errors"`
-	Disconnections  uint64 `label:"disconnections"`
-	SrRoutingErrors uint64 `label:"sr_routing_errors"`
+	Notifications    uint64 `label:"gnmi_notifications"`
+	Updates          uint64 `label:"gnmi_updates"`
+	Deletes          uint64 `label:"gnmi_deletes"`
+	DialErrors       uint64 `label:"dial_errors"`
+	CheckCapsErrors  uint64 `label:"capabilities_errors"`
+	CheckCapsRetries uint64 `label:"capabilities_retries"`
+	SubscribeErrors  uint64 `label:"subscribe_errors"`
+	Disconnections   uint64 `label:"disconnections"`
+	SrRoutingErrors  uint64 `label:"sr_routing_errors"`
 }
 
 // cmGauges represents the gauges of a client instance.
 // It includes the following fields:
 // - NfBufUsagePC: gauge for the percentage of fullness of notification buffer.
+// - SupportedModels: gauge for the number of YANG models supported by the device.
+// - DeviceUp: 1 if the gNMI stream is established or was lost since less than the down grace period, 0 otherwise.
 type cmGauges struct {
-	NfBufUsagePC uint64 `label:"notification_buf_usage_pc"`
+	NfBufUsagePC    uint64 `label:"notification_buf_usage_pc"`
+	SupportedModels uint64 `label:"supported_models"`
+	DeviceUp        uint64 `label:"device_up"`
+}
+
+// cmInfo represents the device capabilities received by a client instance, and its configured data mode.
+type cmInfo struct {
+	gnmiVersion string
+	encoding    string
+	mode        string // "cache" or "passthrough"
+}
+
+// cmHistogram represents the distribution of the number of updates per notification.
+type cmHistogram struct {
+	buckets []float64 // Bucket upper bounds, sorted. Nil if the histogram is disabled
+	counts  []uint64  // Observations per bucket (not cumulative)
+	count   uint64
+	sum     float64
+}
+
+// observe records the updates count of a notification.
+func (h *cmHistogram) observe(updates uint64) {
+	h.count++
+	h.sum += float64(updates)
+	for i, bound := range h.buckets {
+		if float64(updates) <= bound {
+			h.counts[i]++
+			return
+		}
+	}
+}
+
+// cumulative returns the cumulative count of observations by bucket upper bound.
+func (h *cmHistogram) cumulative() map[float64]uint64 {
+	out := make(map[float64]uint64, len(h.buckets))
+	var total uint64
+	for i, bound := range h.buckets {
+		total += h.counts[i]
+		out[bound] = total
+	}
+	return out
 }
 
 type clientMon struct {
-	devName  string
-	counters cmCounters
-	gauges   cmGauges
-	mutex    sync.Mutex
+	devName        string
+	counters       cmCounters
+	gauges         cmGauges
+	info           cmInfo
+	nfSizes        cmHistogram
+	sampleInterval float64       // Seconds. Zero until a SAMPLE mode subscription is sent
+	address        string        // Device address in use
+	online         bool          // The gNMI stream is established
+	offlineSince   time.Time     // Time the gNMI stream was lost. Zero if it was never established
+	downGrace      time.Duration // Disconnections shorter than this are not reported by the device_up gauge
+	mutex          sync.Mutex
 }
 
-// configure sets the device name and prepares metrics for registration.
-func (m *clientMon) configure(devName string) error {
+// configure sets the device name and data mode, and prepares metrics for registration.
+// If nfSizeBuckets is not empty, the histogram of the updates per notification is enabled.
+// If disabled is true, the metrics are not registered to the exporter.
+func (m *clientMon) configure(devName string, cacheMode, disabled bool, nfSizeBuckets []float64) error {
 	m.devName = devName
+	m.info.mode = "passthrough"
+	if cacheMode {
+		m.info.mode = "cache"
+	}
+	if disabled {
+		return nil
+	}
 	// Prepare metrics for registration
 	mList := []exporter.GMetric{
 		m.newMetric(prometheus.CounterValue),
 		m.newMetric(prometheus.GaugeValue),
+		m.newInfoMetric(),
+		m.newSampleIntMetric(),
+		m.newAddressMetric(),
 	}
-	return exporter.Registry(m, mList)
+	if len(nfSizeBuckets) > 0 {
+		m.nfSizes.buckets = nfSizeBuckets
+		m.nfSizes.counts = make([]uint64, len(nfSizeBuckets))
+		mList = append(mList, m.newNfSizeMetric())
+	}
+	return exporter.GroupRegistry(exporter.SelfMonGroup, m, mList)
 }
 
 // GetMetrics implements the exporter GMetricSource interface
@@ -71,6 +140,10 @@ func (m *clientMon) GetMetrics(ch chan<- exporter.GMetric) {
 		ch <- metric
 	}
 	// Gauges
+	m.gauges.DeviceUp = 0
+	if m.online || (!m.offlineSince.IsZero() && time.Since(m.offlineSince) < m.downGrace) {
+		m.gauges.DeviceUp = 1
+	}
 	rType = reflect.TypeOf(m.gauges)
 	rValue = reflect.ValueOf(m.gauges)
 	for i := 0; i < rType.NumField(); i++ {
@@ -81,6 +154,38 @@ func (m *clientMon) GetMetrics(ch chan<- exporter.GMetric) {
 	}
 	// Reset the nf buffer usage gauge
 	m.gauges.NfBufUsagePC = 0
+
+	// Device info. Only available after a successful capability exchange
+	if m.info.gnmiVersion != "" {
+		metric := m.newInfoMetric()
+		metric.GnmiVersion = m.info.gnmiVersion
+		metric.Encoding = m.info.encoding
+		metric.Mode = m.info.mode
+		ch <- metric
+	}
+
+	// Sample interval requested to the device. Only available after a SAMPLE mode subscription
+	if m.sampleInterval > 0 {
+		metric := m.newSampleIntMetric()
+		metric.Value = m.sampleInterval
+		ch <- metric
+	}
+
+	// Device address in use
+	if m.address != "" {
+		metric := m.newAddressMetric()
+		metric.Address = m.address
+		ch <- metric
+	}
+
+	// Updates per notification histogram
+	if m.nfSizes.buckets != nil {
+		metric := m.newNfSizeMetric()
+		metric.Histogram.Count = m.nfSizes.count
+		metric.Histogram.Sum = m.nfSizes.sum
+		metric.Histogram.Buckets = m.nfSizes.cumulative()
+		ch <- metric
+	}
 }
 
 func (m *clientMon) incNfCounters(upd, del uint64) {
@@ -89,6 +194,9 @@ func (m *clientMon) incNfCounters(upd, del uint64) {
 	m.counters.Notifications++
 	m.counters.Updates += upd
 	m.counters.Deletes += del
+	if m.nfSizes.buckets != nil {
+		m.nfSizes.observe(upd)
+	}
 }
 
 func (m *clientMon) incDialErrors() {
@@ -103,6 +211,12 @@ func (m *clientMon) incCheckCapsErrors() {
 	m.counters.CheckCapsErrors++
 }
 
+func (m *clientMon) incCheckCapsRetries() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.counters.CheckCapsRetries++
+}
+
 func (m *clientMon) incSubscribeErrors() {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
@@ -131,3 +245,36 @@ func (m *clientMon) srBufSize(size int) {
 		m.gauges.NfBufUsagePC = currValue
 	}
 }
+
+// setSampleInterval records the sample interval sent to the device with the subscription.
+func (m *clientMon) setSampleInterval(interval time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.sampleInterval = interval.Seconds()
+}
+
+// setOnline records whether the gNMI stream is established.
+func (m *clientMon) setOnline(online bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.online && !online {
+		m.offlineSince = time.Now()
+	}
+	m.online = online
+}
+
+// setAddress records the device address in use.
+func (m *clientMon) setAddress(address string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.address = address
+}
+
+// setCapabilities records the device capabilities and the negotiated encoding.
+func (m *clientMon) setCapabilities(gnmiVersion string, models int, encoding string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.info.gnmiVersion = gnmiVersion
+	m.info.encoding = encoding
+	m.gauges.SupportedModels = uint64(models)
+}
@@ -4,18 +4,25 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
-	log "github.com/golang/glog"
+	log "github.com/automixer/gtexporter/pkg/logger"
 	"github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/openconfig/ygot/ygot"
+	"golang.org/x/net/proxy"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"math"
+	"math/rand/v2"
 	"net"
+	"net/url"
 	"os"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -23,56 +30,108 @@ import (
 
 // Constants
 const (
-	timeoutMultiplier = 3
-	oversampling      = 2
-	srBufferSize      = 128
+	timeoutMultiplier   = 3
+	oversampling        = 2
+	srBufferSize        = 128
+	permanentErrBackoff = 5 * time.Minute
+	maxLifeJitterPC     = 10 // Max random delay added to MaxLife, as a percentage of MaxLife
+	capsMaxRetries      = 3  // Transient capabilities check failures retried on the same connection
+	capsRetryBackoff    = 2 * time.Second
+	maxUnroutedLogs     = 100 // Distinct unrouted destinations logged by log_unrouted_paths
+	addrMaxFailures     = 3   // Consecutive connection failures before switching to the next device address
 )
 
+// yangKeysRx matches the YANG keys of an xPath (e.g.: [name=eth0]).
+// Escaped characters inside key values (e.g.: \] as per gNMI path conventions) do not end the match.
+var yangKeysRx = regexp.MustCompile(`\[(?:[^\]\\]|\\.)*]`)
+
+// errNotSupported is returned when the device does not support a required feature.
+var errNotSupported = errors.New("not supported")
+
 type plugin interface {
 	GetPlugName() string
 	GetPathsToSubscribe() []string
+	GetPathMode(xPath string) (gnmi.SubscriptionMode, bool)
 	GetDataModel() string
+	GetEncoding() string
+	IsCacheMode() bool
 	OnSync(status bool)
 	Notification(nf *gnmi.Notification)
 }
 
 type Config struct {
-	IPAddress             string
+	Addresses             []string // Device addresses. The next one is tried on persistent connection failures
 	Port                  string
 	User                  string
 	Password              string
+	TokenFile             string // Bearer token file, read again when changed. Takes precedence over User and Password
 	TLS                   bool
 	TLSCert               string
 	TLSKey                string
 	TLSCa                 string
 	TLSInsecureSkipVerify bool
+	TLSServerName         string
+	MTLSAuth              bool // Authentication by TLS client certificate only: per RPC credentials are never sent
+	GrpcMetadata          map[string]string
+	GrpcUserAgent         string
+	Proxy                 string // SOCKS5 proxy URL (e.g.: socks5://host:port). Empty means direct dialing
+	GnmiTarget            string
 	ForceEncoding         string
 	DevName               string
 	ScrapeInterval        time.Duration
+	SampleInterval        time.Duration
 	MaxLife               time.Duration
 	GnmiSubscriptionMode  gnmi.SubscriptionMode
 	GnmiUpdatesOnly       bool
+	SuppressRedundant     bool
+	HeartbeatInterval     time.Duration
+	AllowAggregation      bool          // The device may aggregate the leaves marked as eligible into a single update
+	LogUnrouted           bool          // Log the schema path of unroutable notifications the first time it is seen
+	PathOrigin            string        // gNMI origin set on each subscription path (e.g.: openconfig)
+	DownGracePeriod       time.Duration // The device_up gauge goes to 0 only after a disconnection longer than this
+	HistorySnapshot       time.Time     // gNMI History extension snapshot time. Zero value means no extension
 	OverSampling          int64
 	Vendor                string
+	MaxFailures           int64
+	DisableSelfMon        bool
+	NfSizeBuckets         []float64 // Updates per notification histogram buckets. Nil disables the histogram
+	ReplayFile            string    // If set, subscribe responses are read from this file instead of the device
+	ReplayLoop            bool      // Replay the file every ScrapeInterval
 }
 
 // GnmiClient The gNMI client object
 type GnmiClient struct {
 	clientMon
 	config    Config
+	logger    log.Logger // Structured logging context: device name
 	shutdown  func()
 	encoding  gnmi.Encoding
-	plugins   map[string]plugin   // Map key: plugin name
-	xPathList map[string][]string // Map key: plugin name. Paths to be subscribed, including YANG keys filter
-	xPaths    map[string]plugin   // Map key: subscribed xPath (schema path used for routing subResponses)
-
+	plugEnc   map[string]gnmi.Encoding // Key: plugin name. Plugins overriding the device encoding
+	plugins   map[string]plugin        // Map key: plugin name
+	xPathList map[string][]string      // Map key: plugin name. Paths to be subscribed, including YANG keys filter
+	xPaths    map[string][]plugin      // Map key: subscribed xPath (schema path used for routing subResponses)
+	creds     *perRpcCreds
+	conn      *grpc.ClientConn   // Current gRPC connection
+	connStop  context.CancelFunc // Cancels the capabilities check context of the current connection
+	connMutex sync.Mutex
+	unrouted  map[string]bool // Key: unrouted schema path or target, already logged
+	lastErr   string          // Last permanent error
+	errCount  int64           // Consecutive permanent errors count
+	addrFails int             // Consecutive connection failures of the device address in use
 }
 
 // New Creates a new GnmiClient instance
 func New(cfg Config) (*GnmiClient, error) {
 	gClient := &GnmiClient{config: cfg}
+	gClient.logger = log.With("device", cfg.DevName)
 	gClient.xPathList = make(map[string][]string)
-	if err := gClient.clientMon.configure(cfg.DevName); err != nil {
+	provider := staticCreds(cfg.User, cfg.Password)
+	if cfg.TokenFile != "" {
+		provider = fileCreds(cfg.TokenFile)
+	}
+	gClient.creds = newPerRpcCreds(provider, cfg.TLS)
+	gClient.clientMon.downGrace = cfg.DownGracePeriod
+	if err := gClient.clientMon.configure(cfg.DevName, !cfg.GnmiUpdatesOnly, cfg.DisableSelfMon, cfg.NfSizeBuckets); err != nil {
 		return nil, err
 	}
 	return gClient, nil
@@ -86,6 +145,29 @@ func (c *GnmiClient) Close() {
 	}
 }
 
+// UpdateCredentials replaces the device access credentials and forces a reconnection.
+// The new credentials are used starting from the next RPC, without rebuilding the client.
+// They also replace the token_file credentials, if configured.
+func (c *GnmiClient) UpdateCredentials(user, pwd string) {
+	c.creds.update(user, pwd)
+	c.connMutex.Lock()
+	defer c.connMutex.Unlock()
+	if c.conn != nil {
+		c.logger.Info("Credentials updated, reconnecting...")
+		// As on max_life expiration, the worker must not wait for the capabilities check deadline
+		c.connStop()
+		_ = c.conn.Close()
+	}
+}
+
+// setConn stores the current gRPC connection and the cancel function of its capabilities check context.
+func (c *GnmiClient) setConn(conn *grpc.ClientConn, stop context.CancelFunc) {
+	c.connMutex.Lock()
+	defer c.connMutex.Unlock()
+	c.conn = conn
+	c.connStop = stop
+}
+
 // RegisterPlugin registers a plugin instance into the GnmiClient.
 func (c *GnmiClient) RegisterPlugin(name string, plug plugin) error {
 	if c.plugins == nil {
@@ -99,16 +181,18 @@ func (c *GnmiClient) RegisterPlugin(name string, plug plugin) error {
 	}
 
 	if c.xPaths == nil {
-		c.xPaths = make(map[string]plugin)
+		c.xPaths = make(map[string][]plugin)
 	}
 
 	plugPaths := plug.GetPathsToSubscribe()
-	re := regexp.MustCompile(`\[.*?]`)
 	for _, reqPath := range plugPaths {
 		c.xPathList[name] = append(c.xPathList[name], reqPath)
 		// Remove keys from YANG path
-		reqPath = re.ReplaceAllString(reqPath, "")
-		c.xPaths[reqPath] = plug
+		reqPath = yangKeysRx.ReplaceAllString(reqPath, "")
+		// Several plugin instances can share the same xPath
+		if !slices.Contains(c.xPaths[reqPath], plug) {
+			c.xPaths[reqPath] = append(c.xPaths[reqPath], plug)
+		}
 	}
 
 	c.plugins[name] = plug
@@ -122,7 +206,11 @@ func (c *GnmiClient) Start() error {
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
-		c.run(gCtx)
+		if c.config.ReplayFile != "" {
+			c.replay(gCtx)
+		} else {
+			c.run(gCtx)
+		}
 		wg.Done()
 	}()
 	c.shutdown = func() {
@@ -139,6 +227,8 @@ func (c *GnmiClient) Start() error {
 // - Setting the backoff and minimum connect timeout values
 // - Configuring TLS for secure connections
 // - Setting device access credentials per RPC
+// - Setting custom gRPC metadata and user-agent
+// - Dialing through a SOCKS5 proxy
 func (c *GnmiClient) newDialOptions() ([]grpc.DialOption, error) {
 	opts := make([]grpc.DialOption, 0)
 	opts = append(opts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32)))
@@ -166,18 +256,26 @@ func (c *GnmiClient) newDialOptions() ([]grpc.DialOption, error) {
 			}
 
 			if ok := rootCAs.AppendCertsFromPEM(ca); !ok {
-				return nil, fmt.Errorf("<%s>: cannot load CA certificate file", c.config.DevName)
+				return nil, errors.New("cannot load CA certificate file")
 			}
 		}
 
-		cert, err := tls.LoadX509KeyPair(c.config.TLSCert, c.config.TLSKey)
-		if err != nil {
-			return nil, err
-		}
 		tlsCfg := &tls.Config{
-			Certificates:       []tls.Certificate{cert},
 			RootCAs:            rootCAs,
 			InsecureSkipVerify: c.config.TLSInsecureSkipVerify,
+			ServerName:         c.config.TLSServerName,
+		}
+
+		// Client certificate. Optional: server-only authentication if both cert and key are missing
+		switch {
+		case c.config.TLSCert != "" && c.config.TLSKey != "":
+			cert, err := tls.LoadX509KeyPair(c.config.TLSCert, c.config.TLSKey)
+			if err != nil {
+				return nil, err
+			}
+			tlsCfg.Certificates = []tls.Certificate{cert}
+		case c.config.TLSCert != "" || c.config.TLSKey != "":
+			return nil, errors.New("tls_cert and tls_key must be set together")
 		}
 		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
 	} else {
@@ -185,20 +283,65 @@ func (c *GnmiClient) newDialOptions() ([]grpc.DialOption, error) {
 		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	}
 
-	// Device access credentials (per RPC)
-	if c.config.User != "" && c.config.Password != "" {
-		var secure bool
-		if c.config.TLS {
-			secure = true
-		} else {
-			secure = false
+	// Device access credentials (per RPC). Always set, so that they can be updated at runtime.
+	// With mTLS authentication they are never attached, even if user and password are configured
+	if !c.config.MTLSAuth {
+		opts = append(opts, grpc.WithPerRPCCredentials(c.creds))
+	}
+
+	// Custom gRPC metadata and user-agent
+	if len(c.config.GrpcMetadata) > 0 {
+		opts = append(opts, grpc.WithPerRPCCredentials(&metadataCreds{md: c.config.GrpcMetadata}))
+	}
+	if c.config.GrpcUserAgent != "" {
+		opts = append(opts, grpc.WithUserAgent(c.config.GrpcUserAgent))
+	}
+
+	// Proxy
+	if c.config.Proxy != "" {
+		dialer, err := newProxyDialer(c.config.Proxy)
+		if err != nil {
+			return nil, err
 		}
-		devCreds := newPerRpcCreds(c.config.User, c.config.Password, secure)
-		opts = append(opts, grpc.WithPerRPCCredentials(devCreds))
+		opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "tcp", addr)
+		}))
 	}
 	return opts, nil
 }
 
+// CheckProxy returns an error if the proxy URL is invalid or its scheme is not supported.
+func CheckProxy(proxyURL string) error {
+	_, err := newProxyDialer(proxyURL)
+	return err
+}
+
+// newProxyDialer returns a dialer routing the TCP connections through the given proxy URL.
+// Supported schemes are socks5 and socks5h. User and password can be set into the URL.
+func newProxyDialer(proxyURL string) (proxy.ContextDialer, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", err)
+	}
+	switch u.Scheme {
+	case "socks5", "socks5h":
+	default:
+		return nil, fmt.Errorf("the proxy scheme %q is %w", u.Scheme, errNotSupported)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("proxy URL %s has no host", proxyURL)
+	}
+	dialer, err := proxy.FromURL(u, proxy.Direct)
+	if err != nil {
+		return nil, err
+	}
+	ctxDialer, ok := dialer.(proxy.ContextDialer)
+	if !ok {
+		return nil, fmt.Errorf("the proxy scheme %q is %w", u.Scheme, errNotSupported)
+	}
+	return ctxDialer, nil
+}
+
 // checkCapabilities verifies if the target device supports the required datamodels and encodings.
 // The capabilities include:
 // - Check if the required datamodel is supported
@@ -216,8 +359,12 @@ func (c *GnmiClient) checkCapabilities(ctx context.Context, stub gnmi.GNMIClient
 	}
 	for _, plug := range c.plugins {
 		reqModel := plug.GetDataModel()
+		if reqModel == "" {
+			// The plugin does not require a specific datamodel
+			continue
+		}
 		if _, ok := supportedModels[reqModel]; !ok {
-			return fmt.Errorf("the yang model <%s> is not supported by %s", reqModel, c.config.DevName)
+			return fmt.Errorf("the yang model <%s> is %w by the device", reqModel, errNotSupported)
 		}
 	}
 
@@ -227,64 +374,102 @@ func (c *GnmiClient) checkCapabilities(ctx context.Context, stub gnmi.GNMIClient
 	// Override if required
 	if c.config.ForceEncoding != "" {
 		// Config enforces encoding
-		c.config.ForceEncoding = strings.ToUpper(c.config.ForceEncoding)
-		switch c.config.ForceEncoding {
-		case "JSON":
-			c.encoding = gnmi.Encoding_JSON
-		case "BYTES":
-			c.encoding = gnmi.Encoding_BYTES
-		case "PROTO":
-			c.encoding = gnmi.Encoding_PROTO
-		case "ASCII":
-			c.encoding = gnmi.Encoding_ASCII
-		case "JSON_IETF":
-			c.encoding = gnmi.Encoding_JSON_IETF
-		default:
-			return fmt.Errorf("the encoding %s is not supported by gNMI", c.config.ForceEncoding)
+		if c.encoding, err = parseEncoding(c.config.ForceEncoding); err != nil {
+			return err
+		}
+	}
+
+	// Per plugin encodings
+	c.plugEnc = make(map[string]gnmi.Encoding)
+	for name, plug := range c.plugins {
+		if plug.GetEncoding() == "" {
+			continue
+		}
+		if c.plugEnc[name], err = parseEncoding(plug.GetEncoding()); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
 		}
 	}
+	c.setCapabilities(caps.GetGNMIVersion(), len(caps.GetSupportedModels()), c.encoding.String())
 	return nil
 }
 
-// receive takes care of receiving the GNMI stream from the device
-func (c *GnmiClient) receive(sub gnmi.GNMI_SubscribeClient) error {
-	ch := make(chan *gnmi.SubscribeResponse, srBufferSize)
-	done := make(chan struct{})
-	var err error
-	var sr *gnmi.SubscribeResponse
+// parseEncoding converts an encoding name (e.g.: json_ietf) into its gNMI value.
+func parseEncoding(name string) (gnmi.Encoding, error) {
+	switch strings.ToUpper(name) {
+	case "JSON":
+		return gnmi.Encoding_JSON, nil
+	case "BYTES":
+		return gnmi.Encoding_BYTES, nil
+	case "PROTO":
+		return gnmi.Encoding_PROTO, nil
+	case "ASCII":
+		return gnmi.Encoding_ASCII, nil
+	case "JSON_IETF":
+		return gnmi.Encoding_JSON_IETF, nil
+	default:
+		return gnmi.Encoding_PROTO, fmt.Errorf("the encoding %s is %w by gNMI", name, errNotSupported)
+	}
+}
 
-	go func() {
-		for {
-			sr, err = sub.Recv()
-			if err != nil {
-				close(done)
-				close(ch)
-				return
+// receive takes care of receiving the gNMI streams from the device.
+// It returns when one of the streams fails or ctx is canceled. Streams still running are released by canceling
+// their own context.
+func (c *GnmiClient) receive(ctx context.Context, subs []subStream) error {
+	type streamMsg struct {
+		sr      *gnmi.SubscribeResponse
+		plugins map[string]plugin
+	}
+	ch := make(chan streamMsg, srBufferSize)
+	errCh := make(chan error, len(subs))
+	done := make(chan struct{})
+	defer close(done)
+
+	for _, sub := range subs {
+		go func() {
+			for {
+				sr, err := sub.sub.Recv()
+				if err != nil {
+					errCh <- err
+					return
+				}
+				select {
+				case ch <- streamMsg{sr: sr, plugins: sub.plugins}:
+					c.srBufSize(len(ch))
+				case <-done:
+					return
+				}
 			}
-			ch <- sr
-			c.srBufSize(len(ch))
-		}
-	}()
+		}()
+	}
 
+	offline := func() {
+		for _, plug := range c.plugins {
+			plug.OnSync(false)
+		}
+	}
 	for {
 		select {
-		case <-done:
-			<-ch
-			for _, plug := range c.plugins {
-				plug.OnSync(false)
-			}
+		case <-ctx.Done():
+			offline()
+			return ctx.Err()
+		case err := <-errCh:
+			offline()
 			return err
 		case msg := <-ch:
-			c.routeSr(msg)
+			// The device is responsive: errors are no longer consecutive
+			c.lastErr = ""
+			c.errCount = 0
+			c.routeSr(msg.sr, msg.plugins)
 		}
 	}
 }
 
-// routeSr examines the subscribe response paths metadata and sends the SR object to the related plugin
-func (c *GnmiClient) routeSr(sr *gnmi.SubscribeResponse) {
+// routeSr examines the subscribe response paths metadata and sends the SR object to the related plugin.
+// Only the given plugins, those subscribed by the stream the response was received from, are considered.
+func (c *GnmiClient) routeSr(sr *gnmi.SubscribeResponse, plugins map[string]plugin) {
 	// Sync response
 	if sr.GetSyncResponse() {
-		for _, plug := range c.plugins {
+		for _, plug := range plugins {
 			plug.OnSync(true)
 		}
 		return
@@ -292,19 +477,28 @@ func (c *GnmiClient) routeSr(sr *gnmi.SubscribeResponse) {
 
 	// Notification
 	nf := sr.GetUpdate() // Beware! GetUpdate() actually returns a notification, not an Update :-(
+	if len(nf.GetUpdate()) == 0 && len(nf.GetDelete()) == 0 {
+		// Empty notification. Nothing to route
+		return
+	}
 	c.incNfCounters(uint64(len(nf.GetUpdate())), uint64(len(nf.GetDelete())))
-	if nf.GetPrefix().GetTarget() != "" {
+	// Target matching contract:
+	// - no target, or the configured subscription target: notifications are routed by xPath prefix
+	// - a plugin instance name: notifications are routed to that plugin
+	// - any other target: routing error
+	if target := nf.GetPrefix().GetTarget(); target != "" && target != c.config.GnmiTarget {
 		// Huawei specific
 		if c.config.Vendor == "huawei" {
 			c.removeDmPfxFromPath(nf)
 		}
 		// Normal messages routing
-		if _, ok := c.plugins[nf.Prefix.Target]; !ok {
+		if _, ok := plugins[nf.Prefix.Target]; !ok {
 			// Unknown destination
 			c.incSrRoutingErrors()
+			c.logUnrouted("target " + nf.Prefix.Target)
 			return
 		}
-		c.plugins[nf.Prefix.Target].Notification(nf)
+		plugins[nf.Prefix.Target].Notification(nf)
 	} else {
 		// Huawei specific
 		if c.config.Vendor == "huawei" {
@@ -318,32 +512,109 @@ func (c *GnmiClient) routeSr(sr *gnmi.SubscribeResponse) {
 			pfx = ""
 		}
 
-		// Search for Updates
-		for _, upd := range nf.GetUpdate() {
-			path, _ := ygot.PathToSchemaPath(upd.Path)
-			fullPath := pfx + path
-			for xPath, plug := range c.xPaths {
-				if strings.HasPrefix(fullPath, xPath) {
-					plug.Notification(nf)
-					return
+		if !c.routeByPath(nf, pfx, plugins) {
+			// Unknown destination
+			// Sr response error field is deprecated and not handled
+			c.incSrRoutingErrors()
+			if c.config.LogUnrouted {
+				for _, upd := range nf.GetUpdate() {
+					path, _ := ygot.PathToSchemaPath(upd.GetPath())
+					c.logUnrouted("path " + pfx + path)
+				}
+				for _, delPath := range nf.GetDelete() {
+					path, _ := ygot.PathToSchemaPath(delPath)
+					c.logUnrouted("delete path " + pfx + path)
 				}
 			}
 		}
-		// Search for Deletes
-		for _, delPath := range nf.GetDelete() {
-			path, _ := ygot.PathToSchemaPath(delPath)
-			fullDelPath := pfx + path
-			for xPath, plug := range c.xPaths {
-				if strings.HasPrefix(fullDelPath, xPath) {
-					plug.Notification(nf)
-					return
+	}
+}
+
+// logUnrouted logs an unroutable notification destination, if the log_unrouted_paths key is set.
+// Each destination is logged once. After maxUnroutedLogs destinations, logging stops.
+func (c *GnmiClient) logUnrouted(dest string) {
+	if !c.config.LogUnrouted || c.unrouted[dest] {
+		return
+	}
+	if c.unrouted == nil {
+		c.unrouted = make(map[string]bool)
+	}
+	if len(c.unrouted) >= maxUnroutedLogs {
+		return
+	}
+	c.unrouted[dest] = true
+	c.logger.Infof("notification dropped, no plugin subscribed to %s", dest)
+	if len(c.unrouted) == maxUnroutedLogs {
+		c.logger.Infof("%d unrouted destinations logged, further ones are only counted", maxUnroutedLogs)
+	}
+}
+
+// routeByPath sends the notification to the plugins subscribed to its update and delete paths.
+// Usually all the paths of a notification belong to the same subscription and the notification is routed as is.
+// Aggregated notifications (allow_aggregation) can carry the paths of several subscriptions: in that case each
+// plugin receives a copy holding only its own updates and deletes. Plugins not in the given set are skipped.
+// It returns false if no path could be routed.
+func (c *GnmiClient) routeByPath(nf *gnmi.Notification, pfx string, plugins map[string]plugin) bool {
+	var dest []plugin                          // Destination plugins, in order of appearance
+	updates := make(map[plugin][]*gnmi.Update) // Key: destination plugin
+	deletes := make(map[plugin][]*gnmi.Path)   // Key: destination plugin
+	match := func(path *gnmi.Path) []plugin {
+		sPath, _ := ygot.PathToSchemaPath(path)
+		fullPath := pfx + sPath
+		var out []plugin
+		for xPath, plugs := range c.xPaths {
+			if !strings.HasPrefix(fullPath, xPath) {
+				continue
+			}
+			for _, plug := range plugs {
+				if plugins[plug.GetPlugName()] == plug {
+					out = append(out, plug)
+				}
+			}
+			if len(out) > 0 {
+				return out
+			}
+		}
+		return nil
+	}
+	add := func(plugs []plugin) {
+		for _, plug := range plugs {
+			if _, ok := updates[plug]; !ok {
+				if _, ok = deletes[plug]; !ok {
+					dest = append(dest, plug)
 				}
 			}
 		}
-		// Unknown destination
-		// Sr response error field is deprecated and not handled
-		c.incSrRoutingErrors()
 	}
+	for _, upd := range nf.GetUpdate() {
+		plugs := match(upd.GetPath())
+		add(plugs)
+		for _, plug := range plugs {
+			updates[plug] = append(updates[plug], upd)
+		}
+	}
+	for _, delPath := range nf.GetDelete() {
+		plugs := match(delPath)
+		add(plugs)
+		for _, plug := range plugs {
+			deletes[plug] = append(deletes[plug], delPath)
+		}
+	}
+	for _, plug := range dest {
+		if len(updates[plug]) == len(nf.GetUpdate()) && len(deletes[plug]) == len(nf.GetDelete()) {
+			// The whole notification belongs to this plugin
+			plug.Notification(nf)
+			continue
+		}
+		plug.Notification(&gnmi.Notification{
+			Timestamp: nf.GetTimestamp(),
+			Prefix:    nf.GetPrefix(),
+			Update:    updates[plug],
+			Delete:    deletes[plug],
+			Atomic:    nf.GetAtomic(),
+		})
+	}
+	return len(dest) > 0
 }
 
 // removeDmPfxFromPath sanitizes the prefix, updates, and deletes paths in the given
@@ -380,6 +651,123 @@ func (c *GnmiClient) removeDmPfxFromPath(nf *gnmi.Notification) {
 	}
 }
 
+// checkCapabilitiesRetry runs checkCapabilities, retrying transient failures (e.g.: a device still booting) on the
+// same connection up to capsMaxRetries times, with an exponential backoff, within the deadline of ctx.
+// Permanent errors are returned immediately.
+func (c *GnmiClient) checkCapabilitiesRetry(ctx context.Context, stub gnmi.GNMIClient) error {
+	backoff := capsRetryBackoff
+	for retry := 0; ; retry++ {
+		err := c.checkCapabilities(ctx, stub)
+		if err == nil || isPermanentError(err) || retry == capsMaxRetries {
+			return err
+		}
+		c.logger.Infof("capabilities check failed, retrying in %s: %s", backoff, err)
+		c.incCheckCapsRetries()
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// dialTarget returns the gRPC target of the given device address.
+// Device names are resolved by the proxy, if any.
+func (c *GnmiClient) dialTarget(address string) string {
+	hostPort := net.JoinHostPort(address, c.config.Port)
+	switch {
+	case net.ParseIP(address) != nil:
+		return hostPort
+	case c.config.Proxy != "":
+		return "passthrough:///" + hostPort
+	default:
+		return "dns:///" + hostPort
+	}
+}
+
+// onAddressFailure counts the consecutive connection failures of the device address at index.
+// It returns the index of the address to be used next: the same one, until addrMaxFailures is reached.
+func (c *GnmiClient) onAddressFailure(index int) int {
+	c.addrFails++
+	if c.addrFails < addrMaxFailures {
+		return index
+	}
+	c.addrFails = 0
+	return c.nextAddress(index)
+}
+
+// nextAddress returns the index of the device address to be used after the one at index.
+// Addresses are tried in a round-robin fashion.
+func (c *GnmiClient) nextAddress(index int) int {
+	if len(c.config.Addresses) < 2 {
+		return index
+	}
+	next := (index + 1) % len(c.config.Addresses)
+	c.logger.Warningf("%s is unreachable, switching to %s", c.config.Addresses[index], c.config.Addresses[next])
+	return next
+}
+
+// isConnectionError returns true if err means that the device could not be reached.
+func isConnectionError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// isPermanentError reports whether the given error is unlikely to be solved by retrying.
+// (e.g.: authentication failures, unsupported models or RPCs)
+func isPermanentError(err error) bool {
+	if errors.Is(err, errNotSupported) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unauthenticated, codes.PermissionDenied, codes.Unimplemented:
+		return true
+	default:
+		return false
+	}
+}
+
+// onError keeps track of consecutive identical permanent errors. Permanent errors delay the next retry by
+// permanentErrBackoff. It returns true if the device has to be disabled because MaxFailures has been reached.
+func (c *GnmiClient) onError(ctx context.Context, err error) bool {
+	if !isPermanentError(err) {
+		c.lastErr = ""
+		c.errCount = 0
+		return false
+	}
+	if err.Error() == c.lastErr {
+		c.errCount++
+	} else {
+		c.lastErr = err.Error()
+		c.errCount = 1
+	}
+	if c.config.MaxFailures > 0 && c.errCount >= c.config.MaxFailures {
+		c.logger.Errorf("%d consecutive unrecoverable errors: %s", c.errCount, err)
+		c.logger.Error("Device has been disabled...")
+		return true
+	}
+	c.logger.Warningf("unrecoverable error, retrying in %s: %s", permanentErrBackoff, err)
+	select {
+	case <-ctx.Done():
+	case <-time.After(permanentErrBackoff):
+	}
+	return false
+}
+
+// maxLifeWithJitter returns the configured MaxLife plus a random delay of up to maxLifeJitterPC percent.
+func (c *GnmiClient) maxLifeWithJitter() time.Duration {
+	jitter := int64(c.config.MaxLife) * maxLifeJitterPC / 100
+	if jitter <= 0 {
+		return c.config.MaxLife
+	}
+	return c.config.MaxLife + time.Duration(rand.Int64N(jitter))
+}
+
 // run is the main loop for gNMI worker thread. It establishes a connection to the target
 // device using the specified dial options, checks the device capabilities, subscribes to
 // gNMI telemetry, and continuously receives the gNMI stream. It runs until the context is
@@ -389,7 +777,7 @@ func (c *GnmiClient) run(ctx context.Context) {
 	var dialOpts []grpc.DialOption
 	var err error
 	var stub gnmi.GNMIClient
-	var sub gnmi.GNMI_SubscribeClient
+	var subs []subStream
 	var gCtx context.Context
 	var gCtxCancelFunc func()
 	var maxLifeExpired bool
@@ -398,22 +786,17 @@ func (c *GnmiClient) run(ctx context.Context) {
 	// Setup dial options
 	dialOpts, err = c.newDialOptions()
 	if err != nil {
-		log.Error(err)
-		log.Errorf("Device %s has been disabled...", c.config.DevName)
+		c.logger.Error(err)
+		c.logger.Error("Device has been disabled...")
 		return
 	}
 
-	// Setup target ip address
-	var targetDev string
-	if net.ParseIP(c.config.IPAddress) != nil {
-		targetDev = fmt.Sprintf("%s:%s", c.config.IPAddress, c.config.Port)
-	} else {
-		targetDev = fmt.Sprintf("dns:///%s:%s", c.config.IPAddress, c.config.Port)
-	}
+	// Device address in use. Rotated on persistent connection failures
+	addrIndex := 0
 
-	// Setup session TTL timer
+	// Setup session TTL timer. Jitter avoids synchronized reconnects among devices
 	if c.config.MaxLife != 0 {
-		sessionTimer = time.AfterFunc(c.config.MaxLife, func() {
+		sessionTimer = time.AfterFunc(c.maxLifeWithJitter(), func() {
 			if gCtx != nil && conn != nil {
 				gCtxCancelFunc()
 				gCtx = nil
@@ -421,7 +804,7 @@ func (c *GnmiClient) run(ctx context.Context) {
 				maxLifeExpired = true
 				_ = conn.Close()
 			}
-			sessionTimer.Reset(c.config.MaxLife)
+			sessionTimer.Reset(c.maxLifeWithJitter())
 		})
 	}
 
@@ -435,9 +818,6 @@ func (c *GnmiClient) run(ctx context.Context) {
 		}
 		// Time to exit?
 		if ctx.Err() != nil {
-			if sessionTimer != nil {
-				sessionTimer.Stop()
-			}
 			break
 		}
 		// Reconnecting after MaxLife expired?
@@ -447,11 +827,14 @@ func (c *GnmiClient) run(ctx context.Context) {
 		}
 
 		// Dial
-		log.Infof("Dialing %s...", c.config.DevName)
-		conn, err = grpc.NewClient(targetDev, dialOpts...)
+		address := c.config.Addresses[addrIndex]
+		c.setAddress(address)
+		c.logger.Infof("Dialing %s...", address)
+		conn, err = grpc.NewClient(c.dialTarget(address), dialOpts...)
 		if err != nil {
-			log.Info(err)
+			c.logger.Info(err)
 			c.incDialErrors()
+			addrIndex = c.onAddressFailure(addrIndex)
 			continue
 		}
 		stub = gnmi.NewGNMIClient(conn)
@@ -462,27 +845,59 @@ func (c *GnmiClient) run(ctx context.Context) {
 			timeout = time.Minute * 5
 		}
 		gCtx, gCtxCancelFunc = context.WithTimeout(ctx, timeout)
-		log.Infof("Checking %s capabilities...", c.config.DevName)
-		if err = c.checkCapabilities(gCtx, stub); err != nil {
-			log.Info(err)
+		c.setConn(conn, gCtxCancelFunc)
+		c.logger.Info("Checking capabilities...")
+		if err = c.checkCapabilitiesRetry(gCtx, stub); err != nil {
+			c.logger.Info(err)
 			c.incCheckCapsErrors()
+			if isConnectionError(err) {
+				addrIndex = c.onAddressFailure(addrIndex)
+			}
+			if c.onError(ctx, err) {
+				break
+			}
 			continue
 		}
 
-		// Subscribe
-		log.Infof("Subscribing gNMI telemetries to %s...", c.config.DevName)
-		sub, err = c.subscribe(ctx, stub)
+		// Subscribe. Streams are released by subCancel when done
+		c.logger.Info("Subscribing gNMI telemetries...")
+		subCtx, subCancel := context.WithCancel(ctx)
+		subs, err = c.subscribe(subCtx, stub)
 		if err != nil {
-			log.Info(err)
+			subCancel()
+			c.logger.Info(err)
 			c.incSubscribeErrors()
+			if c.onError(ctx, err) {
+				break
+			}
 			continue
 		}
 
-		// Receive gNMI stream (blocking)
-		log.Infof("Device %s is now online...", c.config.DevName)
-		if err = c.receive(sub); err != nil {
-			log.Error(err)
+		// Receive gNMI streams (blocking)
+		c.logger.Info("Device is now online...")
+		c.addrFails = 0
+		c.setOnline(true)
+		err = c.receive(subCtx, subs)
+		subCancel()
+		c.setOnline(false)
+		if err != nil {
+			c.logger.Error(err)
 			c.incDisconnections()
+			if c.onError(ctx, err) {
+				break
+			}
 		}
 	}
+
+	// Cleanup
+	if sessionTimer != nil {
+		sessionTimer.Stop()
+	}
+	if gCtxCancelFunc != nil {
+		gCtxCancelFunc()
+	}
+	if conn != nil {
+		_ = conn.Close()
+	}
+	c.setConn(nil, nil)
 }
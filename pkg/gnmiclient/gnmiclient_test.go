@@ -0,0 +1,397 @@
+package gnmiclient
+
+import (
+	"context"
+	"errors"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"maps"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakePlugin records the notifications and sync events routed to it.
+type fakePlugin struct {
+	name        string
+	paths       []string
+	passthrough bool
+	mutex       sync.Mutex
+	nfs         []*gnmi.Notification
+	syncs       []bool
+}
+
+func (p *fakePlugin) GetPlugName() string                              { return p.name }
+func (p *fakePlugin) GetPathsToSubscribe() []string                    { return p.paths }
+func (p *fakePlugin) GetPathMode(string) (gnmi.SubscriptionMode, bool) { return 0, false }
+func (p *fakePlugin) GetDataModel() string                             { return "" }
+func (p *fakePlugin) GetEncoding() string                              { return "" }
+func (p *fakePlugin) IsCacheMode() bool                                { return !p.passthrough }
+
+func (p *fakePlugin) OnSync(status bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.syncs = append(p.syncs, status)
+}
+
+func (p *fakePlugin) Notification(nf *gnmi.Notification) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.nfs = append(p.nfs, nf)
+}
+
+func (p *fakePlugin) notifications() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return len(p.nfs)
+}
+
+func (p *fakePlugin) syncEvents() []bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return slices.Clone(p.syncs)
+}
+
+// fakeStream is a Subscribe stream returning the queued responses, then blocking until its context is canceled.
+type fakeStream struct {
+	grpc.ClientStream
+	ctx context.Context
+	srs chan *gnmi.SubscribeResponse
+}
+
+func (s *fakeStream) Send(*gnmi.SubscribeRequest) error { return nil }
+
+func (s *fakeStream) Recv() (*gnmi.SubscribeResponse, error) {
+	select {
+	case sr := <-s.srs:
+		return sr, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+// fakeTarget is a gNMI server recording the RPCs received, along with their username metadata.
+// Subscribe streams are kept open until the client leaves.
+type fakeTarget struct {
+	gnmi.UnimplementedGNMIServer
+	rpcs chan string
+}
+
+func (s *fakeTarget) Capabilities(ctx context.Context, _ *gnmi.CapabilityRequest) (*gnmi.CapabilityResponse, error) {
+	s.rpcs <- "capabilities:" + rpcUser(ctx)
+	return &gnmi.CapabilityResponse{}, nil
+}
+
+func (s *fakeTarget) Subscribe(stream gnmi.GNMI_SubscribeServer) error {
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+	s.rpcs <- "subscribe:" + rpcUser(stream.Context())
+	<-stream.Context().Done()
+	return nil
+}
+
+// rpcUser returns the username metadata of an RPC.
+func rpcUser(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return strings.Join(md.Get("username"), ",")
+}
+
+// newTestClient returns a GnmiClient without self-monitoring, with a fake plugin subscribed to the given paths.
+func newTestClient(t *testing.T, paths ...string) (*GnmiClient, *fakePlugin) {
+	t.Helper()
+	c, err := New(Config{DevName: "dev1", DisableSelfMon: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	plug := &fakePlugin{name: "plug1", paths: paths}
+	if err = c.RegisterPlugin(plug.name, plug); err != nil {
+		t.Fatal(err)
+	}
+	return c, plug
+}
+
+// mustPath converts an xPath into a gNMI path.
+func mustPath(t *testing.T, xPath string) *gnmi.Path {
+	t.Helper()
+	p, err := ygot.StringToStructuredPath(xPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestRouteSrEmptyNotification(t *testing.T) {
+	c, plug := newTestClient(t, "/interfaces/interface/state")
+	tests := []struct {
+		name string
+		sr   *gnmi.SubscribeResponse
+	}{
+		{
+			name: "no response",
+			sr:   &gnmi.SubscribeResponse{},
+		},
+		{
+			name: "nil notification",
+			sr:   &gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{}},
+		},
+		{
+			name: "empty notification",
+			sr:   &gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{}}},
+		},
+		{
+			name: "prefix only",
+			sr: &gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{
+				Prefix: mustPath(t, "/interfaces/interface[name=eth0]/state"),
+			}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c.routeSr(tt.sr, c.plugins)
+			if n := plug.notifications(); n != 0 {
+				t.Errorf("%d notifications routed, want 0", n)
+			}
+			if c.counters.SrRoutingErrors != 0 || c.counters.Notifications != 0 {
+				t.Errorf("empty notification counted: %+v", c.counters)
+			}
+		})
+	}
+}
+
+func TestRouteSrNilUpdate(t *testing.T) {
+	c, plug := newTestClient(t, "/interfaces/interface/state")
+	c.routeSr(&gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{
+		Update: []*gnmi.Update{nil},
+	}}}, c.plugins)
+	if n := plug.notifications(); n != 0 {
+		t.Errorf("%d notifications routed, want 0", n)
+	}
+	if c.counters.SrRoutingErrors != 1 {
+		t.Errorf("sr_routing_errors is %d, want 1", c.counters.SrRoutingErrors)
+	}
+}
+
+func TestRouteSrNotification(t *testing.T) {
+	c, plug := newTestClient(t, "/interfaces/interface/state")
+	c.routeSr(&gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{
+		Prefix: mustPath(t, "/interfaces/interface[name=eth0]/state"),
+		Update: []*gnmi.Update{{
+			Path: mustPath(t, "/counters/in-octets"),
+			Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 10}},
+		}},
+	}}}, c.plugins)
+	if n := plug.notifications(); n != 1 {
+		t.Errorf("%d notifications routed, want 1", n)
+	}
+}
+
+func TestReceiveCancel(t *testing.T) {
+	c, plug := newTestClient(t, "/interfaces/interface/state")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &fakeStream{ctx: ctx, srs: make(chan *gnmi.SubscribeResponse, 1)}
+	stream.srs <- &gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_SyncResponse{SyncResponse: true}}
+
+	result := make(chan error)
+	go func() {
+		result <- c.receive(ctx, []subStream{{sub: stream, plugins: c.plugins}})
+	}()
+
+	// Cancel in the middle of the subscription, once the sync response has been routed
+	deadline := time.Now().Add(5 * time.Second)
+	for len(plug.syncEvents()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("sync response not routed")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+
+	select {
+	case err := <-result:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("receive returned %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("receive did not return after cancel")
+	}
+	if got := plug.syncEvents(); !slices.Equal(got, []bool{true, false}) {
+		t.Errorf("sync events %v, want [true false]", got)
+	}
+}
+
+func TestYangKeysStripping(t *testing.T) {
+	tests := []struct {
+		name  string
+		xPath string
+		want  string
+	}{
+		{
+			name:  "no keys",
+			xPath: "/interfaces/interface/state",
+			want:  "/interfaces/interface/state",
+		},
+		{
+			name:  "single key",
+			xPath: "/interfaces/interface[name=eth0]/state",
+			want:  "/interfaces/interface/state",
+		},
+		{
+			name:  "multiple keys",
+			xPath: "/network-instances/network-instance[name=default]/protocols/protocol[identifier=BGP][name=bgp]/state",
+			want:  "/network-instances/network-instance/protocols/protocol/state",
+		},
+		{
+			name:  "adjacent keys",
+			xPath: "/a/b[a=1][b=2]/c",
+			want:  "/a/b/c",
+		},
+		{
+			name:  "escaped bracket",
+			xPath: `/interfaces/interface[name=eth\]0]/state`,
+			want:  "/interfaces/interface/state",
+		},
+		{
+			name:  "escaped brackets in multiple keys",
+			xPath: `/a/b[a=\[1\]][b=x\]y]/c[d=\\]/e`,
+			want:  "/a/b/c/e",
+		},
+		{
+			name:  "special characters",
+			xPath: "/interfaces/interface[name=Ethernet1/1.100]/subinterfaces/subinterface[index=*]/state",
+			want:  "/interfaces/interface/subinterfaces/subinterface/state",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := yangKeysRx.ReplaceAllString(tt.xPath, ""); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+			// RegisterPlugin routes by the stripped path
+			c, plug := newTestClient(t, tt.xPath)
+			if plugs := c.xPaths[tt.want]; len(plugs) != 1 || plugs[0] != plug {
+				t.Errorf("plugin not registered on %s: %v", tt.want, c.xPaths)
+			}
+		})
+	}
+}
+
+func TestNewSubListSharedPath(t *testing.T) {
+	c, cachePlug := newTestClient(t, "/interfaces/interface/state", "/lldp/interfaces")
+	passPlug := &fakePlugin{name: "plug0", paths: []string{"/interfaces/interface/state"}, passthrough: true}
+	if err := c.RegisterPlugin(passPlug.name, passPlug); err != nil {
+		t.Fatal(err)
+	}
+	c.config.ScrapeInterval = time.Minute
+	c.config.OverSampling = 1
+
+	// The device mode (cache) list comes first, whatever the plugin names
+	want := []struct {
+		updatesOnly bool
+		plug        *fakePlugin
+		subs        int
+	}{
+		{updatesOnly: false, plug: cachePlug, subs: 2},
+		{updatesOnly: true, plug: passPlug, subs: 1},
+	}
+	for run := 0; run < 5; run++ {
+		lists, plugs := c.newSubList()
+		if len(lists) != len(want) || len(plugs) != len(want) {
+			t.Fatalf("got %d subscription lists, want %d", len(lists), len(want))
+		}
+		for i, w := range want {
+			if lists[i].GetUpdatesOnly() != w.updatesOnly {
+				t.Errorf("list %d: got updates_only %v, want %v", i, lists[i].GetUpdatesOnly(), w.updatesOnly)
+			}
+			if n := len(lists[i].GetSubscription()); n != w.subs {
+				t.Errorf("list %d: got %d subscriptions, want %d", i, n, w.subs)
+			}
+			if len(plugs[i]) != 1 || plugs[i][w.plug.name] != w.plug {
+				t.Errorf("list %d: got plugins %v, want %s", i, slices.Collect(maps.Keys(plugs[i])), w.plug.name)
+			}
+		}
+	}
+}
+
+func TestRouteSrByStream(t *testing.T) {
+	c, cachePlug := newTestClient(t, "/interfaces/interface/state")
+	passPlug := &fakePlugin{name: "plug0", paths: []string{"/interfaces/interface/state"}, passthrough: true}
+	if err := c.RegisterPlugin(passPlug.name, passPlug); err != nil {
+		t.Fatal(err)
+	}
+
+	// Received on the passthrough stream: the cache plugin shares the path but must not get it
+	c.routeSr(&gnmi.SubscribeResponse{Response: &gnmi.SubscribeResponse_Update{Update: &gnmi.Notification{
+		Prefix: mustPath(t, "/interfaces/interface[name=eth0]/state"),
+		Update: []*gnmi.Update{{
+			Path: mustPath(t, "/counters/in-octets"),
+			Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 10}},
+		}},
+	}}}, map[string]plugin{passPlug.name: passPlug})
+	if n := cachePlug.notifications(); n != 0 {
+		t.Errorf("cache plugin: got %d notifications, want 0", n)
+	}
+	if n := passPlug.notifications(); n != 1 {
+		t.Errorf("passthrough plugin: got %d notifications, want 1", n)
+	}
+}
+
+func TestUpdateCredentials(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	target := &fakeTarget{rpcs: make(chan string, 16)}
+	gnmi.RegisterGNMIServer(srv, target)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	host, port, _ := net.SplitHostPort(lis.Addr().String())
+	c, err := New(Config{
+		DevName:        "dev1",
+		Addresses:      []string{host},
+		Port:           port,
+		User:           "user1",
+		Password:       "pwd1",
+		ScrapeInterval: time.Minute,
+		OverSampling:   1,
+		DisableSelfMon: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	plug := &fakePlugin{name: "plug1", paths: []string{"/interfaces/interface/state"}}
+	if err = c.RegisterPlugin(plug.name, plug); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	expect := func(want ...string) {
+		t.Helper()
+		for _, w := range want {
+			select {
+			case rpc := <-target.rpcs:
+				if rpc != w {
+					t.Fatalf("got RPC %s, want %s", rpc, w)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("RPC %s not received", w)
+			}
+		}
+	}
+	expect("capabilities:user1", "subscribe:user1")
+
+	// The reconnection must not wait for the capabilities check deadline (3 minutes)
+	c.UpdateCredentials("user2", "pwd2")
+	expect("capabilities:user2", "subscribe:user2")
+}
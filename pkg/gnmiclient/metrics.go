@@ -23,3 +23,73 @@ func (m *clientMon) newMetric(mType prometheus.ValueType) smMetric {
 	metric.Type = mType
 	return metric
 }
+
+// nfSizeMetric represents the histogram of the updates per notification of a single client instance.
+type nfSizeMetric struct {
+	exporter.MetricCommons
+}
+
+// newNfSizeMetric creates a new nfSizeMetric object and initializes its headers.
+func (m *clientMon) newNfSizeMetric() nfSizeMetric {
+	metric := nfSizeMetric{}
+	// Headers
+	metric.Name = "gnmi_client_notification_updates"
+	metric.Help = "Gnmi client distribution of the updates per notification"
+	metric.Device = m.devName
+	metric.Histogram = &exporter.Histogram{}
+	return metric
+}
+
+// sampleIntMetric represents the effective sample interval of a single client instance.
+type sampleIntMetric struct {
+	exporter.MetricCommons
+}
+
+// newSampleIntMetric creates a new sampleIntMetric object and initializes its headers.
+func (m *clientMon) newSampleIntMetric() sampleIntMetric {
+	metric := sampleIntMetric{}
+	// Headers
+	metric.Name = "device_effective_sample_interval_seconds"
+	metric.Help = "Gnmi sample interval requested to the device, after the oversampling computation"
+	metric.Device = m.devName
+	metric.Type = prometheus.GaugeValue
+	return metric
+}
+
+// addressMetric represents the device address in use by a single client instance.
+type addressMetric struct {
+	exporter.MetricCommons
+	Address string `label:"address"`
+}
+
+// newAddressMetric creates a new addressMetric object and initializes its headers.
+func (m *clientMon) newAddressMetric() addressMetric {
+	metric := addressMetric{}
+	// Headers
+	metric.Name = "device_address"
+	metric.Help = "Gnmi device address in use"
+	metric.Device = m.devName
+	metric.Type = prometheus.GaugeValue
+	metric.Info = true
+	return metric
+}
+
+// infoMetric represents the gNMI capabilities of a single client instance.
+type infoMetric struct {
+	exporter.MetricCommons
+	GnmiVersion string `label:"gnmi_version"`
+	Encoding    string `label:"encoding"`
+	Mode        string `label:"mode"`
+}
+
+// newInfoMetric creates a new infoMetric object and initializes its headers.
+func (m *clientMon) newInfoMetric() infoMetric {
+	metric := infoMetric{}
+	// Headers
+	metric.Name = "device_gnmi"
+	metric.Help = "Gnmi device capabilities"
+	metric.Device = m.devName
+	metric.Type = prometheus.GaugeValue
+	metric.Info = true
+	return metric
+}
@@ -0,0 +1,71 @@
+package gnmiclient
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/protobuf/encoding/protojson"
+	"os"
+	"strings"
+	"time"
+)
+
+const maxReplayLineSize = 64 * 1024 * 1024
+
+// replay is the worker thread main loop when a replay file is configured. Instead of dialing the device,
+// it reads the recorded subscribe responses from the file and routes them to the plugins.
+// In loop mode the file is replayed every ScrapeInterval, as if the device reconnected each time.
+func (c *GnmiClient) replay(ctx context.Context) {
+	for {
+		c.logger.Infof("Replaying %s...", c.config.ReplayFile)
+		if err := c.replayFile(ctx); err != nil {
+			c.logger.Error(err)
+			c.logger.Error("Device has been disabled...")
+			return
+		}
+		if !c.config.ReplayLoop {
+			c.logger.Info("replay completed")
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.config.ScrapeInterval):
+		}
+		for _, plug := range c.plugins {
+			plug.OnSync(false)
+		}
+	}
+}
+
+// replayFile reads the replay file and routes its subscribe responses.
+// The file contains one JSON encoded gNMI SubscribeResponse per line. Empty lines and lines
+// starting with # are ignored.
+func (c *GnmiClient) replayFile(ctx context.Context) error {
+	f, err := os.Open(c.config.ReplayFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxReplayLineSize)
+	lineNum := 0
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return nil
+		}
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		sr := &gnmi.SubscribeResponse{}
+		if err = protojson.Unmarshal([]byte(line), sr); err != nil {
+			return fmt.Errorf("%s line %d: %w", c.config.ReplayFile, lineNum, err)
+		}
+		c.routeSr(sr, c.plugins)
+	}
+	return scanner.Err()
+}
@@ -2,22 +2,34 @@ package gnmiclient
 
 import (
 	"context"
-	"google.golang.org/grpc/credentials"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
 )
 
+// credProvider returns the device access credentials, as gRPC metadata, attached to an RPC.
+// It is called on each RPC, so that token based providers can refresh the credentials.
+type credProvider func(ctx context.Context) (map[string]string, error)
+
 // perRpcCreds represents per RPC credentials.
+// Credentials are read on each RPC from the provider, which can be replaced while the client is running.
 type perRpcCreds struct {
-	username string
-	password string
+	provider credProvider
 	secure   bool
+	mutex    sync.RWMutex
 }
 
 // GetRequestMetadata implements the required credentials interface
 func (c *perRpcCreds) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
-	return map[string]string{
-		"username": c.username,
-		"password": c.password,
-	}, nil
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	c.mutex.RLock()
+	provider := c.provider
+	c.mutex.RUnlock()
+	return provider(ctx)
 }
 
 // RequireTransportSecurity implements the required credentials interface
@@ -25,11 +37,85 @@ func (c *perRpcCreds) RequireTransportSecurity() bool {
 	return c.secure
 }
 
+// update replaces the credentials used by the next RPCs with the given static ones.
+func (c *perRpcCreds) update(user, pwd string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.provider = staticCreds(user, pwd)
+}
+
+// staticCreds returns a provider of fixed username and password credentials.
+// No credentials are sent if either of them is empty.
+func staticCreds(user, pwd string) credProvider {
+	return func(ctx context.Context) (map[string]string, error) {
+		if user == "" || pwd == "" {
+			// No device access credentials
+			return nil, nil
+		}
+		return map[string]string{
+			"username": user,
+			"password": pwd,
+		}, nil
+	}
+}
+
+// tokenFile holds a bearer token read from a file. The file is read again when its modification time
+// or size changes, so that the token can be rotated by an external agent without restarting the client.
+type tokenFile struct {
+	path    string
+	modTime time.Time
+	size    int64
+	token   string
+	mutex   sync.Mutex
+}
+
+// fileCreds returns a provider of the bearer token stored in the given file.
+func fileCreds(path string) credProvider {
+	tf := &tokenFile{path: path}
+	return tf.get
+}
+
+// get returns the authorization metadata, reloading the token if the file has changed.
+func (t *tokenFile) get(ctx context.Context) (map[string]string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	info, err := os.Stat(t.path)
+	if err != nil {
+		return nil, err
+	}
+	if t.token == "" || !info.ModTime().Equal(t.modTime) || info.Size() != t.size {
+		data, err := os.ReadFile(t.path)
+		if err != nil {
+			return nil, err
+		}
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return nil, fmt.Errorf("token file %s is empty", t.path)
+		}
+		t.token, t.modTime, t.size = token, info.ModTime(), info.Size()
+	}
+	return map[string]string{"authorization": "Bearer " + t.token}, nil
+}
+
+// metadataCreds represents static gRPC metadata (headers) attached to each RPC.
+type metadataCreds struct {
+	md map[string]string
+}
+
+// GetRequestMetadata implements the required credentials interface
+func (c *metadataCreds) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return c.md, nil
+}
+
+// RequireTransportSecurity implements the required credentials interface
+func (c *metadataCreds) RequireTransportSecurity() bool {
+	return false
+}
+
 // newPerRpcCreds creates a new instance of perRpcCreds, used for dialing the target device.
-func newPerRpcCreds(user, pwd string, secure bool) credentials.PerRPCCredentials {
+func newPerRpcCreds(provider credProvider, secure bool) *perRpcCreds {
 	return &perRpcCreds{
-		username: user,
-		password: pwd,
+		provider: provider,
 		secure:   secure,
 	}
 }
@@ -2,88 +2,201 @@ package gnmiclient
 
 import (
 	"context"
-	log "github.com/golang/glog"
 	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/proto/gnmi_ext"
 	"github.com/openconfig/ygot/ygot"
+	"maps"
+	"slices"
+	"time"
 )
 
-// subscribe creates a subscription client and sends SubscribeRequests to the server.
-// It returns the subscription client and any error encountered during the process.
-func (c *GnmiClient) subscribe(ctx context.Context, stub gnmi.GNMIClient) (gnmi.GNMI_SubscribeClient, error) {
-	// Create client
-	gNMISubClt, err := stub.Subscribe(ctx)
-	if err != nil {
-		return nil, err
-	}
+// subStream is a gNMI Subscribe RPC and the plugin instances its subscription list was built for.
+type subStream struct {
+	sub     gnmi.GNMI_SubscribeClient
+	plugins map[string]plugin // Key: plugin name. Notifications of the stream are only routed to these plugins
+}
+
+// subscribe creates the subscription clients and sends a SubscribeRequest to each of them.
+// gNMI allows a single subscription list per Subscribe RPC, so each list is sent on its own stream.
+// It returns the subscription streams and any error encountered during the process. The streams live
+// until ctx is canceled.
+func (c *GnmiClient) subscribe(ctx context.Context, stub gnmi.GNMIClient) ([]subStream, error) {
 	if c.config.OverSampling == 0 {
 		c.config.OverSampling = oversampling
 	}
 	if c.config.OverSampling < 1 || c.config.OverSampling > 10 {
-		log.Warningf("%s: Oversampling must fall between 1 and 10", c.config.DevName)
+		c.logger.Warning("Oversampling must fall between 1 and 10")
 		c.config.OverSampling = oversampling
 	}
 
 	// Prepare the subscription list
-	subLists := c.newSubList()
+	subLists, subPlugins := c.newSubList()
 
 	// Subscribe
-	for _, sl := range subLists {
+	subs := make([]subStream, 0, len(subLists))
+	for i, sl := range subLists {
+		// Time to exit?
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// Create client
+		gNMISubClt, err := stub.Subscribe(ctx)
+		if err != nil {
+			return nil, err
+		}
 		// Prepare the SubscribeRequest struct
 		req := &gnmi.SubscribeRequest{
 			Request:   &gnmi.SubscribeRequest_Subscribe{Subscribe: sl},
-			Extension: nil,
+			Extension: c.newExtensions(),
 		}
 		// Send it to the device
 		err = gNMISubClt.Send(req)
 		if err != nil {
 			return nil, err
 		}
+		subs = append(subs, subStream{sub: gNMISubClt, plugins: subPlugins[i]})
 	}
 
-	return gNMISubClt, nil
+	return subs, nil
+}
+
+// newExtensions returns the gNMI extensions to be attached to the SubscribeRequest.
+// Currently supported extensions:
+// - History (snapshot time): requests the device state at a given point in time.
+func (c *GnmiClient) newExtensions() []*gnmi_ext.Extension {
+	var ext []*gnmi_ext.Extension
+	if !c.config.HistorySnapshot.IsZero() {
+		ext = append(ext, &gnmi_ext.Extension{
+			Ext: &gnmi_ext.Extension_History{
+				History: &gnmi_ext.History{
+					Request: &gnmi_ext.History_SnapshotTime{SnapshotTime: c.config.HistorySnapshot.UnixNano()},
+				},
+			},
+		})
+	}
+	return ext
 }
 
-// newSubList creates a list with a single subscriptions for all the configured plugins.
-// This is the default way for subscribing telemetries.
-func (c *GnmiClient) newSubList() []*gnmi.SubscriptionList {
-	var subs []*gnmi.Subscription
+// subListKey identifies the subscription list a plugin's paths belong to.
+type subListKey struct {
+	encoding    gnmi.Encoding
+	updatesOnly bool
+}
+
+// newSubList creates a subscription list for all the configured plugins.
+// Plugins overriding the device encoding are grouped into a dedicated list (and stream) per encoding.
+// Passthrough plugins are subscribed with updates_only, so they are grouped apart from cache plugins.
+// A path shared by plugins of different lists is subscribed in each of them. Within a list, it is subscribed
+// once, with the mode of the first plugin by name.
+// It returns the subscription lists and, for each of them, the plugins it was built for.
+func (c *GnmiClient) newSubList() ([]*gnmi.SubscriptionList, []map[string]plugin) {
 	var subLists []*gnmi.SubscriptionList
+	var subPlugins []map[string]plugin
+	subs := make(map[subListKey][]*gnmi.Subscription)
+	plugs := make(map[subListKey]map[string]plugin)
+	subscribed := make(map[subListKey]map[string]gnmi.SubscriptionMode) // Key: path. Plugins may share paths
 
-	for _, plug := range c.plugins {
-		for _, path := range c.xPathList[plug.GetPlugName()] {
+	// Sample interval. If not configured, it is derived from the scrape interval
+	sampleInterval := uint64(c.config.ScrapeInterval.Nanoseconds() / c.config.OverSampling)
+	if c.config.SampleInterval != 0 {
+		sampleInterval = uint64(c.config.SampleInterval.Nanoseconds())
+	}
+
+	// Redundant samples suppression and heartbeat only apply to SAMPLE mode subscriptions
+	suppressRedundant := c.config.SuppressRedundant
+	heartbeatInterval := uint64(c.config.HeartbeatInterval.Nanoseconds())
+	if c.config.GnmiSubscriptionMode == gnmi.SubscriptionMode_SAMPLE {
+		c.clientMon.setSampleInterval(time.Duration(sampleInterval))
+	}
+
+	// Sorted by name, so that the subscriptions do not depend on the map iteration order
+	for _, name := range slices.Sorted(maps.Keys(c.plugins)) {
+		plug := c.plugins[name]
+		encoding, ok := c.plugEnc[name]
+		if !ok {
+			encoding = c.encoding
+		}
+		key := subListKey{encoding: encoding, updatesOnly: !plug.IsCacheMode()}
+		if plugs[key] == nil {
+			plugs[key] = make(map[string]plugin)
+			subscribed[key] = make(map[string]gnmi.SubscriptionMode)
+		}
+		plugs[key][name] = plug
+		for _, path := range c.xPathList[name] {
+			// The plugin can override the device subscription mode of a path
+			mode := c.config.GnmiSubscriptionMode
+			if m, ok := plug.GetPathMode(path); ok {
+				mode = m
+			}
 			// Huawei requires prepending the datamodel name to paths
 			if c.config.Vendor == "huawei" {
 				path = plug.GetDataModel() + ":" + path[1:]
 			}
+			if m, ok := subscribed[key][path]; ok {
+				if m != mode {
+					c.logger.Warningf("%s is shared by plugins with different modes, %s is kept", path, m)
+				}
+				continue
+			}
+			subscribed[key][path] = mode
 
 			// One subscription for each plugin's path
 			p, err := ygot.StringToPath(path, ygot.StructuredPath, ygot.StringSlicePath)
 			if err != nil {
-				log.Error(err)
+				c.logger.Error(err)
 				continue
 			}
+			// Origin qualified path. The origin is not part of the schema path used for routing
+			p.Origin = c.config.PathOrigin
 			newSub := &gnmi.Subscription{
-				Path:              p,
-				Mode:              c.config.GnmiSubscriptionMode,
-				SampleInterval:    uint64(c.config.ScrapeInterval.Nanoseconds() / c.config.OverSampling),
-				SuppressRedundant: false,
-				HeartbeatInterval: 0,
+				Path:           p,
+				Mode:           mode,
+				SampleInterval: sampleInterval,
+			}
+			if mode == gnmi.SubscriptionMode_SAMPLE {
+				newSub.SuppressRedundant = suppressRedundant
+				newSub.HeartbeatInterval = heartbeatInterval
 			}
-			subs = append(subs, newSub)
+			subs[key] = append(subs[key], newSub)
 		}
 	}
 
-	// One subscription list per device
-	subLists = append(subLists, &gnmi.SubscriptionList{
-		Prefix:           nil,
-		Subscription:     subs,
-		Qos:              nil,
-		Mode:             gnmi.SubscriptionList_STREAM,
-		AllowAggregation: false,
-		UseModels:        nil,
-		Encoding:         c.encoding,
-		UpdatesOnly:      c.config.GnmiUpdatesOnly,
+	// Subscription target. Notifications carrying it are routed by xPath
+	var prefix *gnmi.Path
+	if c.config.GnmiTarget != "" {
+		prefix = &gnmi.Path{Target: c.config.GnmiTarget}
+	}
+
+	// One subscription list per encoding and updates_only flag. The device encoding and mode come first
+	keys := slices.SortedFunc(maps.Keys(subs), func(a, b subListKey) int {
+		switch {
+		case a.encoding != b.encoding && a.encoding == c.encoding:
+			return -1
+		case a.encoding != b.encoding && b.encoding == c.encoding:
+			return 1
+		case a.encoding != b.encoding:
+			return int(a.encoding - b.encoding)
+		case a.updatesOnly == b.updatesOnly:
+			return 0
+		case a.updatesOnly == c.config.GnmiUpdatesOnly:
+			return -1
+		default:
+			return 1
+		}
 	})
+	for _, key := range keys {
+		subPlugins = append(subPlugins, plugs[key])
+		subLists = append(subLists, &gnmi.SubscriptionList{
+			Prefix:           prefix,
+			Subscription:     subs[key],
+			Qos:              nil,
+			Mode:             gnmi.SubscriptionList_STREAM,
+			AllowAggregation: c.config.AllowAggregation,
+			UseModels:        nil,
+			Encoding:         key.encoding,
+			UpdatesOnly:      key.updatesOnly,
+		})
+	}
 
-	return subLists
+	return subLists, subPlugins
 }
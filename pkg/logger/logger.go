@@ -0,0 +1,116 @@
+// Package logger is a thin logging layer on top of glog.
+// By default, messages are sent to glog unchanged. When the json format is selected, messages are emitted
+// by a slog JSON handler on stderr, along with their structured context (e.g.: device and plugin names).
+package logger
+
+import (
+	"context"
+	"fmt"
+	log "github.com/golang/glog"
+	"log/slog"
+	"os"
+	"sync/atomic"
+)
+
+const (
+	FormatText = "text" // glog backend. Default
+	FormatJSON = "json" // slog JSON backend
+)
+
+// jsonLogger is the slog backend. Nil means the glog backend is in use.
+var jsonLogger atomic.Pointer[slog.Logger]
+
+// SetFormat selects the logging backend. Acceptable values are "text" (or empty) and "json".
+func SetFormat(format string) error {
+	switch format {
+	case "", FormatText:
+		jsonLogger.Store(nil)
+	case FormatJSON:
+		jsonLogger.Store(slog.New(slog.NewJSONHandler(os.Stderr, nil)))
+	default:
+		return fmt.Errorf("unknown log format: %s", format)
+	}
+	return nil
+}
+
+// Logger carries the structured context attached to its messages.
+// The json backend emits the context as attributes. The glog backend prepends the context values to the message
+// text (e.g.: "router1: if1: message"), so call sites must not embed them.
+type Logger struct {
+	attrs  []any
+	prefix string // Context values, as prepended to glog messages
+}
+
+// With returns a Logger with the given key/value pairs (e.g.: "device", "router1") added to its context.
+func With(args ...any) Logger {
+	return Logger{}.With(args...)
+}
+
+// With returns a copy of the Logger with the given key/value pairs added to its context.
+func (l Logger) With(args ...any) Logger {
+	out := Logger{attrs: append(append([]any(nil), l.attrs...), args...), prefix: l.prefix}
+	for i := 1; i < len(args); i += 2 {
+		out.prefix += fmt.Sprint(args[i]) + ": "
+	}
+	return out
+}
+
+// Info logs at the info level, with fmt.Sprint semantics.
+func (l Logger) Info(args ...any) { l.output(slog.LevelInfo, fmt.Sprint(args...)) }
+
+// Infof logs at the info level, with fmt.Sprintf semantics.
+func (l Logger) Infof(format string, args ...any) {
+	l.output(slog.LevelInfo, fmt.Sprintf(format, args...))
+}
+
+// Warning logs at the warning level, with fmt.Sprint semantics.
+func (l Logger) Warning(args ...any) { l.output(slog.LevelWarn, fmt.Sprint(args...)) }
+
+// Warningf logs at the warning level, with fmt.Sprintf semantics.
+func (l Logger) Warningf(format string, args ...any) {
+	l.output(slog.LevelWarn, fmt.Sprintf(format, args...))
+}
+
+// Error logs at the error level, with fmt.Sprint semantics.
+func (l Logger) Error(args ...any) { l.output(slog.LevelError, fmt.Sprint(args...)) }
+
+// Errorf logs at the error level, with fmt.Sprintf semantics.
+func (l Logger) Errorf(format string, args ...any) {
+	l.output(slog.LevelError, fmt.Sprintf(format, args...))
+}
+
+// output sends the message to the active backend.
+func (l Logger) output(level slog.Level, msg string) {
+	if jl := jsonLogger.Load(); jl != nil {
+		jl.Log(context.Background(), level, msg, l.attrs...)
+		return
+	}
+	msg = l.prefix + msg
+	// Depth 2 reports the caller of the Logger method
+	switch level {
+	case slog.LevelError:
+		log.ErrorDepth(2, msg)
+	case slog.LevelWarn:
+		log.WarningDepth(2, msg)
+	default:
+		log.InfoDepth(2, msg)
+	}
+}
+
+// Package level helpers log without structured context.
+
+func Info(args ...any) { Logger{}.output(slog.LevelInfo, fmt.Sprint(args...)) }
+
+func Infof(format string, args ...any) { Logger{}.output(slog.LevelInfo, fmt.Sprintf(format, args...)) }
+
+func Warning(args ...any) { Logger{}.output(slog.LevelWarn, fmt.Sprint(args...)) }
+
+func Warningf(format string, args ...any) {
+	Logger{}.output(slog.LevelWarn, fmt.Sprintf(format, args...))
+}
+
+func Error(args ...any) { Logger{}.output(slog.LevelError, fmt.Sprint(args...)) }
+
+func Errorf(format string, args ...any) {
+	Logger{}.output(slog.LevelError, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,43 @@
+package plugins
+
+import "sync"
+
+// SeriesBudget is the max_series_per_device limit, shared by the plugins of a device.
+// The exporter collects the plugins concurrently, without notifying them when a collection starts: the budget is
+// refilled when a plugin begins its collection again, since this means that a new collection has started.
+type SeriesBudget struct {
+	limit   int
+	used    int
+	started map[*Plugin]bool // Plugins collected since the last refill
+	mutex   sync.Mutex
+}
+
+// NewSeriesBudget returns a budget of limit series per collection. It returns nil if limit is zero (no limit).
+func NewSeriesBudget(limit int) *SeriesBudget {
+	if limit <= 0 {
+		return nil
+	}
+	return &SeriesBudget{limit: limit, started: make(map[*Plugin]bool)}
+}
+
+// begin marks the start of the collection of plug, refilling the budget on a new collection.
+func (b *SeriesBudget) begin(plug *Plugin) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.started[plug] {
+		b.used = 0
+		clear(b.started)
+	}
+	b.started[plug] = true
+}
+
+// take reserves a series. It returns false once the limit has been reached.
+func (b *SeriesBudget) take() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.used >= b.limit {
+		return false
+	}
+	b.used++
+	return true
+}
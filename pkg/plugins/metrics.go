@@ -37,3 +37,14 @@ func newFormatterMetric(mType prometheus.ValueType, devName string) smMetric {
 	metric.Type = mType
 	return metric
 }
+
+// newPluginMetric creates a new empty smMetric object to be used by the plugin itself.
+func newPluginMetric(mType prometheus.ValueType, devName string) smMetric {
+	metric := smMetric{}
+	// Common fields
+	metric.Name = "plugin"
+	metric.Help = "Plugin statistics"
+	metric.Device = devName
+	metric.Type = mType
+	return metric
+}
@@ -0,0 +1,41 @@
+package ocacl
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/exporter"
+)
+
+// ocAclEntryMetric represents the Openconfig ACL Interface Entries Metric.
+//
+// Fields:
+// - Metric: Name of the metric.
+// - CustomLabel: Custom label associated with the metric.
+// - IfId: Interface id.
+// - Direction: ACL direction (ingress or egress).
+// - SetName: ACL set name.
+// - SetType: ACL set type.
+// - SequenceId: ACL entry sequence id.
+type ocAclEntryMetric struct {
+	exporter.MetricCommons
+	Metric      string `label:"metric"`
+	CustomLabel string `label:"custom_label"`
+	IfId        string `label:"name"`
+	Direction   string `label:"direction"`
+	SetName     string `label:"acl_set"`
+	SetType     string `label:"acl_type"`
+	SequenceId  string `label:"sequence_id"`
+}
+
+// newAclEntryMetric creates a new ocAclEntryMetric.
+func (f *ocAclFormatter) newAclEntryMetric() ocAclEntryMetric {
+	metric := ocAclEntryMetric{}
+	// Common fields
+	metric.Name = "oc_acl_entry"
+	metric.Help = "Openconfig ACL Interface Entries Metric"
+	metric.Device = f.config.DevName
+	metric.Type = prometheus.CounterValue
+	metric.CustomLabel = f.config.CustomLabel
+	return metric
+}
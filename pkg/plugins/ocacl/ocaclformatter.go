@@ -0,0 +1,130 @@
+package ocacl
+
+import (
+	"fmt"
+	log "github.com/automixer/gtexporter/pkg/logger"
+	"github.com/openconfig/ygot/ygot"
+	"strconv"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/datamodels/ysocacl"
+	"github.com/automixer/gtexporter/pkg/datamodels/ysocif"
+	"github.com/automixer/gtexporter/pkg/exporter"
+	"github.com/automixer/gtexporter/pkg/plugins"
+)
+
+const (
+	plugName  = "oc_acl"
+	dataModel = "openconfig-acl"
+	// Paths to subscribe
+	ingressEntryState = "/acl/interfaces/interface/ingress-acl-sets/ingress-acl-set/acl-entries/acl-entry/state"
+	egressEntryState  = "/acl/interfaces/interface/egress-acl-sets/egress-acl-set/acl-entries/acl-entry/state"
+)
+
+// init register the parser and the formatter to the plugin registration system
+func init() {
+	err := plugins.Register(plugName, newFormatter, newParser)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// ocAclFormatter is a type that represents a formatter for Openconfig ACL data.
+type ocAclFormatter struct {
+	config         plugins.Config
+	root           *ysocacl.Root
+	disableIngress bool
+	disableEgress  bool
+}
+
+// newFormatter creates a new instance of ocAclFormatter and initializes its config field with the provided config.
+func newFormatter(cfg plugins.Config) (plugins.Formatter, error) {
+	f := &ocAclFormatter{}
+	f.config = cfg
+	f.disableIngress, _ = strconv.ParseBool(f.config.Options["disable_ingress"])
+	f.disableEgress, _ = strconv.ParseBool(f.config.Options["disable_egress"])
+	if f.disableIngress && f.disableEgress {
+		return nil, fmt.Errorf("%s: both ACL directions are disabled", plugName)
+	}
+	return f, nil
+}
+
+// GetPaths returns the XPaths and Datamodels for the ocAclFormatter plugin.
+func (f *ocAclFormatter) GetPaths() plugins.FormatterPaths {
+	fp := plugins.FormatterPaths{
+		Datamodel: dataModel,
+	}
+	if !f.disableIngress {
+		fp.XPaths = append(fp.XPaths, ingressEntryState)
+	}
+	if !f.disableEgress {
+		fp.XPaths = append(fp.XPaths, egressEntryState)
+	}
+	return fp
+}
+
+// Describe returns a slice of exporter.GMetric objects containing the description of the ocAclFormatter plugin.
+func (f *ocAclFormatter) Describe() []exporter.GMetric {
+	return []exporter.GMetric{f.newAclEntryMetric()}
+}
+
+// Collect returns a slice of GMetric objects containing ACL entries metrics.
+func (f *ocAclFormatter) Collect() []exporter.GMetric {
+	out := make([]exporter.GMetric, 0)
+	out = append(out, f.entryMetrics()...)
+	return out
+}
+
+// ScrapeEvent implements the plugin's formatter interface.
+// It is called by the plugin when a scrape event occurs.
+func (f *ocAclFormatter) ScrapeEvent(ys ygot.GoStruct) func() {
+	f.root = ysocacl.GoStructToOcAcl(ys)
+	return func() {
+		f.root = nil
+	}
+}
+
+// entryMetrics scans the yGot GoStruct and returns a slice of acl/interfaces/interface ACL entries metrics
+func (f *ocAclFormatter) entryMetrics() []exporter.GMetric {
+	out := make([]exporter.GMetric, 0, len(f.root.GetAcl().Interface))
+
+	// Set counters pull mode
+	pullMode := ysocif.Normal
+	if f.config.UseGoDefaults {
+		pullMode = ysocif.UseGoDefault
+	}
+
+	// entryCounters appends the counters of an ACL entry to the output slice
+	entryCounters := func(metric ocAclEntryMetric, seqId uint32, entry any) {
+		metric.SequenceId = fmt.Sprint(seqId)
+		for valueName, value := range ysocif.GetCountersFromStruct(entry, pullMode) {
+			metric.Metric = valueName
+			metric.Value = value
+			out = append(out, metric)
+		}
+	}
+
+	for ifId, ifObject := range f.root.GetAcl().Interface {
+		for setKey, setObject := range ifObject.IngressAclSet {
+			metric := f.newAclEntryMetric()
+			metric.IfId = ifId
+			metric.Direction = "ingress"
+			metric.SetName = setKey.SetName
+			metric.SetType = setKey.Type.ShortString()
+			for seqId, entry := range setObject.AclEntry {
+				entryCounters(metric, seqId, *entry)
+			}
+		}
+		for setKey, setObject := range ifObject.EgressAclSet {
+			metric := f.newAclEntryMetric()
+			metric.IfId = ifId
+			metric.Direction = "egress"
+			metric.SetName = setKey.SetName
+			metric.SetType = setKey.Type.ShortString()
+			for seqId, entry := range setObject.AclEntry {
+				entryCounters(metric, seqId, *entry)
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,249 @@
+package ocacl
+
+import (
+	"errors"
+	"fmt"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"strconv"
+	"strings"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/datamodels/ysocacl"
+	"github.com/automixer/gtexporter/pkg/plugins"
+)
+
+const yStructInitialSize = 128
+
+// pathMetadata represents metadata extracted from a path.
+// It contains information about the interface id, the ACL direction, the ACL set keys (name and type),
+// the ACL entry sequence id and the leaf name.
+type pathMetadata struct {
+	ifId      string
+	direction string
+	setName   string
+	setType   ysocacl.E_OpenconfigAcl_ACL_TYPE
+	seqId     uint32
+	isEntry   bool
+	leafName  string
+}
+
+// ocAclParser represents a parser for OpenConfig ACL data.
+// It implements the plugins.Parser interface and includes a ygot structure for storing ACL data
+// and an EnumMapper for mapping string enum values to their corresponding integer values.
+type ocAclParser struct {
+	plugins.ParserMon
+	yStruct *ysocacl.Root
+	eMapper *ysocacl.EnumMapper
+}
+
+// newParser creates a new ocAclParser and initializes its fields based on the given configuration.
+// It returns the newly created parser or an error if there was an issue during initialization.
+func newParser(cfg plugins.Config) (plugins.Parser, error) {
+	p := &ocAclParser{}
+	if err := p.ParserMon.Configure(cfg); err != nil {
+		return nil, err
+	}
+	p.ClearCache()
+	p.eMapper = ysocacl.NewEnumMapper()
+	return p, nil
+}
+
+// CheckOut returns the yGot structure.
+func (p *ocAclParser) CheckOut() ygot.GoStruct {
+	if p.yStruct == nil {
+		panic(fmt.Sprint("yGot structure not initialized"))
+	}
+	return p.yStruct
+}
+
+// ClearCache resets the yGot structure, populates default values, and initializes the Acl.Interface map.
+func (p *ocAclParser) ClearCache() {
+	p.yStruct = &ysocacl.Root{}
+	p.yStruct.PopulateDefaults()
+	p.yStruct.Acl.Interface = make(map[string]*ysocacl.Acl_Interface, yStructInitialSize)
+}
+
+// getPathMeta returns the metadata of the given path by scanning its elements and extracting the necessary
+// information. The ACL set list has a composite key (set-name and type), so keys are read from the path
+// elements rather than from their string representation.
+// If any of the metadata is missing or the path is invalid, an error is returned.
+func (p *ocAclParser) getPathMeta(pfx, path *gnmi.Path) (*pathMetadata, error) {
+	var elems []*gnmi.PathElem
+	out := &pathMetadata{}
+
+	// Build the full path as a slice of path elements
+	elems = append(elems, pfx.GetElem()...)
+	elems = append(elems, path.GetElem()...)
+	if len(elems) < 2 {
+		return nil, errors.New("path too short")
+	}
+
+	// Scan the path elements and extract metadata
+	for _, elem := range elems {
+		keys := elem.GetKey()
+		switch elem.GetName() {
+		case "interface":
+			out.ifId = keys["id"]
+		case "ingress-acl-set", "egress-acl-set":
+			out.direction, _, _ = strings.Cut(elem.GetName(), "-")
+			out.setName = keys["set-name"]
+			out.setType = ysocacl.E_OpenconfigAcl_ACL_TYPE(
+				p.eMapper.GetEnumFromString(keys["type"], out.setType))
+			if out.setName == "" || out.setType == ysocacl.OpenconfigAcl_ACL_TYPE_UNSET {
+				return nil, errors.New("invalid acl set keys")
+			}
+		case "acl-entry":
+			seqId, err := strconv.ParseUint(keys["sequence-id"], 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			out.seqId = uint32(seqId)
+			out.isEntry = true
+		}
+	}
+	out.leafName = elems[len(elems)-1].GetName()
+
+	// Final check
+	if out.ifId == "" || out.leafName == "" {
+		return nil, errors.New("invalid path metadata")
+	}
+	return out, nil
+}
+
+// ParseNotification analyzes a GNMI notification and calls the appropriate decoding method.
+func (p *ocAclParser) ParseNotification(nf *gnmi.Notification) {
+	if p.yStruct == nil {
+		panic(fmt.Sprint("yGot structure not initialized"))
+	}
+
+	// Process GNMI delete messages
+	for _, gDelete := range nf.Delete {
+		p.removeDbEntry(nf.Prefix, gDelete)
+	}
+
+	// Process GNMI update messages
+	for i, update := range nf.Update {
+		// An update without a value would overwrite the cached leaf with a zero value
+		if update.GetVal() == nil {
+			p.NilValue()
+			continue
+		}
+		updHandler := p.updHandlerLookup(nf.Prefix, update.Path)
+		if updHandler == nil {
+			continue
+		}
+		p.UpdateDuplicates(uint64(update.GetDuplicates()))
+		updHandler(nf, i)
+	}
+}
+
+// removeDbEntry removes the yGot GoStruct entry specified by the given prefix and path.
+func (p *ocAclParser) removeDbEntry(pfx, path *gnmi.Path) {
+	pathMeta, err := p.getPathMeta(pfx, path)
+	if err != nil {
+		p.InvalidPath()
+		return
+	}
+
+	iface, ok := p.yStruct.GetAcl().Interface[pathMeta.ifId]
+	if !ok {
+		p.DeleteNotFound()
+		return
+	}
+	switch {
+	case pathMeta.direction == "":
+		// Delete interface
+		p.yStruct.GetAcl().DeleteInterface(pathMeta.ifId)
+	case pathMeta.direction == "ingress":
+		set := iface.GetIngressAclSet(pathMeta.setName, pathMeta.setType)
+		switch {
+		case set == nil:
+			p.DeleteNotFound()
+		case !pathMeta.isEntry:
+			iface.DeleteIngressAclSet(pathMeta.setName, pathMeta.setType)
+		case set.GetAclEntry(pathMeta.seqId) != nil:
+			set.DeleteAclEntry(pathMeta.seqId)
+		default:
+			p.DeleteNotFound()
+		}
+	default:
+		set := iface.GetEgressAclSet(pathMeta.setName, pathMeta.setType)
+		switch {
+		case set == nil:
+			p.DeleteNotFound()
+		case !pathMeta.isEntry:
+			iface.DeleteEgressAclSet(pathMeta.setName, pathMeta.setType)
+		case set.GetAclEntry(pathMeta.seqId) != nil:
+			set.DeleteAclEntry(pathMeta.seqId)
+		default:
+			p.DeleteNotFound()
+		}
+	}
+}
+
+// updHandlerLookup returns the appropriate decoding handler based on the given prefix and path.
+func (p *ocAclParser) updHandlerLookup(pfx, path *gnmi.Path) func(*gnmi.Notification, int) {
+	sPfx, _ := ygot.PathToSchemaPath(pfx)
+	sPath, _ := ygot.PathToSchemaPath(path)
+	var fullPath string
+	if len(sPfx) > 1 {
+		fullPath += sPfx
+	}
+	fullPath += sPath
+	leafIndex := strings.LastIndex(fullPath, "/")
+	if leafIndex == -1 {
+		p.InvalidPath()
+		return nil
+	}
+
+	// Find the proper handler
+	switch fullPath[:leafIndex] {
+	case ingressEntryState, egressEntryState:
+		return p.entryState
+	default:
+		p.ContainerNotFound()
+	}
+	return nil
+}
+
+// entryState updates the yGot structure with the information from the GNMI update message for the
+// interface ingress or egress ACL entry state.
+func (p *ocAclParser) entryState(nf *gnmi.Notification, updNum int) {
+	pathMeta, err := p.getPathMeta(nf.Prefix, nf.Update[updNum].Path)
+	if err != nil || !pathMeta.isEntry || pathMeta.direction == "" {
+		p.InvalidPath()
+		return
+	}
+	// Create the interface if missing
+	iface, ok := p.yStruct.GetAcl().Interface[pathMeta.ifId]
+	if !ok {
+		iface, err = p.yStruct.GetAcl().NewInterface(pathMeta.ifId)
+		if err != nil {
+			return
+		}
+		iface.PopulateDefaults()
+	}
+	// Create the ACL set and entry if missing
+	var target *ysocacl.Acl_Interface_IngressAclSet_AclEntry
+	if pathMeta.direction == "ingress" {
+		set := iface.GetOrCreateIngressAclSet(pathMeta.setName, pathMeta.setType)
+		target = set.GetOrCreateAclEntry(pathMeta.seqId)
+	} else {
+		set := iface.GetOrCreateEgressAclSet(pathMeta.setName, pathMeta.setType)
+		// Ingress and egress entries share the same structure
+		target = (*ysocacl.Acl_Interface_IngressAclSet_AclEntry)(set.GetOrCreateAclEntry(pathMeta.seqId))
+	}
+	// Load the gnmi update into yGot struct
+	source := nf.Update[updNum].Val
+	switch pathMeta.leafName {
+	case "matched-octets":
+		target.MatchedOctets = ygot.Uint64(plugins.UintVal(source))
+	case "matched-packets":
+		target.MatchedPackets = ygot.Uint64(plugins.UintVal(source))
+	case "sequence-id":
+		target.SequenceId = ygot.Uint32(uint32(plugins.UintVal(source)))
+	default:
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
+	}
+}
@@ -57,12 +57,76 @@ type ocIfMetric struct {
 	LagType     string `label:"lag_type"`
 }
 
+// ocIfFlatMetric is the ocIfMetric variant emitted when the flatten_subif option is set.
+// Subinterface indexes are part of the name label (e.g.: Gi0/0.100), so the index label is omitted.
+type ocIfFlatMetric struct {
+	exporter.MetricCommons
+	Kind        string `label:"kind"`
+	Metric      string `label:"metric"`
+	CustomLabel string `label:"custom_label"`
+	IfName      string `label:"name"`
+	IfRealName  string `label:"real_name"`
+	IfType      string `label:"if_type"`
+	SnmpIndex   string `label:"if_index"`
+	Description string `label:"description"`
+	AdminStatus string `label:"admin_status"`
+	OperStatus  string `label:"oper_status"`
+	LagType     string `label:"lag_type"`
+}
+
+// flatten converts an ocIfMetric into an ocIfFlatMetric, dropping the index label.
+func (m ocIfMetric) flatten() ocIfFlatMetric {
+	return ocIfFlatMetric{
+		MetricCommons: m.MetricCommons,
+		Kind:          m.Kind,
+		Metric:        m.Metric,
+		CustomLabel:   m.CustomLabel,
+		IfName:        m.IfName,
+		IfRealName:    m.IfRealName,
+		IfType:        m.IfType,
+		SnmpIndex:     m.SnmpIndex,
+		Description:   m.Description,
+		AdminStatus:   m.AdminStatus,
+		OperStatus:    m.OperStatus,
+		LagType:       m.LagType,
+	}
+}
+
+// ocIfChannelMetric represents the mapping between an interface and its physical channels (e.g.: breakout ports).
+type ocIfChannelMetric struct {
+	exporter.MetricCommons
+	CustomLabel string `label:"custom_label"`
+	IfName      string `label:"name"`
+	Channel     string `label:"channel"`
+}
+
+// newIfChannelMetric creates a new ocIfChannelMetric info metric.
+func (f *ocIfFormatter) newIfChannelMetric() ocIfChannelMetric {
+	metric := ocIfChannelMetric{}
+	// Common fields
+	metric.Name = "oc_if_phy_channel"
+	metric.Help = "Openconfig Interfaces Physical Channels"
+	metric.Device = f.config.DevName
+	metric.Type = prometheus.GaugeValue
+	metric.Info = true
+	metric.CustomLabel = f.config.CustomLabel
+	return metric
+}
+
+// ifMetricHelp holds the help string of the ocIfMetric, by metric type.
+var ifMetricHelp = map[prometheus.ValueType]string{
+	prometheus.CounterValue: "Openconfig Interfaces counters. " +
+		"The metric label carries the counter name (e.g.: in_octets, out_pkts, in_errors)",
+	prometheus.GaugeValue: "Openconfig Interfaces gauges. " +
+		"The metric label carries the gauge name (e.g.: mtu, last_change, last_clear, lag_speed, in_utilization_ratio)",
+}
+
 // newIfMetric creates a new ocIfMetric with the given metric type.
 func (f *ocIfFormatter) newIfMetric(mType prometheus.ValueType) ocIfMetric {
 	metric := ocIfMetric{}
 	// Common fields
-	metric.Name = "oc_if"
-	metric.Help = "Openconfig Interfaces Metric"
+	metric.Name = f.metricName
+	metric.Help = ifMetricHelp[mType]
 	metric.Device = f.config.DevName
 	metric.Type = mType
 	metric.CustomLabel = f.config.CustomLabel
@@ -2,11 +2,15 @@ package ocinterfaces
 
 import (
 	"fmt"
-	log "github.com/golang/glog"
+	log "github.com/automixer/gtexporter/pkg/logger"
+	"github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/openconfig/ygot/ygot"
 	"github.com/prometheus/client_golang/prometheus"
+	"maps"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	// Local packages
 	"github.com/automixer/gtexporter/pkg/datamodels/ysocif"
@@ -21,8 +25,15 @@ const (
 	ifState    = "/interfaces/interface/state"
 	ifAggState = "/interfaces/interface/aggregation/state"
 	subIfState = "/interfaces/interface/subinterfaces/subinterface/state"
+	ifEthState = "/interfaces/interface/ethernet/state"
 )
 
+// ifEthSpeedLeaves lists the ifEthState leaves subscribed by the utilization option.
+var ifEthSpeedLeaves = []string{"negotiated-port-speed", "port-speed"}
+
+// ifStatusLeaves lists the ifState leaves subscribed in ON_CHANGE mode by the on_change_status option.
+var ifStatusLeaves = []string{"admin-status", "oper-status", "description"}
+
 // init register the parser and the formatter to the plugin registration system
 func init() {
 	err := plugins.Register(plugName, newFormatter, newParser)
@@ -31,6 +42,18 @@ func init() {
 	}
 }
 
+// subIfKey identifies a subinterface across scrapes.
+type subIfKey struct {
+	ifName string
+	index  uint32
+}
+
+// octetSample holds the octet counters of an interface and the time of the scrape they were collected in.
+type octetSample struct {
+	in, out uint64
+	time    time.Time
+}
+
 type ocIfFormatter struct {
 	config            plugins.Config
 	root              *ysocif.Root
@@ -40,6 +63,20 @@ type ocIfFormatter struct {
 	disableAgg        bool
 	disableSubInt     bool
 	fillLagMemberDesc bool
+	skipAdminDown     bool
+	pullMode          ysocif.CntMode
+	descFallback      string
+	octetBits         bool                   // Emit octet counters as bits
+	flattenSubIf      bool                   // Subinterface index merged into the name label
+	rxNameRewrite     *regexp.Regexp         // Interface name rewrite. Nil if not configured
+	nameRewriteRepl   string                 // Interface name rewrite replacement
+	subIfLastClear    map[subIfKey]uint64    // Last-clear value seen on the previous scrape
+	utilization       bool                   // Emit the in/out utilization ratio gauges
+	onChangeStatus    bool                   // Subscribe to the status leaves in ON_CHANGE mode
+	ifOctets          map[string]octetSample // Key: ifName. Octet counters seen on the previous scrape
+	scrapeTime        time.Time
+	metricName        string // Base name of the oc_if metric
+	rawEnums          map[ysocif.RawEnumKey]string
 }
 
 func newFormatter(cfg plugins.Config) (plugins.Formatter, error) {
@@ -49,9 +86,134 @@ func newFormatter(cfg plugins.Config) (plugins.Formatter, error) {
 	f.disableAgg, _ = strconv.ParseBool(f.config.Options["disable_agg"])
 	f.disableSubInt, _ = strconv.ParseBool(f.config.Options["disable_subint"])
 	f.fillLagMemberDesc, _ = strconv.ParseBool(f.config.Options["fill_lag_member_desc"])
+	f.skipAdminDown, _ = strconv.ParseBool(f.config.Options["skip_admin_down"])
+	f.flattenSubIf, _ = strconv.ParseBool(f.config.Options["flatten_subif"])
+	f.utilization, _ = strconv.ParseBool(f.config.Options["utilization"])
+	f.onChangeStatus, _ = strconv.ParseBool(f.config.Options["on_change_status"])
+
+	var err error
+	if f.metricName, err = plugins.MetricName(f.config, "oc_if"); err != nil {
+		return nil, err
+	}
+
+	// Counters pull mode
+	switch f.config.Options["counter_fill"] {
+	case "":
+		f.pullMode = ysocif.Normal
+		if f.config.UseGoDefaults {
+			f.pullMode = ysocif.UseGoDefault
+		}
+	case "present":
+		f.pullMode = ysocif.Normal
+	case "zero":
+		f.pullMode = ysocif.ZeroFill
+	case "godefault":
+		f.pullMode = ysocif.UseGoDefault
+	default:
+		return nil, fmt.Errorf("%s: invalid counter_fill value: %s", plugName, f.config.Options["counter_fill"])
+	}
+
+	// Octet counters unit
+	switch f.config.Options["octet_unit"] {
+	case "", "octets":
+	case "bits":
+		f.octetBits = true
+	default:
+		return nil, fmt.Errorf("%s: invalid octet_unit value: %s", plugName, f.config.Options["octet_unit"])
+	}
+
+	// Empty description fallback
+	f.descFallback = f.config.Options["description_fallback"]
+	switch f.descFallback {
+	case "", "empty", "name", "index":
+	default:
+		return nil, fmt.Errorf("%s: invalid description_fallback value: %s", plugName, f.descFallback)
+	}
+
+	// Interface name rewrite
+	if f.config.Options["name_rewrite"] != "" {
+		var err error
+		f.rxNameRewrite, err = regexp.Compile(f.config.Options["name_rewrite"])
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid name_rewrite regexp: %w", plugName, err)
+		}
+		f.nameRewriteRepl = f.config.Options["name_rewrite_replace"]
+	}
 	return f, nil
 }
 
+// rewriteName applies the name_rewrite option to an interface name label value.
+// It is applied to labels only: GoStruct lookups and LAG tables keep using the names received from the device.
+func (f *ocIfFormatter) rewriteName(name string) string {
+	if f.rxNameRewrite == nil || name == "" {
+		return name
+	}
+	return f.rxNameRewrite.ReplaceAllString(name, f.nameRewriteRepl)
+}
+
+// export returns the metric to be sent to the exporter, flattened if the flatten_subif option is set.
+// All the oc_if metrics must share the same label set, so interface metrics are flattened as well.
+func (f *ocIfFormatter) export(metric ocIfMetric) exporter.GMetric {
+	if f.flattenSubIf {
+		return metric.flatten()
+	}
+	return metric
+}
+
+// subIfName returns the name and index label values of a subinterface, as per the flatten_subif option.
+func (f *ocIfFormatter) subIfName(name string, index uint32) (string, string) {
+	if f.flattenSubIf {
+		if name == "" {
+			return "", ""
+		}
+		return fmt.Sprintf("%s.%d", name, index), ""
+	}
+	return name, fmt.Sprint(index)
+}
+
+// octetsToBits converts the in-octets and out-octets counters into in-bits and out-bits, if required.
+// Other counters are left untouched.
+func (f *ocIfFormatter) octetsToBits(counters map[string]float64) {
+	if !f.octetBits {
+		return
+	}
+	for octets, bits := range map[string]string{"in-octets": "in-bits", "out-octets": "out-bits"} {
+		if value, ok := counters[octets]; ok {
+			counters[bits] = value * 8
+			delete(counters, octets)
+		}
+	}
+}
+
+// description returns the description label value, applying the configured fallback if desc is empty.
+func (f *ocIfFormatter) description(desc, name, snmpIndex string) string {
+	if desc != "" {
+		return desc
+	}
+	switch f.descFallback {
+	case "name":
+		return name
+	case "index":
+		return snmpIndex
+	default:
+		return ""
+	}
+}
+
+// enumLabel returns the label value of an enum leaf. If the value is not known by the yGot GoStruct,
+// it falls back to the raw string received from the device, if kept by the parser.
+// index is nil for interfaces.
+func (f *ocIfFormatter) enumLabel(value, ifName string, index *uint32, leaf string) string {
+	if value != "" {
+		return value
+	}
+	key := ysocif.RawEnumKey{IfName: ifName, Leaf: leaf}
+	if index != nil {
+		key.Index, key.IsSubIf = *index, true
+	}
+	return f.rawEnums[key]
+}
+
 // GetPaths returns the XPaths and datamodels for the ocIfFormatter package.
 // It implements the plugin's formatter interface
 func (f *ocIfFormatter) GetPaths() plugins.FormatterPaths {
@@ -60,21 +222,35 @@ func (f *ocIfFormatter) GetPaths() plugins.FormatterPaths {
 	ifList := strings.Split(ifaces, ",")
 
 	// Build the xPath lists
-	var ifPaths, subIfPaths []string
+	var ifPaths, subIfPaths, ethPaths []string
 	if ifList[0] == "" {
 		ifPaths = []string{ifState}
 		subIfPaths = []string{subIfState}
+		ethPaths = []string{ifEthState}
 	} else {
 		for _, name := range ifList {
 			// Interfaces
 			p := strings.ReplaceAll(ifState, "/interface/", "/interface[name="+name+"]/")
 			ifPaths = append(ifPaths, p)
+			// Ethernet
+			p = strings.ReplaceAll(ifEthState, "/interface/", "/interface[name="+name+"]/")
+			ethPaths = append(ethPaths, p)
 			// Subinterfaces
 			p = strings.ReplaceAll(subIfState, "/interface/", "/interface[name="+name+"]/")
 			subIfPaths = append(subIfPaths, p)
 		}
 	}
 
+	// Parent interface filtering for subinterfaces. Overrides gnmi_filter if set
+	subIfaces := strings.ReplaceAll(f.config.Options["subint_gnmi_filter"], " ", "")
+	if subIfaces != "" {
+		subIfPaths = nil
+		for _, name := range strings.Split(subIfaces, ",") {
+			p := strings.ReplaceAll(subIfState, "/interface/", "/interface[name="+name+"]/")
+			subIfPaths = append(subIfPaths, p)
+		}
+	}
+
 	// Create the path list to be subscribed
 	fp := plugins.FormatterPaths{
 		Datamodel: dataModel,
@@ -82,6 +258,24 @@ func (f *ocIfFormatter) GetPaths() plugins.FormatterPaths {
 	// If not disabled, subscribe to interface state
 	if !f.disableInt {
 		fp.XPaths = append(fp.XPaths, ifPaths...)
+		// Status leaves are also subscribed in ON_CHANGE mode, to catch the transitions between samples
+		if f.onChangeStatus {
+			fp.Modes = make(map[string]gnmi.SubscriptionMode)
+			for _, p := range ifPaths {
+				for _, leaf := range ifStatusLeaves {
+					fp.XPaths = append(fp.XPaths, p+"/"+leaf)
+					fp.Modes[p+"/"+leaf] = gnmi.SubscriptionMode_ON_CHANGE
+				}
+			}
+		}
+		// Port speed is required to compute the utilization
+		if f.utilization {
+			for _, p := range ethPaths {
+				for _, leaf := range ifEthSpeedLeaves {
+					fp.XPaths = append(fp.XPaths, p+"/"+leaf)
+				}
+			}
+		}
 	}
 	// If not disabled, subscribe to interface aggregation state
 	if !f.disableAgg {
@@ -99,6 +293,8 @@ func (f *ocIfFormatter) GetPaths() plugins.FormatterPaths {
 // It is called by the plugin when a scrape event occurs.
 func (f *ocIfFormatter) ScrapeEvent(ys ygot.GoStruct) func() {
 	f.root = ysocif.GoStructToOcIf(ys)
+	f.scrapeTime = time.Now()
+	f.rawEnums = ysocif.GoStructToRawEnums(ys)
 
 	// Build LAG tables
 	f.lagTable = make(map[string]string, 128)
@@ -113,6 +309,7 @@ func (f *ocIfFormatter) ScrapeEvent(ys ygot.GoStruct) func() {
 	return func() {
 		f.lagTable = nil
 		f.lagSet = nil
+		f.rawEnums = nil
 		f.root = nil
 	}
 }
@@ -121,8 +318,9 @@ func (f *ocIfFormatter) ScrapeEvent(ys ygot.GoStruct) func() {
 // It returns a slice of GMetric to describe the metrics itself.
 func (f *ocIfFormatter) Describe() []exporter.GMetric {
 	return []exporter.GMetric{
-		f.newIfMetric(prometheus.CounterValue),
-		f.newIfMetric(prometheus.GaugeValue),
+		f.export(f.newIfMetric(prometheus.CounterValue)),
+		f.export(f.newIfMetric(prometheus.GaugeValue)),
+		f.newIfChannelMetric(),
 	}
 }
 
@@ -134,6 +332,7 @@ func (f *ocIfFormatter) Collect() []exporter.GMetric {
 	if !f.disableInt {
 		out = append(out, f.ifCounters()...)
 		out = append(out, f.ifGauges()...)
+		out = append(out, f.ifChannels()...)
 	}
 
 	if !f.disableSubInt {
@@ -144,6 +343,21 @@ func (f *ocIfFormatter) Collect() []exporter.GMetric {
 	return out
 }
 
+// ifChannels scans the yGot GoStruct and returns a slice of interface/state/physical-channel info metrics.
+// One series is emitted for each physical channel of an interface.
+func (f *ocIfFormatter) ifChannels() []exporter.GMetric {
+	out := make([]exporter.GMetric, 0)
+	for name, iface := range f.root.Interface {
+		for _, channel := range iface.GetPhysicalChannel() {
+			metric := f.newIfChannelMetric()
+			metric.IfName = f.rewriteName(name)
+			metric.Channel = fmt.Sprint(channel)
+			out = append(out, metric)
+		}
+	}
+	return out
+}
+
 // ifCounters scans the yGot GoStruct and returns a slice of interface/counters metrics
 func (f *ocIfFormatter) ifCounters() []exporter.GMetric {
 	out := make([]exporter.GMetric, 0, len(f.root.Interface))
@@ -152,9 +366,14 @@ func (f *ocIfFormatter) ifCounters() []exporter.GMetric {
 		alias := name
 		kind := kindIface
 
+		// Skip admin down interfaces if required
+		if f.skipAdminDown && iface.GetAdminStatus() == ysocif.Interface_AdminStatus_DOWN {
+			continue
+		}
+
 		// Check if the interface is a LAG
 		if f.lagSet[name] {
-			lagType = iface.GetAggregation().GetLagType().ShortString()
+			lagType = f.enumLabel(iface.GetAggregation().GetLagType().ShortString(), name, nil, "lag-type")
 			kind = kindIfaceLag
 		}
 
@@ -165,45 +384,81 @@ func (f *ocIfFormatter) ifCounters() []exporter.GMetric {
 			kind = kindIfaceLagMember
 		}
 
-		// Set counters pull mode
-		pullMode := ysocif.Normal
-		if f.config.UseGoDefaults {
-			pullMode = ysocif.UseGoDefault
-		}
+		// Set counters pull mode. LAG counters are always forced to zero
+		pullMode := f.pullMode
 		if f.lagSet[name] {
 			pullMode = ysocif.ForceToZero
 		}
 
 		// Get counters
 		ifCnt := ysocif.GetCountersFromStruct(*iface.GetCounters(), pullMode)
+		f.octetsToBits(ifCnt)
 		for counterName, counterValue := range ifCnt {
 			metric := f.newIfMetric(prometheus.CounterValue)
 			// Labels
 			metric.Kind = kind.String()
-			metric.IfName = alias
-			metric.IfRealName = realName
+			metric.IfName = f.rewriteName(alias)
+			metric.IfRealName = f.rewriteName(realName)
 			metric.SnmpIndex = fmt.Sprint(iface.GetIfindex())
-			metric.AdminStatus = iface.GetAdminStatus().ShortString()
-			metric.OperStatus = iface.GetOperStatus().ShortString()
-			metric.IfType = iface.GetType().ShortString()
+			metric.AdminStatus = f.enumLabel(iface.GetAdminStatus().ShortString(), name, nil, "admin-status")
+			metric.OperStatus = f.enumLabel(iface.GetOperStatus().ShortString(), name, nil, "oper-status")
+			metric.IfType = f.enumLabel(iface.GetType().ShortString(), name, nil, "type")
 			metric.LagType = lagType
 			metric.Description = iface.GetDescription()
 			if f.fillLagMemberDesc && metric.Description == "" && kind == kindIfaceLagMember {
 				// Copy the parent's description
 				metric.Description = f.root.Interface[alias].GetDescription()
 			}
+			metric.Description = f.description(metric.Description, f.rewriteName(name), metric.SnmpIndex)
 			// Values
 			metric.Metric = counterName
 			metric.Value = counterValue
-			out = append(out, metric)
+			out = append(out, f.export(metric))
 		}
 	}
 	return out
 }
 
-// ifGauges scans the yGot GoStruct and returns a slice with the interface gauges metrics
+// ifUtilization returns the in and out utilization ratio gauges of an interface, computed from the
+// octet counters increase since the previous scrape and the port speed. It returns nil if the port speed
+// is unknown or on the first scrape. The octet counters are stored into octetsTable for the next scrape.
+func (f *ocIfFormatter) ifUtilization(name string, iface *ysocif.Interface, octetsTable map[string]octetSample) map[string]float64 {
+	counters := iface.GetCounters()
+	if counters.InOctets == nil || counters.OutOctets == nil {
+		return nil
+	}
+	sample := octetSample{in: counters.GetInOctets(), out: counters.GetOutOctets(), time: f.scrapeTime}
+	octetsTable[name] = sample
+
+	// Negotiated speed first
+	speed := iface.GetEthernet().GetNegotiatedPortSpeed().Bps()
+	if speed == 0 {
+		speed = iface.GetEthernet().GetPortSpeed().Bps()
+	}
+	prev, ok := f.ifOctets[name]
+	if !ok || speed == 0 || sample.in < prev.in || sample.out < prev.out {
+		// Unknown speed, first scrape or counters reset
+		return nil
+	}
+	elapsed := sample.time.Sub(prev.time).Seconds()
+	if elapsed <= 0 {
+		return nil
+	}
+	return map[string]float64{
+		"in_utilization_ratio":  float64(sample.in-prev.in) * 8 / elapsed / speed,
+		"out_utilization_ratio": float64(sample.out-prev.out) * 8 / elapsed / speed,
+	}
+}
+
+// ifGauges scans the yGot GoStruct and returns a slice with the interface gauges metrics.
+// If the utilization option is set, the in/out utilization ratio gauges of non LAG interfaces are added.
 func (f *ocIfFormatter) ifGauges() []exporter.GMetric {
 	out := make([]exporter.GMetric, 0, len(f.root.Interface))
+	octetsTable := make(map[string]octetSample, len(f.ifOctets))
+	defer func() {
+		// Forget the interfaces no longer reported
+		f.ifOctets = octetsTable
+	}()
 	for name, iface := range f.root.Interface {
 		var lagType, realName string
 		alias := name
@@ -217,10 +472,14 @@ func (f *ocIfFormatter) ifGauges() []exporter.GMetric {
 			"lag_speed":     float64(iface.GetAggregation().GetLagSpeed()),
 			"lag_min_links": float64(iface.GetAggregation().GetMinLinks()),
 		}
+		// LAG counters are forced to zero, so their utilization is not computed
+		if f.utilization && !f.lagSet[name] {
+			maps.Copy(gauges, f.ifUtilization(name, iface, octetsTable))
+		}
 
 		// Check if the interface is a LAG
 		if f.lagSet[name] {
-			lagType = iface.GetAggregation().GetLagType().ShortString()
+			lagType = f.enumLabel(iface.GetAggregation().GetLagType().ShortString(), name, nil, "lag-type")
 			kind = kindIfaceLag
 		}
 
@@ -236,22 +495,23 @@ func (f *ocIfFormatter) ifGauges() []exporter.GMetric {
 			metric := f.newIfMetric(prometheus.GaugeValue)
 			// Labels
 			metric.Kind = kind.String()
-			metric.IfName = alias
-			metric.IfRealName = realName
+			metric.IfName = f.rewriteName(alias)
+			metric.IfRealName = f.rewriteName(realName)
 			metric.SnmpIndex = fmt.Sprint(iface.GetIfindex())
-			metric.AdminStatus = iface.GetAdminStatus().ShortString()
-			metric.OperStatus = iface.GetOperStatus().ShortString()
-			metric.IfType = iface.GetType().ShortString()
+			metric.AdminStatus = f.enumLabel(iface.GetAdminStatus().ShortString(), name, nil, "admin-status")
+			metric.OperStatus = f.enumLabel(iface.GetOperStatus().ShortString(), name, nil, "oper-status")
+			metric.IfType = f.enumLabel(iface.GetType().ShortString(), name, nil, "type")
 			metric.LagType = lagType
 			metric.Description = iface.GetDescription()
 			if f.fillLagMemberDesc && metric.Description == "" && kind == kindIfaceLagMember {
 				// Copy the parent's description
 				metric.Description = f.root.Interface[alias].GetDescription()
 			}
+			metric.Description = f.description(metric.Description, f.rewriteName(name), metric.SnmpIndex)
 			// Values
 			metric.Metric = gaugeName
 			metric.Value = gaugeValue
-			out = append(out, metric)
+			out = append(out, f.export(metric))
 		}
 	}
 	return out
@@ -267,7 +527,7 @@ func (f *ocIfFormatter) subIfCounters() []exporter.GMetric {
 
 		// Check if the interface is a LAG
 		if f.lagSet[name] {
-			lagType = iface.GetAggregation().GetLagType().ShortString()
+			lagType = f.enumLabel(iface.GetAggregation().GetLagType().ShortString(), name, nil, "lag-type")
 			kind = kindSubIfaceLag
 		}
 
@@ -278,48 +538,56 @@ func (f *ocIfFormatter) subIfCounters() []exporter.GMetric {
 			kind = kindSubIfaceLagMember
 		}
 
-		// Set counters pull mode
-		pullMode := ysocif.Normal
-		if f.config.UseGoDefaults {
-			pullMode = ysocif.UseGoDefault
-		}
+		// Set counters pull mode. LAG counters are always forced to zero
+		pullMode := f.pullMode
 		if f.lagSet[name] {
 			pullMode = ysocif.ForceToZero
 		}
 
 		// Walk subinterfaces
 		for index, subIface := range f.root.Interface[name].Subinterface {
+			// Skip admin down subinterfaces if required
+			if f.skipAdminDown && subIface.GetAdminStatus() == ysocif.Interface_AdminStatus_DOWN {
+				continue
+			}
 			// Get counters
 			ifCnt := ysocif.GetCountersFromStruct(*subIface.GetCounters(), pullMode)
+			f.octetsToBits(ifCnt)
 			for counterName, counterValue := range ifCnt {
 				metric := f.newIfMetric(prometheus.CounterValue)
 				// Labels
 				metric.Kind = kind.String()
-				metric.IfName = alias
-				metric.IfRealName = realName
-				metric.IfIndex = fmt.Sprint(index)
+				metric.IfName, metric.IfIndex = f.subIfName(f.rewriteName(alias), index)
+				metric.IfRealName, _ = f.subIfName(f.rewriteName(realName), index)
 				metric.SnmpIndex = fmt.Sprint(subIface.GetIfindex())
-				metric.AdminStatus = subIface.GetAdminStatus().ShortString()
-				metric.OperStatus = subIface.GetOperStatus().ShortString()
+				metric.AdminStatus = f.enumLabel(subIface.GetAdminStatus().ShortString(), name, &index, "admin-status")
+				metric.OperStatus = f.enumLabel(subIface.GetOperStatus().ShortString(), name, &index, "oper-status")
 				metric.LagType = lagType
 				metric.Description = subIface.GetDescription()
 				if f.fillLagMemberDesc && metric.Description == "" && kind == kindSubIfaceLagMember {
 					// Copy the parent's description
 					metric.Description = f.root.Interface[alias].Subinterface[index].GetDescription()
 				}
+				metric.Description = f.description(metric.Description, fmt.Sprintf("%s.%d", f.rewriteName(name), index), metric.SnmpIndex)
 				// Values
 				metric.Metric = counterName
 				metric.Value = counterValue
-				out = append(out, metric)
+				out = append(out, f.export(metric))
 			}
 		}
 	}
 	return out
 }
 
-// subIfGauges scans the yGot GoStruct and returns a slice with the subinterfaces gauges metrics
+// subIfGauges scans the yGot GoStruct and returns a slice with the subinterfaces gauges metrics.
+// The counters_reset gauge reports the last_clear increase since the previous scrape (0 if unchanged).
 func (f *ocIfFormatter) subIfGauges() []exporter.GMetric {
 	out := make([]exporter.GMetric, 0, len(f.root.Interface))
+	lastClearTable := make(map[subIfKey]uint64, len(f.subIfLastClear))
+	defer func() {
+		// Forget the subinterfaces no longer reported
+		f.subIfLastClear = lastClearTable
+	}()
 	for name, iface := range f.root.Interface {
 		var lagType, realName string
 		alias := name
@@ -327,7 +595,7 @@ func (f *ocIfFormatter) subIfGauges() []exporter.GMetric {
 
 		// Check if the interface is a LAG
 		if f.lagSet[name] {
-			lagType = iface.GetAggregation().GetLagType().ShortString()
+			lagType = f.enumLabel(iface.GetAggregation().GetLagType().ShortString(), name, nil, "lag-type")
 			kind = kindSubIfaceLag
 		}
 
@@ -340,34 +608,44 @@ func (f *ocIfFormatter) subIfGauges() []exporter.GMetric {
 
 		// Walk subinterfaces
 		for index, subIface := range f.root.Interface[name].Subinterface {
+			// Counters reset detection
+			lastClear := subIface.GetCounters().GetLastClear()
+			key := subIfKey{ifName: name, index: index}
+			var reset float64
+			if prev, ok := f.subIfLastClear[key]; ok && lastClear > prev {
+				reset = float64(lastClear - prev)
+			}
+			lastClearTable[key] = lastClear
+
 			// Build gauges value map
 			gauges := map[string]float64{
-				"last_change":   float64(subIface.GetLastChange()),
-				"last_clear":    float64(subIface.GetCounters().GetLastClear()),
-				"lag_speed":     float64(iface.GetAggregation().GetLagSpeed()),
-				"lag_min_links": float64(iface.GetAggregation().GetMinLinks()),
+				"last_change":    float64(subIface.GetLastChange()),
+				"last_clear":     float64(lastClear),
+				"counters_reset": reset,
+				"lag_speed":      float64(iface.GetAggregation().GetLagSpeed()),
+				"lag_min_links":  float64(iface.GetAggregation().GetMinLinks()),
 			}
 			// Build gauge metrics
 			for gaugeName, gaugeValue := range gauges {
 				metric := f.newIfMetric(prometheus.GaugeValue)
 				// Labels
 				metric.Kind = kind.String()
-				metric.IfName = alias
-				metric.IfRealName = realName
-				metric.IfIndex = fmt.Sprint(index)
+				metric.IfName, metric.IfIndex = f.subIfName(f.rewriteName(alias), index)
+				metric.IfRealName, _ = f.subIfName(f.rewriteName(realName), index)
 				metric.SnmpIndex = fmt.Sprint(subIface.GetIfindex())
-				metric.AdminStatus = subIface.GetAdminStatus().ShortString()
-				metric.OperStatus = subIface.GetOperStatus().ShortString()
+				metric.AdminStatus = f.enumLabel(subIface.GetAdminStatus().ShortString(), name, &index, "admin-status")
+				metric.OperStatus = f.enumLabel(subIface.GetOperStatus().ShortString(), name, &index, "oper-status")
 				metric.LagType = lagType
 				metric.Description = subIface.GetDescription()
 				if f.fillLagMemberDesc && metric.Description == "" && kind == kindSubIfaceLagMember {
 					// Copy the parent's description
 					metric.Description = f.root.Interface[alias].Subinterface[index].GetDescription()
 				}
+				metric.Description = f.description(metric.Description, fmt.Sprintf("%s.%d", f.rewriteName(name), index), metric.SnmpIndex)
 				// Values
 				metric.Metric = gaugeName
 				metric.Value = gaugeValue
-				out = append(out, metric)
+				out = append(out, f.export(metric))
 			}
 		}
 	}
@@ -8,12 +8,27 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	// Local packages
 	"github.com/automixer/gtexporter/pkg/datamodels/ysocif"
 	"github.com/automixer/gtexporter/pkg/plugins"
 )
 
+// nativeRateLeaves lists the vendor specific rate leaves found in the counters containers.
+// They are not part of the openconfig-interfaces model and are ignored (rates are computed by Prometheus),
+// but they are known and must not be counted as LeafNotFound.
+var nativeRateLeaves = map[string]bool{
+	"in-rate":         true,
+	"out-rate":        true,
+	"in-bits-rate":    true,
+	"out-bits-rate":   true,
+	"in-pkts-rate":    true,
+	"out-pkts-rate":   true,
+	"in-octets-rate":  true,
+	"out-octets-rate": true,
+}
+
 const yStructInitialSize = 128
 
 // pathMetadata represents metadata extracted from a GNMI path.
@@ -28,17 +43,21 @@ type pathMetadata struct {
 
 type ocIfParser struct {
 	plugins.ParserMon
-	yStruct        *ysocif.Root
-	eMapper        *ysocif.EnumMapper
-	rxSD           *regexp.Regexp // Description sanitize
-	rxName         *regexp.Regexp // Interface name filter
-	rxIndex        *regexp.Regexp // subInterface index filter
-	disableDeletes bool
+	yStruct       *ysocif.Root
+	eMapper       *ysocif.EnumMapper
+	rxSD          *regexp.Regexp               // Description sanitize
+	rxName        *regexp.Regexp               // Interface name filter
+	rxNameExclude *regexp.Regexp               // Interface name exclusion filter. Nil if not configured
+	rxIndex       *regexp.Regexp               // subInterface index filter
+	keepUnknown   bool                         // Keep the raw string of unknown enum values
+	rawEnums      map[ysocif.RawEnumKey]string // Raw strings of unknown enum values
+	lastSeen      map[string]time.Time         // Key: ifName. Time of the last update received
+	nfTime        time.Time                    // Receive time of the notification being parsed
 }
 
 func newParser(cfg plugins.Config) (plugins.Parser, error) {
 	p := &ocIfParser{}
-	p.disableDeletes, _ = strconv.ParseBool(cfg.Options["disable_gnmi_delete"])
+	p.keepUnknown, _ = strconv.ParseBool(cfg.Options["keep_unknown_enums"])
 
 	// Load parser self-monitoring
 	if err := p.ParserMon.Configure(cfg); err != nil {
@@ -49,6 +68,8 @@ func newParser(cfg plugins.Config) (plugins.Parser, error) {
 	p.yStruct = &ysocif.Root{
 		Interface: make(map[string]*ysocif.Interface, yStructInitialSize),
 	}
+	p.rawEnums = make(map[ysocif.RawEnumKey]string)
+	p.lastSeen = make(map[string]time.Time, yStructInitialSize)
 	p.eMapper = ysocif.NewEnumMapper()
 
 	// Descriptions sanitization
@@ -68,6 +89,14 @@ func newParser(cfg plugins.Config) (plugins.Parser, error) {
 		p.rxName = regexp.MustCompile(".*")
 	}
 
+	// Interface name exclusion filter
+	if cfg.Options["name_exclude_filter"] != "" {
+		p.rxNameExclude, err = regexp.Compile(cfg.Options["name_exclude_filter"])
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// SubInterface index filter
 	if cfg.Options["index_filter"] != "" {
 		p.rxIndex, err = regexp.Compile(cfg.Options["index_filter"])
@@ -81,12 +110,24 @@ func newParser(cfg plugins.Config) (plugins.Parser, error) {
 	return p, nil
 }
 
+// nameAllowed reports whether the interface name passes the name filters.
+// The exclusion filter wins over name_filter.
+func (p *ocIfParser) nameAllowed(name string) bool {
+	if p.rxNameExclude != nil && p.rxNameExclude.MatchString(name) {
+		return false
+	}
+	return p.rxName.MatchString(name)
+}
+
 // CheckOut returns the current yGot structure.
 // It implements the plugin's parser interface
 func (p *ocIfParser) CheckOut() ygot.GoStruct {
 	if p.yStruct == nil {
 		panic(fmt.Sprint("yGot structure not initialized"))
 	}
+	if p.keepUnknown {
+		return &ysocif.RawEnumRoot{Root: p.yStruct, RawEnums: p.rawEnums}
+	}
 	return p.yStruct
 }
 
@@ -96,16 +137,20 @@ func (p *ocIfParser) ParseNotification(nf *gnmi.Notification) {
 	if p.yStruct == nil {
 		panic(fmt.Sprint("yGot structure not initialized"))
 	}
+	p.nfTime = time.Now()
 
 	// Process GNMI delete messages
-	if !p.disableDeletes {
-		for _, gDelete := range nf.Delete {
-			p.removeDbEntry(nf.Prefix, gDelete)
-		}
+	for _, gDelete := range nf.Delete {
+		p.removeDbEntry(nf.Prefix, gDelete)
 	}
 
 	// Process GNMI update messages
 	for i, update := range nf.Update {
+		// An update without a value would overwrite the cached leaf with a zero value
+		if update.GetVal() == nil {
+			p.NilValue()
+			continue
+		}
 		updHandler := p.updHandlerLookup(nf.Prefix, update.Path)
 		if updHandler == nil {
 			continue
@@ -120,6 +165,46 @@ func (p *ocIfParser) ClearCache() {
 	p.yStruct = &ysocif.Root{
 		Interface: make(map[string]*ysocif.Interface, yStructInitialSize),
 	}
+	p.rawEnums = make(map[ysocif.RawEnumKey]string)
+	p.lastSeen = make(map[string]time.Time, yStructInitialSize)
+}
+
+// ClearStale removes the interfaces, with their subinterfaces, not updated by the device since the given time.
+// It implements the plugin's StaleParser interface and returns the number of removed interfaces.
+func (p *ocIfParser) ClearStale(before time.Time) int {
+	removed := 0
+	for ifName, seen := range p.lastSeen {
+		if !seen.Before(before) {
+			continue
+		}
+		delete(p.lastSeen, ifName)
+		if _, ok := p.yStruct.Interface[ifName]; !ok {
+			// Already deleted by the device
+			continue
+		}
+		p.yStruct.DeleteInterface(ifName)
+		for key := range p.rawEnums {
+			if key.IfName == ifName {
+				delete(p.rawEnums, key)
+			}
+		}
+		removed++
+	}
+	return removed
+}
+
+// enumValue maps the raw string of an enum leaf to its value, counting the unknown ones.
+// If the keep_unknown_enums option is set, the raw string of unknown values is kept for the formatter.
+func (p *ocIfParser) enumValue(raw string, e ygot.GoEnum, key ysocif.RawEnumKey) int64 {
+	value := p.CheckEnum(p.eMapper.GetEnumFromString(raw, e), raw)
+	if p.keepUnknown {
+		if value == 0 && raw != "" {
+			p.rawEnums[key] = raw
+		} else {
+			delete(p.rawEnums, key)
+		}
+	}
+	return value
 }
 
 // removeDbEntry processes the GNMI delete messages
@@ -182,6 +267,8 @@ func (p *ocIfParser) updHandlerLookup(pfx, path *gnmi.Path) func(*gnmi.Notificat
 		return p.subIfStateCounters
 	case ifAggState:
 		return p.ifAggState
+	case ifEthState:
+		return p.ifEthState
 	default:
 		p.ContainerNotFound()
 	}
@@ -259,9 +346,10 @@ func (p *ocIfParser) ifStateCounters(nf *gnmi.Notification, updNum int) {
 	}
 
 	// Name filtering
-	if !p.rxName.MatchString(pathMeta.ifName) {
+	if !p.nameAllowed(pathMeta.ifName) {
 		return
 	}
+	p.lastSeen[pathMeta.ifName] = p.nfTime
 
 	// Create the interface if missing
 	if _, ok := p.yStruct.Interface[pathMeta.ifName]; !ok {
@@ -276,45 +364,47 @@ func (p *ocIfParser) ifStateCounters(nf *gnmi.Notification, updNum int) {
 	target := p.yStruct.Interface[pathMeta.ifName].Counters
 	switch pathMeta.leafName {
 	case "carrier-transitions":
-		target.CarrierTransitions = ygot.Uint64(source.GetUintVal())
+		target.CarrierTransitions = ygot.Uint64(plugins.UintVal(source))
 	case "in-broadcast-pkts":
-		target.InBroadcastPkts = ygot.Uint64(source.GetUintVal())
+		target.InBroadcastPkts = ygot.Uint64(plugins.UintVal(source))
 	case "in-discards":
-		target.InDiscards = ygot.Uint64(source.GetUintVal())
+		target.InDiscards = ygot.Uint64(plugins.UintVal(source))
 	case "in-errors":
-		target.InErrors = ygot.Uint64(source.GetUintVal())
+		target.InErrors = ygot.Uint64(plugins.UintVal(source))
 	case "in-fcs-errors":
-		target.InFcsErrors = ygot.Uint64(source.GetUintVal())
+		target.InFcsErrors = ygot.Uint64(plugins.UintVal(source))
 	case "in-multicast-pkts":
-		target.InMulticastPkts = ygot.Uint64(source.GetUintVal())
+		target.InMulticastPkts = ygot.Uint64(plugins.UintVal(source))
 	case "in-octets":
-		target.InOctets = ygot.Uint64(source.GetUintVal())
+		target.InOctets = ygot.Uint64(plugins.UintVal(source))
 	case "in-pkts":
-		target.InPkts = ygot.Uint64(source.GetUintVal())
+		target.InPkts = ygot.Uint64(plugins.UintVal(source))
 	case "in-unicast-pkts":
-		target.InUnicastPkts = ygot.Uint64(source.GetUintVal())
+		target.InUnicastPkts = ygot.Uint64(plugins.UintVal(source))
 	case "in-unknown-protos":
-		target.InUnknownProtos = ygot.Uint64(source.GetUintVal())
+		target.InUnknownProtos = ygot.Uint64(plugins.UintVal(source))
 	case "last-clear":
-		target.LastClear = ygot.Uint64(source.GetUintVal())
+		target.LastClear = ygot.Uint64(plugins.UintVal(source))
 	case "out-broadcast-pkts":
-		target.OutBroadcastPkts = ygot.Uint64(source.GetUintVal())
+		target.OutBroadcastPkts = ygot.Uint64(plugins.UintVal(source))
 	case "out-discards":
-		target.OutDiscards = ygot.Uint64(source.GetUintVal())
+		target.OutDiscards = ygot.Uint64(plugins.UintVal(source))
 	case "out-errors":
-		target.OutErrors = ygot.Uint64(source.GetUintVal())
+		target.OutErrors = ygot.Uint64(plugins.UintVal(source))
 	case "out-multicast-pkts":
-		target.OutMulticastPkts = ygot.Uint64(source.GetUintVal())
+		target.OutMulticastPkts = ygot.Uint64(plugins.UintVal(source))
 	case "out-octets":
-		target.OutOctets = ygot.Uint64(source.GetUintVal())
+		target.OutOctets = ygot.Uint64(plugins.UintVal(source))
 	case "out-pkts":
-		target.OutPkts = ygot.Uint64(source.GetUintVal())
+		target.OutPkts = ygot.Uint64(plugins.UintVal(source))
 	case "out-unicast-pkts":
-		target.OutUnicastPkts = ygot.Uint64(source.GetUintVal())
+		target.OutUnicastPkts = ygot.Uint64(plugins.UintVal(source))
 	case "resets":
-		target.Resets = ygot.Uint64(source.GetUintVal())
+		target.Resets = ygot.Uint64(plugins.UintVal(source))
 	default:
-		p.LeafNotFound()
+		if !nativeRateLeaves[pathMeta.leafName] {
+			p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
+		}
 	}
 }
 
@@ -327,9 +417,10 @@ func (p *ocIfParser) ifState(nf *gnmi.Notification, updNum int) {
 	}
 
 	// Name filtering
-	if !p.rxName.MatchString(pathMeta.ifName) {
+	if !p.nameAllowed(pathMeta.ifName) {
 		return
 	}
+	p.lastSeen[pathMeta.ifName] = p.nfTime
 
 	// Create the interface if missing
 	if _, ok := p.yStruct.Interface[pathMeta.ifName]; !ok {
@@ -345,38 +436,48 @@ func (p *ocIfParser) ifState(nf *gnmi.Notification, updNum int) {
 	switch pathMeta.leafName {
 	case "admin-status":
 		target.AdminStatus = ysocif.E_Interface_AdminStatus(
-			p.eMapper.GetEnumFromString(source.GetStringVal(), target.AdminStatus))
+			p.enumValue(plugins.StringVal(source), target.AdminStatus,
+				ysocif.RawEnumKey{IfName: pathMeta.ifName, Leaf: pathMeta.leafName}))
 	case "cpu":
-		target.Cpu = ygot.Bool(source.GetBoolVal())
+		target.Cpu = ygot.Bool(plugins.BoolVal(source))
 	case "description":
-		target.Description = ygot.String(p.sanitizeDescription(source.GetStringVal()))
+		target.Description = ygot.String(p.sanitizeDescription(plugins.StringVal(source)))
 	case "enabled":
-		target.Enabled = ygot.Bool(source.GetBoolVal())
+		target.Enabled = ygot.Bool(plugins.BoolVal(source))
 	case "ifindex":
-		target.Ifindex = ygot.Uint32(uint32(source.GetUintVal()))
+		target.Ifindex = ygot.Uint32(uint32(plugins.UintVal(source)))
 	case "last-change":
-		target.LastChange = ygot.Uint64(source.GetUintVal())
+		target.LastChange = ygot.Uint64(plugins.UintVal(source))
 	case "logical":
-		target.Logical = ygot.Bool(source.GetBoolVal())
+		target.Logical = ygot.Bool(plugins.BoolVal(source))
 	case "loopback-mode":
 		target.LoopbackMode = ysocif.E_OpenconfigInterfaces_LoopbackModeType(
-			p.eMapper.GetEnumFromString(source.GetStringVal(), target.LoopbackMode))
+			p.enumValue(plugins.StringVal(source), target.LoopbackMode,
+				ysocif.RawEnumKey{IfName: pathMeta.ifName, Leaf: pathMeta.leafName}))
 	case "management":
-		target.Management = ygot.Bool(source.GetBoolVal())
+		target.Management = ygot.Bool(plugins.BoolVal(source))
 	case "mtu":
-		target.Mtu = ygot.Uint16(uint16(source.GetUintVal()))
+		target.Mtu = ygot.Uint16(uint16(plugins.UintVal(source)))
 	case "name":
-		target.Name = ygot.String(source.GetStringVal())
+		target.Name = ygot.String(plugins.StringVal(source))
 	case "oper-status":
 		target.OperStatus = ysocif.E_Interface_OperStatus(
-			p.eMapper.GetEnumFromString(source.GetStringVal(), target.OperStatus))
+			p.enumValue(plugins.StringVal(source), target.OperStatus,
+				ysocif.RawEnumKey{IfName: pathMeta.ifName, Leaf: pathMeta.leafName}))
+	case "physical-channel":
+		// The leaf-list is received as a whole: replace the previous content
+		target.PhysicalChannel = nil
+		for _, channel := range plugins.LeaflistVal(source) {
+			target.PhysicalChannel = append(target.PhysicalChannel, uint16(plugins.UintVal(channel)))
+		}
 	case "tpid":
 		// tpid isn't handled but present to avoid false LeafNotFound() counting
 	case "type":
 		target.Type = ysocif.E_IETFInterfaces_InterfaceType(
-			p.eMapper.GetEnumFromString(source.GetStringVal(), target.Type))
+			p.enumValue(plugins.StringVal(source), target.Type,
+				ysocif.RawEnumKey{IfName: pathMeta.ifName, Leaf: pathMeta.leafName}))
 	default:
-		p.LeafNotFound()
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
 	}
 }
 
@@ -389,9 +490,10 @@ func (p *ocIfParser) ifAggState(nf *gnmi.Notification, updNum int) {
 	}
 
 	// Name filtering
-	if !p.rxName.MatchString(pathMeta.ifName) {
+	if !p.nameAllowed(pathMeta.ifName) {
 		return
 	}
+	p.lastSeen[pathMeta.ifName] = p.nfTime
 
 	// Create the interface if missing
 	if _, ok := p.yStruct.Interface[pathMeta.ifName]; !ok {
@@ -406,19 +508,58 @@ func (p *ocIfParser) ifAggState(nf *gnmi.Notification, updNum int) {
 	target := p.yStruct.Interface[pathMeta.ifName].Aggregation
 	switch pathMeta.leafName {
 	case "lag-speed":
-		target.LagSpeed = ygot.Uint32(uint32(source.GetUintVal()))
+		target.LagSpeed = ygot.Uint32(uint32(plugins.UintVal(source)))
 	case "lag-type":
 		target.LagType = ysocif.E_OpenconfigIfAggregate_AggregationType(
-			p.eMapper.GetEnumFromString(source.GetStringVal(), target.LagType))
+			p.enumValue(plugins.StringVal(source), target.LagType,
+				ysocif.RawEnumKey{IfName: pathMeta.ifName, Leaf: pathMeta.leafName}))
 	case "member":
-		memberList := source.GetLeaflistVal()
-		for _, member := range memberList.Element {
-			target.Member = append(target.Member, member.GetStringVal())
+		for _, member := range plugins.LeaflistVal(source) {
+			target.Member = append(target.Member, plugins.StringVal(member))
 		}
 	case "min-links":
-		target.MinLinks = ygot.Uint16(uint16(source.GetUintVal()))
+		target.MinLinks = ygot.Uint16(uint16(plugins.UintVal(source)))
+	default:
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
+	}
+}
+
+// ifEthState parses the port speed leaves of the /interface/ethernet/state YANG container
+func (p *ocIfParser) ifEthState(nf *gnmi.Notification, updNum int) {
+	pathMeta, err := p.getPathMeta(nf.Prefix, nf.Update[updNum].Path)
+	if err != nil {
+		p.InvalidPath()
+		return
+	}
+
+	// Name filtering
+	if !p.nameAllowed(pathMeta.ifName) {
+		return
+	}
+	p.lastSeen[pathMeta.ifName] = p.nfTime
+
+	// Create the interface if missing
+	if _, ok := p.yStruct.Interface[pathMeta.ifName]; !ok {
+		newIf, err := p.yStruct.NewInterface(pathMeta.ifName)
+		if err != nil {
+			return
+		}
+		newIf.PopulateDefaults()
+	}
+
+	source := nf.Update[updNum].Val
+	target := p.yStruct.Interface[pathMeta.ifName].GetOrCreateEthernet()
+	switch pathMeta.leafName {
+	case "negotiated-port-speed":
+		target.NegotiatedPortSpeed = ysocif.E_OpenconfigIfEthernet_ETHERNET_SPEED(
+			p.CheckEnum(p.eMapper.GetEnumFromString(plugins.StringVal(source), target.NegotiatedPortSpeed),
+				plugins.StringVal(source)))
+	case "port-speed":
+		target.PortSpeed = ysocif.E_OpenconfigIfEthernet_ETHERNET_SPEED(
+			p.CheckEnum(p.eMapper.GetEnumFromString(plugins.StringVal(source), target.PortSpeed),
+				plugins.StringVal(source)))
 	default:
-		p.LeafNotFound()
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
 	}
 }
 
@@ -431,9 +572,10 @@ func (p *ocIfParser) subIfStateCounters(nf *gnmi.Notification, updNum int) {
 	}
 
 	// Name and index filtering
-	if !p.rxName.MatchString(pathMeta.ifName) || !p.rxIndex.MatchString(fmt.Sprint(pathMeta.ifIndex)) {
+	if !p.nameAllowed(pathMeta.ifName) || !p.rxIndex.MatchString(fmt.Sprint(pathMeta.ifIndex)) {
 		return
 	}
+	p.lastSeen[pathMeta.ifName] = p.nfTime
 
 	// Create the interface if missing
 	if _, ok := p.yStruct.Interface[pathMeta.ifName]; !ok {
@@ -457,43 +599,45 @@ func (p *ocIfParser) subIfStateCounters(nf *gnmi.Notification, updNum int) {
 	target := p.yStruct.Interface[pathMeta.ifName].Subinterface[pathMeta.ifIndex].Counters
 	switch pathMeta.leafName {
 	case "carrier-transitions":
-		target.CarrierTransitions = ygot.Uint64(source.GetUintVal())
+		target.CarrierTransitions = ygot.Uint64(plugins.UintVal(source))
 	case "in-broadcast-pkts":
-		target.InBroadcastPkts = ygot.Uint64(source.GetUintVal())
+		target.InBroadcastPkts = ygot.Uint64(plugins.UintVal(source))
 	case "in-discards":
-		target.InDiscards = ygot.Uint64(source.GetUintVal())
+		target.InDiscards = ygot.Uint64(plugins.UintVal(source))
 	case "in-errors":
-		target.InErrors = ygot.Uint64(source.GetUintVal())
+		target.InErrors = ygot.Uint64(plugins.UintVal(source))
 	case "in-fcs-errors":
-		target.InFcsErrors = ygot.Uint64(source.GetUintVal())
+		target.InFcsErrors = ygot.Uint64(plugins.UintVal(source))
 	case "in-multicast-pkts":
-		target.InMulticastPkts = ygot.Uint64(source.GetUintVal())
+		target.InMulticastPkts = ygot.Uint64(plugins.UintVal(source))
 	case "in-octets":
-		target.InOctets = ygot.Uint64(source.GetUintVal())
+		target.InOctets = ygot.Uint64(plugins.UintVal(source))
 	case "in-pkts":
-		target.InPkts = ygot.Uint64(source.GetUintVal())
+		target.InPkts = ygot.Uint64(plugins.UintVal(source))
 	case "in-unicast-pkts":
-		target.InUnicastPkts = ygot.Uint64(source.GetUintVal())
+		target.InUnicastPkts = ygot.Uint64(plugins.UintVal(source))
 	case "in-unknown-protos":
-		target.InUnknownProtos = ygot.Uint64(source.GetUintVal())
+		target.InUnknownProtos = ygot.Uint64(plugins.UintVal(source))
 	case "last-clear":
-		target.LastClear = ygot.Uint64(source.GetUintVal())
+		target.LastClear = ygot.Uint64(plugins.UintVal(source))
 	case "out-broadcast-pkts":
-		target.OutBroadcastPkts = ygot.Uint64(source.GetUintVal())
+		target.OutBroadcastPkts = ygot.Uint64(plugins.UintVal(source))
 	case "out-discards":
-		target.OutDiscards = ygot.Uint64(source.GetUintVal())
+		target.OutDiscards = ygot.Uint64(plugins.UintVal(source))
 	case "out-errors":
-		target.OutErrors = ygot.Uint64(source.GetUintVal())
+		target.OutErrors = ygot.Uint64(plugins.UintVal(source))
 	case "out-multicast-pkts":
-		target.OutMulticastPkts = ygot.Uint64(source.GetUintVal())
+		target.OutMulticastPkts = ygot.Uint64(plugins.UintVal(source))
 	case "out-octets":
-		target.OutOctets = ygot.Uint64(source.GetUintVal())
+		target.OutOctets = ygot.Uint64(plugins.UintVal(source))
 	case "out-pkts":
-		target.OutPkts = ygot.Uint64(source.GetUintVal())
+		target.OutPkts = ygot.Uint64(plugins.UintVal(source))
 	case "out-unicast-pkts":
-		target.OutUnicastPkts = ygot.Uint64(source.GetUintVal())
+		target.OutUnicastPkts = ygot.Uint64(plugins.UintVal(source))
 	default:
-		p.LeafNotFound()
+		if !nativeRateLeaves[pathMeta.leafName] {
+			p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
+		}
 	}
 }
 
@@ -506,9 +650,10 @@ func (p *ocIfParser) subIfState(nf *gnmi.Notification, updNum int) {
 	}
 
 	// Name and index filtering
-	if !p.rxName.MatchString(pathMeta.ifName) || !p.rxIndex.MatchString(fmt.Sprint(pathMeta.ifIndex)) {
+	if !p.nameAllowed(pathMeta.ifName) || !p.rxIndex.MatchString(fmt.Sprint(pathMeta.ifIndex)) {
 		return
 	}
+	p.lastSeen[pathMeta.ifName] = p.nfTime
 
 	// Create the interface if missing
 	if _, ok := p.yStruct.Interface[pathMeta.ifName]; !ok {
@@ -533,29 +678,31 @@ func (p *ocIfParser) subIfState(nf *gnmi.Notification, updNum int) {
 	switch pathMeta.leafName {
 	case "admin-status":
 		target.AdminStatus = ysocif.E_Interface_AdminStatus(
-			p.eMapper.GetEnumFromString(source.GetStringVal(), target.AdminStatus))
+			p.enumValue(plugins.StringVal(source), target.AdminStatus,
+				ysocif.RawEnumKey{IfName: pathMeta.ifName, Index: pathMeta.ifIndex, IsSubIf: true, Leaf: pathMeta.leafName}))
 	case "cpu":
-		target.Cpu = ygot.Bool(source.GetBoolVal())
+		target.Cpu = ygot.Bool(plugins.BoolVal(source))
 	case "description":
-		target.Description = ygot.String(p.sanitizeDescription(source.GetStringVal()))
+		target.Description = ygot.String(p.sanitizeDescription(plugins.StringVal(source)))
 	case "enabled":
-		target.Enabled = ygot.Bool(source.GetBoolVal())
+		target.Enabled = ygot.Bool(plugins.BoolVal(source))
 	case "ifindex":
-		target.Ifindex = ygot.Uint32(uint32(source.GetUintVal()))
+		target.Ifindex = ygot.Uint32(uint32(plugins.UintVal(source)))
 	case "index":
-		target.Index = ygot.Uint32(uint32(source.GetUintVal()))
+		target.Index = ygot.Uint32(uint32(plugins.UintVal(source)))
 	case "last-change":
-		target.LastChange = ygot.Uint64(source.GetUintVal())
+		target.LastChange = ygot.Uint64(plugins.UintVal(source))
 	case "logical":
-		target.Logical = ygot.Bool(source.GetBoolVal())
+		target.Logical = ygot.Bool(plugins.BoolVal(source))
 	case "management":
-		target.Management = ygot.Bool(source.GetBoolVal())
+		target.Management = ygot.Bool(plugins.BoolVal(source))
 	case "name":
-		target.Name = ygot.String(source.GetStringVal())
+		target.Name = ygot.String(plugins.StringVal(source))
 	case "oper-status":
 		target.OperStatus = ysocif.E_Interface_OperStatus(
-			p.eMapper.GetEnumFromString(source.GetStringVal(), target.OperStatus))
+			p.enumValue(plugins.StringVal(source), target.OperStatus,
+				ysocif.RawEnumKey{IfName: pathMeta.ifName, Index: pathMeta.ifIndex, IsSubIf: true, Leaf: pathMeta.leafName}))
 	default:
-		p.LeafNotFound()
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
 	}
 }
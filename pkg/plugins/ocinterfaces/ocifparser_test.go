@@ -0,0 +1,154 @@
+package ocinterfaces
+
+import (
+	"fmt"
+	"github.com/automixer/gtexporter/pkg/datamodels/ysocif"
+	"github.com/automixer/gtexporter/pkg/exporter"
+	"github.com/automixer/gtexporter/pkg/plugins"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"testing"
+	"time"
+)
+
+// newTestParser returns an oc_interfaces parser with the default options.
+func newTestParser(t *testing.T) plugins.Parser {
+	t.Helper()
+	p, err := newParser(plugins.Config{DevName: "dev1", PlugName: "oc_interfaces", PlugId: "oc_interfaces",
+		Options: map[string]string{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// mustPath converts an xPath into a gNMI path.
+func mustPath(t testing.TB, xPath string) *gnmi.Path {
+	t.Helper()
+	p, err := ygot.StringToStructuredPath(xPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// parserCounter returns the value of the given parser self-monitoring counter.
+func parserCounter(t *testing.T, p plugins.Parser, name string) float64 {
+	t.Helper()
+	for _, m := range p.Collect() {
+		if exporter.LabelValue(m, "metric") == name {
+			return exporter.Commons(m).Value
+		}
+	}
+	t.Fatalf("parser counter %s not found", name)
+	return 0
+}
+
+func TestParseNilValue(t *testing.T) {
+	p := newTestParser(t)
+	pfx := mustPath(t, "/interfaces/interface[name=eth0]/state")
+	p.ParseNotification(&gnmi.Notification{
+		Prefix: pfx,
+		Update: []*gnmi.Update{{
+			Path: mustPath(t, "/counters/in-octets"),
+			Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 1000}},
+		}},
+	})
+	// A malformed update, without value, followed by a valid one in the same notification
+	p.ParseNotification(&gnmi.Notification{
+		Prefix: pfx,
+		Update: []*gnmi.Update{
+			{Path: mustPath(t, "/counters/in-octets")},
+			{
+				Path: mustPath(t, "/counters/out-octets"),
+				Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 2000}},
+			},
+		},
+	})
+
+	counters := p.CheckOut().(*ysocif.Root).GetInterface("eth0").GetCounters()
+	if got := counters.GetInOctets(); got != 1000 {
+		t.Errorf("in-octets is %d, want the cached 1000", got)
+	}
+	if got := counters.GetOutOctets(); got != 2000 {
+		t.Errorf("out-octets is %d, want 2000", got)
+	}
+	if got := parserCounter(t, p, "gnmi_update_nil_values"); got != 1 {
+		t.Errorf("gnmi_update_nil_values is %v, want 1", got)
+	}
+}
+
+// BenchmarkCoalesceUpdates measures a passthrough scrape of a high-churn ON_CHANGE stream, with and without
+// the coalesce_updates option: 100 interfaces, whose 8 counters are updated 10 times each during the interval.
+// The parse-ns/op metric reports the buffer_parse_seconds self-monitoring gauge, the buffer parsing time alone.
+func BenchmarkCoalesceUpdates(b *testing.B) {
+	leaves := []string{"in-octets", "out-octets", "in-pkts", "out-pkts",
+		"in-unicast-pkts", "out-unicast-pkts", "in-discards", "out-discards"}
+	var nfs []*gnmi.Notification
+	for round := 0; round < 10; round++ {
+		for ifIndex := 0; ifIndex < 100; ifIndex++ {
+			pfx := mustPath(b, fmt.Sprintf("/interfaces/interface[name=eth%d]/state/counters", ifIndex))
+			for _, leaf := range leaves {
+				nfs = append(nfs, &gnmi.Notification{
+					Timestamp: int64(len(nfs)),
+					Prefix:    pfx,
+					Update: []*gnmi.Update{{
+						Path: mustPath(b, "/"+leaf),
+						Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: uint64(round)}},
+					}},
+				})
+			}
+		}
+	}
+
+	exporter.Registry = func(exporter.GMetricSource, []exporter.GMetric) error { return nil }
+	var selfMon exporter.GMetricSource
+	exporter.GroupRegistry = func(_ string, src exporter.GMetricSource, _ []exporter.GMetric) error {
+		selfMon = src
+		return nil
+	}
+	parseTime := func() time.Duration {
+		ch := make(chan exporter.GMetric, 64)
+		selfMon.GetMetrics(ch)
+		close(ch)
+		for m := range ch {
+			if exporter.LabelValue(m, "metric") == "buffer_parse_seconds" {
+				return time.Duration(exporter.Commons(m).Value * float64(time.Second))
+			}
+		}
+		b.Fatal("buffer_parse_seconds not found")
+		return 0
+	}
+	for _, coalesce := range []string{"false", "true"} {
+		b.Run("coalesce="+coalesce, func(b *testing.B) {
+			plug, err := plugins.New(plugins.Config{
+				DevName:        "dev1",
+				PlugName:       "oc_interfaces",
+				DescSanitize:   ".*",
+				ScrapeInterval: time.Hour,
+				Options:        map[string]string{"coalesce_updates": coalesce},
+			})
+			if err != nil {
+				b.Fatal(err)
+			}
+			ch := make(chan exporter.GMetric)
+			go func() {
+				for range ch {
+				}
+			}()
+			defer close(ch)
+			var parsing time.Duration
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for _, nf := range nfs {
+					plug.Notification(nf)
+				}
+				plug.GetMetrics(ch)
+				b.StopTimer()
+				parsing += parseTime()
+				b.StartTimer()
+			}
+			b.ReportMetric(float64(parsing.Nanoseconds())/float64(b.N), "parse-ns/op")
+		})
+	}
+}
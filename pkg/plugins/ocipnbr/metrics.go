@@ -0,0 +1,44 @@
+package ocipnbr
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/exporter"
+)
+
+// ocIpNbrMetric represents the Openconfig IP Neighbors info metric.
+//
+// Fields:
+// - CustomLabel: Custom label associated with the metric.
+// - IfName: Interface name.
+// - SubIfIndex: Subinterface index.
+// - Family: Address family (ipv4 or ipv6).
+// - Ip: Neighbor IP address.
+// - LinkLayerAddress: Neighbor link layer (MAC) address.
+// - Origin: Neighbor entry origin (e.g.: DYNAMIC, STATIC).
+// - NeighborState: IPv6 neighbor discovery state. Always empty for IPv4.
+type ocIpNbrMetric struct {
+	exporter.MetricCommons
+	CustomLabel      string `label:"custom_label"`
+	IfName           string `label:"name"`
+	SubIfIndex       string `label:"index"`
+	Family           string `label:"family"`
+	Ip               string `label:"ip"`
+	LinkLayerAddress string `label:"link_layer_address"`
+	Origin           string `label:"origin"`
+	NeighborState    string `label:"neighbor_state"`
+}
+
+// newIpNbrMetric creates a new ocIpNbrMetric info metric.
+func (f *ocIpNbrFormatter) newIpNbrMetric() ocIpNbrMetric {
+	metric := ocIpNbrMetric{}
+	// Common fields
+	metric.Name = "oc_ip_nbr"
+	metric.Help = "Openconfig IP Neighbors Metric"
+	metric.Device = f.config.DevName
+	metric.Type = prometheus.GaugeValue
+	metric.Info = true
+	metric.CustomLabel = f.config.CustomLabel
+	return metric
+}
@@ -0,0 +1,141 @@
+package ocipnbr
+
+import (
+	"fmt"
+	log "github.com/automixer/gtexporter/pkg/logger"
+	"github.com/openconfig/ygot/ygot"
+	"strconv"
+	"strings"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/datamodels/ysocip"
+	"github.com/automixer/gtexporter/pkg/exporter"
+	"github.com/automixer/gtexporter/pkg/plugins"
+)
+
+const (
+	plugName  = "oc_ip_neighbors"
+	dataModel = "openconfig-if-ip"
+	// Paths to subscribe
+	ipv4NbrState = "/interfaces/interface/subinterfaces/subinterface/ipv4/neighbors/neighbor/state"
+	ipv6NbrState = "/interfaces/interface/subinterfaces/subinterface/ipv6/neighbors/neighbor/state"
+)
+
+// init register the parser and the formatter to the plugin registration system
+func init() {
+	err := plugins.Register(plugName, newFormatter, newParser)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// ocIpNbrFormatter is a type that represents a formatter for Openconfig IP neighbors (ARP/ND) data.
+type ocIpNbrFormatter struct {
+	config      plugins.Config
+	root        *ysocip.Root
+	disableIpv4 bool
+	disableIpv6 bool
+}
+
+// newFormatter creates a new instance of ocIpNbrFormatter and initializes its config field with the provided config.
+func newFormatter(cfg plugins.Config) (plugins.Formatter, error) {
+	f := &ocIpNbrFormatter{}
+	f.config = cfg
+	f.disableIpv4, _ = strconv.ParseBool(f.config.Options["disable_ipv4"])
+	f.disableIpv6, _ = strconv.ParseBool(f.config.Options["disable_ipv6"])
+	if f.disableIpv4 && f.disableIpv6 {
+		return nil, fmt.Errorf("%s: both address families are disabled", plugName)
+	}
+	return f, nil
+}
+
+// GetPaths returns the XPaths and Datamodels for the ocIpNbrFormatter plugin.
+func (f *ocIpNbrFormatter) GetPaths() plugins.FormatterPaths {
+	// IfName filtering
+	ifaces := strings.ReplaceAll(f.config.Options["gnmi_filter"], " ", "")
+	ifList := strings.Split(ifaces, ",")
+
+	var basePaths []string
+	if !f.disableIpv4 {
+		basePaths = append(basePaths, ipv4NbrState)
+	}
+	if !f.disableIpv6 {
+		basePaths = append(basePaths, ipv6NbrState)
+	}
+
+	fp := plugins.FormatterPaths{
+		Datamodel: dataModel,
+	}
+	for _, path := range basePaths {
+		if ifList[0] == "" {
+			fp.XPaths = append(fp.XPaths, path)
+			continue
+		}
+		for _, name := range ifList {
+			fp.XPaths = append(fp.XPaths, strings.ReplaceAll(path, "/interface/", "/interface[name="+name+"]/"))
+		}
+	}
+	return fp
+}
+
+// Describe returns a slice of exporter.GMetric objects containing the description of the ocIpNbrFormatter plugin.
+func (f *ocIpNbrFormatter) Describe() []exporter.GMetric {
+	return []exporter.GMetric{f.newIpNbrMetric()}
+}
+
+// Collect returns a slice of GMetric objects containing IP neighbors metrics.
+func (f *ocIpNbrFormatter) Collect() []exporter.GMetric {
+	out := make([]exporter.GMetric, 0)
+	out = append(out, f.nbrInfo()...)
+	return out
+}
+
+// ScrapeEvent implements the plugin's formatter interface.
+// It is called by the plugin when a scrape event occurs.
+func (f *ocIpNbrFormatter) ScrapeEvent(ys ygot.GoStruct) func() {
+	f.root = ysocip.GoStructToOcIp(ys)
+	return func() {
+		f.root = nil
+	}
+}
+
+// nbrInfo scans the yGot GoStruct and returns a slice of ipv4/ipv6 neighbors info metrics
+func (f *ocIpNbrFormatter) nbrInfo() []exporter.GMetric {
+	out := make([]exporter.GMetric, 0, len(f.root.Interface))
+	for ifName, ifObject := range f.root.Interface {
+		for index, subIfObject := range ifObject.Subinterface {
+			// IPv4 neighbors (ARP)
+			var ipv4Nbrs map[string]*ysocip.Interface_Subinterface_Ipv4_Neighbor
+			if subIfObject.Ipv4 != nil {
+				ipv4Nbrs = subIfObject.Ipv4.Neighbor
+			}
+			for ip, nbr := range ipv4Nbrs {
+				metric := f.newIpNbrMetric()
+				metric.IfName = ifName
+				metric.SubIfIndex = fmt.Sprint(index)
+				metric.Family = "ipv4"
+				metric.Ip = ip
+				metric.LinkLayerAddress = nbr.GetLinkLayerAddress()
+				metric.Origin = nbr.GetOrigin().ShortString()
+				out = append(out, metric)
+			}
+			// IPv6 neighbors (ND)
+			var ipv6Nbrs map[string]*ysocip.Interface_Subinterface_Ipv6_Neighbor
+			if subIfObject.Ipv6 != nil {
+				ipv6Nbrs = subIfObject.Ipv6.Neighbor
+			}
+			for ip, nbr := range ipv6Nbrs {
+				metric := f.newIpNbrMetric()
+				metric.IfName = ifName
+				metric.SubIfIndex = fmt.Sprint(index)
+				metric.Family = "ipv6"
+				metric.Ip = ip
+				metric.LinkLayerAddress = nbr.GetLinkLayerAddress()
+				metric.Origin = nbr.GetOrigin().ShortString()
+				metric.NeighborState = nbr.GetNeighborState().ShortString()
+				out = append(out, metric)
+			}
+		}
+	}
+	return out
+}
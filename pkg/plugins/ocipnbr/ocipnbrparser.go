@@ -0,0 +1,320 @@
+package ocipnbr
+
+import (
+	"errors"
+	"fmt"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"strconv"
+	"strings"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/datamodels/ysocip"
+	"github.com/automixer/gtexporter/pkg/plugins"
+)
+
+const yStructInitialSize = 128
+
+// pathMetadata represents metadata extracted from a path.
+// It contains information about the interface name, subinterface index, address family, neighbor ip and leaf name.
+type pathMetadata struct {
+	ifName   string
+	index    uint32
+	isSubIf  bool
+	family   string
+	nbrIp    string
+	leafName string
+}
+
+// ocIpNbrParser represents a parser for OpenConfig interfaces ip neighbors data.
+// It implements the plugins.Parser interface and includes a ygot structure for storing neighbors data
+// and an EnumMapper for mapping string enum values to their corresponding integer values.
+type ocIpNbrParser struct {
+	plugins.ParserMon
+	yStruct *ysocip.Root
+	eMapper *ysocip.EnumMapper
+}
+
+// newParser creates a new ocIpNbrParser and initializes its fields based on the given configuration.
+// It returns the newly created parser or an error if there was an issue during initialization.
+func newParser(cfg plugins.Config) (plugins.Parser, error) {
+	p := &ocIpNbrParser{}
+	if err := p.ParserMon.Configure(cfg); err != nil {
+		return nil, err
+	}
+	p.ClearCache()
+	p.eMapper = ysocip.NewEnumMapper()
+	return p, nil
+}
+
+// CheckOut returns the yGot structure.
+func (p *ocIpNbrParser) CheckOut() ygot.GoStruct {
+	if p.yStruct == nil {
+		panic(fmt.Sprint("yGot structure not initialized"))
+	}
+	return p.yStruct
+}
+
+// ClearCache resets the yGot structure.
+func (p *ocIpNbrParser) ClearCache() {
+	p.yStruct = &ysocip.Root{
+		Interface: make(map[string]*ysocip.Interface, yStructInitialSize),
+	}
+}
+
+// getPathMeta returns the metadata of the given path by parsing it and extracting the necessary information.
+// The metadata includes the interface name, the subinterface index, the address family, the neighbor ip
+// and the name of the leaf node.
+// If any of the metadata is missing or the path is invalid, an error is returned.
+func (p *ocIpNbrParser) getPathMeta(pfx, path *gnmi.Path) (*pathMetadata, error) {
+	var fullPath []string
+	out := &pathMetadata{}
+
+	// Build the full path as a slice of strings
+	if pfx != nil {
+		sPfx, err := ygot.PathToStrings(pfx)
+		if err != nil {
+			return nil, err
+		}
+		if len(sPfx) > 0 {
+			fullPath = append(fullPath, sPfx...)
+		}
+	}
+	if path != nil {
+		sPath, err := ygot.PathToStrings(path)
+		if err != nil {
+			return nil, err
+		}
+		fullPath = append(fullPath, sPath...)
+	}
+	if len(fullPath) < 2 {
+		return nil, errors.New("path too short")
+	}
+
+	// Scan fullPath and extract metadata
+	for _, elem := range fullPath {
+		switch {
+		case strings.HasPrefix(elem, "interface[name=") && strings.Count(elem, "=") == 1:
+			_, after, found := strings.Cut(elem, "=")
+			if found {
+				out.ifName = after[:len(after)-1]
+			}
+		case strings.HasPrefix(elem, "subinterface[index=") && strings.Count(elem, "=") == 1:
+			_, after, found := strings.Cut(elem, "=")
+			if found {
+				index, err := strconv.ParseUint(after[:len(after)-1], 10, 32)
+				if err != nil {
+					return nil, err
+				}
+				out.index = uint32(index)
+				out.isSubIf = true
+			}
+		case elem == "ipv4" || elem == "ipv6":
+			out.family = elem
+		case strings.HasPrefix(elem, "neighbor[ip=") && strings.Count(elem, "=") == 1:
+			_, after, found := strings.Cut(elem, "=")
+			if found {
+				out.nbrIp = after[:len(after)-1]
+			}
+		}
+	}
+	out.leafName = fullPath[len(fullPath)-1]
+
+	// Final check
+	if out.ifName == "" || out.leafName == "" {
+		return nil, errors.New("invalid path metadata")
+	}
+	return out, nil
+}
+
+// ParseNotification analyzes a GNMI notification and calls the appropriate decoding method.
+func (p *ocIpNbrParser) ParseNotification(nf *gnmi.Notification) {
+	if p.yStruct == nil {
+		panic(fmt.Sprint("yGot structure not initialized"))
+	}
+
+	// Process GNMI delete messages
+	for _, gDelete := range nf.Delete {
+		p.removeDbEntry(nf.Prefix, gDelete)
+	}
+
+	// Process GNMI update messages
+	for i, update := range nf.Update {
+		// An update without a value would overwrite the cached leaf with a zero value
+		if update.GetVal() == nil {
+			p.NilValue()
+			continue
+		}
+		updHandler := p.updHandlerLookup(nf.Prefix, update.Path)
+		if updHandler == nil {
+			continue
+		}
+		p.UpdateDuplicates(uint64(update.GetDuplicates()))
+		updHandler(nf, i)
+	}
+}
+
+// removeDbEntry removes the yGot GoStruct entry specified by the given prefix and path.
+func (p *ocIpNbrParser) removeDbEntry(pfx, path *gnmi.Path) {
+	pathMeta, err := p.getPathMeta(pfx, path)
+	if err != nil {
+		p.InvalidPath()
+		return
+	}
+
+	iface, ok := p.yStruct.Interface[pathMeta.ifName]
+	if !ok {
+		p.DeleteNotFound()
+		return
+	}
+	if !pathMeta.isSubIf {
+		// Delete interface
+		p.yStruct.DeleteInterface(pathMeta.ifName)
+		return
+	}
+	subIface, ok := iface.Subinterface[pathMeta.index]
+	if !ok {
+		p.DeleteNotFound()
+		return
+	}
+	if pathMeta.nbrIp == "" {
+		// Delete subinterface
+		iface.DeleteSubinterface(pathMeta.index)
+		return
+	}
+	// Delete neighbor
+	switch {
+	case pathMeta.family == "ipv4" && subIface.Ipv4 != nil && subIface.Ipv4.Neighbor[pathMeta.nbrIp] != nil:
+		subIface.Ipv4.DeleteNeighbor(pathMeta.nbrIp)
+	case pathMeta.family == "ipv6" && subIface.Ipv6 != nil && subIface.Ipv6.Neighbor[pathMeta.nbrIp] != nil:
+		subIface.Ipv6.DeleteNeighbor(pathMeta.nbrIp)
+	default:
+		p.DeleteNotFound()
+	}
+}
+
+// updHandlerLookup returns the appropriate decoding handler based on the given prefix and path.
+func (p *ocIpNbrParser) updHandlerLookup(pfx, path *gnmi.Path) func(*gnmi.Notification, int) {
+	sPfx, _ := ygot.PathToSchemaPath(pfx)
+	sPath, _ := ygot.PathToSchemaPath(path)
+	var fullPath string
+	if len(sPfx) > 1 {
+		fullPath += sPfx
+	}
+	fullPath += sPath
+	leafIndex := strings.LastIndex(fullPath, "/")
+	if leafIndex == -1 {
+		p.InvalidPath()
+		return nil
+	}
+
+	// Find the proper handler
+	switch fullPath[:leafIndex] {
+	case ipv4NbrState:
+		return p.ipv4NbrState
+	case ipv6NbrState:
+		return p.ipv6NbrState
+	default:
+		p.ContainerNotFound()
+	}
+	return nil
+}
+
+// getOrCreateSubIf returns the subinterface identified by the given path metadata, creating it if missing.
+func (p *ocIpNbrParser) getOrCreateSubIf(pathMeta *pathMetadata) *ysocip.Interface_Subinterface {
+	if _, ok := p.yStruct.Interface[pathMeta.ifName]; !ok {
+		newIf, err := p.yStruct.NewInterface(pathMeta.ifName)
+		if err != nil {
+			return nil
+		}
+		newIf.PopulateDefaults()
+	}
+	iface := p.yStruct.Interface[pathMeta.ifName]
+	if _, ok := iface.Subinterface[pathMeta.index]; !ok {
+		newSubIf, err := iface.NewSubinterface(pathMeta.index)
+		if err != nil {
+			return nil
+		}
+		newSubIf.PopulateDefaults()
+	}
+	return iface.Subinterface[pathMeta.index]
+}
+
+// ipv4NbrState updates the yGot structure with the information from the GNMI update message for the
+// subinterface ipv4 neighbor state.
+func (p *ocIpNbrParser) ipv4NbrState(nf *gnmi.Notification, updNum int) {
+	pathMeta, err := p.getPathMeta(nf.Prefix, nf.Update[updNum].Path)
+	if err != nil || !pathMeta.isSubIf || pathMeta.nbrIp == "" {
+		p.InvalidPath()
+		return
+	}
+	subIf := p.getOrCreateSubIf(pathMeta)
+	if subIf == nil {
+		return
+	}
+	// Create the neighbor if missing
+	ipv4 := subIf.GetOrCreateIpv4()
+	if _, ok := ipv4.Neighbor[pathMeta.nbrIp]; !ok {
+		newNbr, err := ipv4.NewNeighbor(pathMeta.nbrIp)
+		if err != nil {
+			return
+		}
+		newNbr.PopulateDefaults()
+	}
+	// Load the gnmi update into yGot struct
+	source := nf.Update[updNum].Val
+	target := ipv4.Neighbor[pathMeta.nbrIp]
+	switch pathMeta.leafName {
+	case "ip":
+		target.Ip = ygot.String(plugins.StringVal(source))
+	case "link-layer-address":
+		target.LinkLayerAddress = ygot.String(plugins.BinaryVal(source))
+	case "origin":
+		target.Origin = ysocip.E_OpenconfigIfIp_NeighborOrigin(
+			p.CheckEnum(p.eMapper.GetEnumFromString(plugins.StringVal(source), target.Origin), plugins.StringVal(source)))
+	default:
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
+	}
+}
+
+// ipv6NbrState updates the yGot structure with the information from the GNMI update message for the
+// subinterface ipv6 neighbor state.
+func (p *ocIpNbrParser) ipv6NbrState(nf *gnmi.Notification, updNum int) {
+	pathMeta, err := p.getPathMeta(nf.Prefix, nf.Update[updNum].Path)
+	if err != nil || !pathMeta.isSubIf || pathMeta.nbrIp == "" {
+		p.InvalidPath()
+		return
+	}
+	subIf := p.getOrCreateSubIf(pathMeta)
+	if subIf == nil {
+		return
+	}
+	// Create the neighbor if missing
+	ipv6 := subIf.GetOrCreateIpv6()
+	if _, ok := ipv6.Neighbor[pathMeta.nbrIp]; !ok {
+		newNbr, err := ipv6.NewNeighbor(pathMeta.nbrIp)
+		if err != nil {
+			return
+		}
+		newNbr.PopulateDefaults()
+	}
+	// Load the gnmi update into yGot struct
+	source := nf.Update[updNum].Val
+	target := ipv6.Neighbor[pathMeta.nbrIp]
+	switch pathMeta.leafName {
+	case "ip":
+		target.Ip = ygot.String(plugins.StringVal(source))
+	case "is-router":
+		target.IsRouter = ygot.Bool(plugins.BoolVal(source))
+	case "link-layer-address":
+		target.LinkLayerAddress = ygot.String(plugins.BinaryVal(source))
+	case "neighbor-state":
+		target.NeighborState = ysocip.E_Neighbor_NeighborState(
+			p.CheckEnum(p.eMapper.GetEnumFromString(plugins.StringVal(source), target.NeighborState), plugins.StringVal(source)))
+	case "origin":
+		target.Origin = ysocip.E_OpenconfigIfIp_NeighborOrigin(
+			p.CheckEnum(p.eMapper.GetEnumFromString(plugins.StringVal(source), target.Origin), plugins.StringVal(source)))
+	default:
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
+	}
+}
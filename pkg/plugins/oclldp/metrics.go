@@ -28,14 +28,45 @@ type ocLldpIfNbrMetric struct {
 	PortDescription string `label:"nbr_port_description"`
 }
 
+// lldpIfNbrHelp holds the help string of the ocLldpIfNbrMetric, by metric type.
+var lldpIfNbrHelp = map[prometheus.ValueType]string{
+	prometheus.GaugeValue: "Openconfig LLDP Interface Neighbors gauges. " +
+		"The metric label carries the gauge name (age, last_update, ttl)",
+}
+
 // newLldpIfNbrMetric creates a new ocLldpIfNbrMetric with the given metric type.
 func (f *ocLldpFormatter) newLldpIfNbrMetric(mType prometheus.ValueType) ocLldpIfNbrMetric {
 	metric := ocLldpIfNbrMetric{}
 	// Common fields
-	metric.Name = "oc_lldp_if_nbr"
-	metric.Help = "Openconfig LLDP Interface Neighbors Metric"
+	metric.Name = f.metricName
+	metric.Help = lldpIfNbrHelp[mType]
 	metric.Device = f.config.DevName
 	metric.Type = mType
 	metric.CustomLabel = f.config.CustomLabel
 	return metric
 }
+
+// ocLldpIfMetric represents the Openconfig LLDP Interface Metric.
+//
+// Fields:
+// - Metric: Name of the metric.
+// - CustomLabel: Custom label associated with the metric.
+// - IfName: Local interface name.
+type ocLldpIfMetric struct {
+	exporter.MetricCommons
+	Metric      string `label:"metric"`
+	CustomLabel string `label:"custom_label"`
+	IfName      string `label:"local_if_name"`
+}
+
+// newLldpIfMetric creates a new ocLldpIfMetric gauge.
+func (f *ocLldpFormatter) newLldpIfMetric() ocLldpIfMetric {
+	metric := ocLldpIfMetric{}
+	// Common fields
+	metric.Name = "oc_lldp_if"
+	metric.Help = "Openconfig LLDP Interface gauges. The metric label carries the gauge name (neighbor_count)"
+	metric.Device = f.config.DevName
+	metric.Type = prometheus.GaugeValue
+	metric.CustomLabel = f.config.CustomLabel
+	return metric
+}
@@ -1,9 +1,10 @@
 package oclldp
 
 import (
-	log "github.com/golang/glog"
+	log "github.com/automixer/gtexporter/pkg/logger"
 	"github.com/openconfig/ygot/ygot"
 	"github.com/prometheus/client_golang/prometheus"
+	"strconv"
 
 	// Local packages
 	"github.com/automixer/gtexporter/pkg/datamodels/ysoclldp"
@@ -16,6 +17,7 @@ const (
 	dataModel = "openconfig-lldp"
 	// Paths to subscribe
 	lldpNbState = "/lldp/interfaces/interface/neighbors/neighbor/state"
+	lldpIfState = "/lldp/interfaces/interface/state"
 )
 
 // init register the parser and the formatter to the plugin registration system
@@ -28,35 +30,53 @@ func init() {
 
 // ocLldpFormatter is a type that represents a formatter for Openconfig LLDP data.
 type ocLldpFormatter struct {
-	config plugins.Config
-	root   *ysoclldp.Root
+	config        plugins.Config
+	root          *ysoclldp.Root
+	neighborCount bool
+	metricName    string // Base name of the oc_lldp_if_nbr metric
 }
 
 // newFormatter creates a new instance of ocLldpFormatter and initializes its config field with the provided config.
 func newFormatter(cfg plugins.Config) (plugins.Formatter, error) {
 	f := &ocLldpFormatter{}
 	f.config = cfg
+	f.neighborCount, _ = strconv.ParseBool(f.config.Options["neighbor_count"])
+	var err error
+	if f.metricName, err = plugins.MetricName(f.config, "oc_lldp_if_nbr"); err != nil {
+		return nil, err
+	}
 	return f, nil
 }
 
 // GetPaths returns the XPaths and Datamodels for the ocLldpFormatter plugin.
 func (f *ocLldpFormatter) GetPaths() plugins.FormatterPaths {
-	return plugins.FormatterPaths{
+	fp := plugins.FormatterPaths{
 		XPaths:    []string{lldpNbState},
 		Datamodel: dataModel,
 	}
+	if f.neighborCount {
+		fp.XPaths = append(fp.XPaths, lldpIfState)
+	}
+	return fp
 }
 
 // Describe returns a slice of exporter.GMetric objects containing the description of the ocLldpFormatter plugin.
 // GMetric represents a metric that follows the exporter.GMetric interface.
 func (f *ocLldpFormatter) Describe() []exporter.GMetric {
-	return []exporter.GMetric{f.newLldpIfNbrMetric(prometheus.GaugeValue)}
+	out := []exporter.GMetric{f.newLldpIfNbrMetric(prometheus.GaugeValue)}
+	if f.neighborCount {
+		out = append(out, f.newLldpIfMetric())
+	}
+	return out
 }
 
 // Collect returns a slice of GMetric objects containing LLDP interface neighbors metrics.
 func (f *ocLldpFormatter) Collect() []exporter.GMetric {
 	out := make([]exporter.GMetric, 0)
 	out = append(out, f.lldpIfNbrGauges()...)
+	if f.neighborCount {
+		out = append(out, f.lldpIfGauges()...)
+	}
 	return out
 }
 
@@ -96,3 +116,17 @@ func (f *ocLldpFormatter) lldpIfNbrGauges() []exporter.GMetric {
 	}
 	return out
 }
+
+// lldpIfGauges scans the yGot GoStruct and returns a slice of lldp/interface metrics.
+// Interfaces without neighbors report a zero neighbor_count.
+func (f *ocLldpFormatter) lldpIfGauges() []exporter.GMetric {
+	out := make([]exporter.GMetric, 0, len(f.root.GetLldp().Interface))
+	for ifName, ifObject := range f.root.GetLldp().Interface {
+		metric := f.newLldpIfMetric()
+		metric.Metric = "neighbor_count"
+		metric.Value = float64(len(ifObject.Neighbor))
+		metric.IfName = ifName
+		out = append(out, metric)
+	}
+	return out
+}
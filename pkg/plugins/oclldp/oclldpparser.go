@@ -6,8 +6,8 @@ import (
 	"github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/openconfig/ygot/ygot"
 	"regexp"
-	"strconv"
 	"strings"
+	"time"
 
 	// Local packages
 	"github.com/automixer/gtexporter/pkg/datamodels/ysoclldp"
@@ -18,35 +18,43 @@ const yStructInitialSize = 128
 
 // pathMetadata represents metadata extracted from a path.
 // It contains information about the interface name, neighbor ID, and leaf name.
+// The neighbor ID is empty for interface level paths.
 type pathMetadata struct {
 	ifName   string
 	nbrId    string
 	leafName string
 }
 
+// nbrKey identifies an LLDP neighbor across notifications.
+type nbrKey struct {
+	ifName string
+	nbrId  string
+}
+
 // ocLldpParser represents a parser for OpenConfig LLDP (Link Layer Discovery Protocol) data.
 // It implements the plugins.Parser interface and includes a ygot structure for storing LLDP data,
 // an EnumMapper for mapping string enum values to their corresponding integer values,
 // and a regular expression used for sanitizing description strings.
 type ocLldpParser struct {
 	plugins.ParserMon
-	yStruct        *ysoclldp.Root
-	eMapper        *ysoclldp.EnumMapper
-	rxSD           *regexp.Regexp
-	disableDeletes bool
+	yStruct  *ysoclldp.Root
+	eMapper  *ysoclldp.EnumMapper
+	rxSD     *regexp.Regexp
+	lastSeen map[nbrKey]time.Time // Time of the last update received
+	nfTime   time.Time            // Receive time of the notification being parsed
 }
 
 // newParser creates a new ocLldpParser and initializes its fields based on the given configuration.
 // It returns the newly created parser or an error if there was an issue during initialization.
 func newParser(cfg plugins.Config) (plugins.Parser, error) {
 	p := &ocLldpParser{}
-	p.disableDeletes, _ = strconv.ParseBool(cfg.Options["disable_gnmi_delete"])
 	if err := p.ParserMon.Configure(cfg); err != nil {
 		return nil, err
 	}
 	p.yStruct = &ysoclldp.Root{}
 	p.yStruct.PopulateDefaults()
 	p.yStruct.Lldp.Interface = make(map[string]*ysoclldp.Lldp_Interface, yStructInitialSize)
+	p.lastSeen = make(map[nbrKey]time.Time, yStructInitialSize)
 	p.eMapper = ysoclldp.NewEnumMapper()
 	var err error
 	p.rxSD, err = regexp.Compile(cfg.DescSanitize)
@@ -69,6 +77,27 @@ func (p *ocLldpParser) ClearCache() {
 	p.yStruct = &ysoclldp.Root{}
 	p.yStruct.PopulateDefaults()
 	p.yStruct.Lldp.Interface = make(map[string]*ysoclldp.Lldp_Interface, yStructInitialSize)
+	p.lastSeen = make(map[nbrKey]time.Time, yStructInitialSize)
+}
+
+// ClearStale removes the neighbors not updated by the device since the given time.
+// It implements the plugin's StaleParser interface and returns the number of removed neighbors.
+func (p *ocLldpParser) ClearStale(before time.Time) int {
+	removed := 0
+	for key, seen := range p.lastSeen {
+		if !seen.Before(before) {
+			continue
+		}
+		delete(p.lastSeen, key)
+		iface := p.yStruct.GetLldp().Interface[key.ifName]
+		if iface.GetNeighbor(key.nbrId) == nil {
+			// Already deleted by the device
+			continue
+		}
+		iface.DeleteNeighbor(key.nbrId)
+		removed++
+	}
+	return removed
 }
 
 // sanitizeDescription removes all non-alphanumeric characters from the given string and returns the result.
@@ -78,7 +107,7 @@ func (p *ocLldpParser) sanitizeDescription(s string) string {
 }
 
 // getPathMeta returns the metadata of the given path by parsing it and extracting the necessary information.
-// The metadata includes the interface name, neighbor ID, and the name of the leaf node.
+// The metadata includes the interface name, neighbor ID (if any), and the name of the leaf node.
 // If any of the metadata is missing or the path is invalid, an error is returned.
 func (p *ocLldpParser) getPathMeta(pfx, path *gnmi.Path) (*pathMetadata, error) {
 	var fullPath []string
@@ -123,7 +152,7 @@ func (p *ocLldpParser) getPathMeta(pfx, path *gnmi.Path) (*pathMetadata, error)
 	out.leafName = fullPath[len(fullPath)-1]
 
 	// Final check
-	if out.ifName == "" || out.nbrId == "" || out.leafName == "" {
+	if out.ifName == "" || out.leafName == "" {
 		return nil, errors.New("invalid path metadata")
 	}
 	return out, nil
@@ -134,16 +163,20 @@ func (p *ocLldpParser) ParseNotification(nf *gnmi.Notification) {
 	if p.yStruct == nil {
 		panic(fmt.Sprint("yGot structure not initialized"))
 	}
+	p.nfTime = time.Now()
 
 	// Process GNMI delete messages
-	if !p.disableDeletes {
-		for _, gDelete := range nf.Delete {
-			p.removeDbEntry(nf.Prefix, gDelete)
-		}
+	for _, gDelete := range nf.Delete {
+		p.removeDbEntry(nf.Prefix, gDelete)
 	}
 
 	// Process GNMI update messages
 	for i, update := range nf.Update {
+		// An update without a value would overwrite the cached leaf with a zero value
+		if update.GetVal() == nil {
+			p.NilValue()
+			continue
+		}
 		updHandler := p.updHandlerLookup(nf.Prefix, update.Path)
 		if updHandler == nil {
 			continue
@@ -161,10 +194,16 @@ func (p *ocLldpParser) removeDbEntry(pfx, path *gnmi.Path) {
 		return
 	}
 
-	if _, ok := p.yStruct.GetLldp().Interface[pathMeta.ifName]; ok {
-		p.yStruct.GetLldp().Interface[pathMeta.ifName].DeleteNeighbor(pathMeta.nbrId)
-	} else {
+	_, ok := p.yStruct.GetLldp().Interface[pathMeta.ifName]
+	switch {
+	case !ok:
 		p.DeleteNotFound()
+	case pathMeta.nbrId == "":
+		// Interface level delete
+		p.yStruct.GetLldp().DeleteInterface(pathMeta.ifName)
+		return
+	default:
+		p.yStruct.GetLldp().Interface[pathMeta.ifName].DeleteNeighbor(pathMeta.nbrId)
 	}
 
 	if len(p.yStruct.GetLldp().Interface) == 0 {
@@ -191,30 +230,63 @@ func (p *ocLldpParser) updHandlerLookup(pfx, path *gnmi.Path) func(*gnmi.Notific
 	switch fullPath[:leafIndex] {
 	case lldpNbState:
 		return p.lldpIfNbState
+	case lldpIfState:
+		return p.lldpIfState
+	case lldpIfState + "/counters":
+		// Received along with the interface state, not used
+		return nil
 	default:
 		p.ContainerNotFound()
 	}
 	return nil
 }
 
+// addInterface creates the interface if missing.
+func (p *ocLldpParser) addInterface(ifName string) error {
+	if _, ok := p.yStruct.GetLldp().Interface[ifName]; !ok {
+		newIf, err := p.yStruct.GetLldp().NewInterface(ifName)
+		if err != nil {
+			return err
+		}
+		newIf.PopulateDefaults()
+	}
+	return nil
+}
+
+// lldpIfState updates the yGot structure with the information from the GNMI update message for the
+// LLDP interface state. It tracks the local interfaces independently of their neighbors.
+func (p *ocLldpParser) lldpIfState(nf *gnmi.Notification, updNum int) {
+	pathMeta, err := p.getPathMeta(nf.Prefix, nf.Update[updNum].Path)
+	if err != nil || pathMeta.nbrId != "" {
+		p.InvalidPath()
+		return
+	}
+	if err := p.addInterface(pathMeta.ifName); err != nil {
+		return
+	}
+	// Load the gnmi update into yGot struct
+	source := nf.Update[updNum].Val
+	target := p.yStruct.GetLldp().Interface[pathMeta.ifName]
+	switch pathMeta.leafName {
+	case "name":
+		target.Name = ygot.String(plugins.StringVal(source))
+	case "enabled":
+		target.Enabled = ygot.Bool(plugins.BoolVal(source))
+	default:
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
+	}
+}
+
 // lldpIfNbState updates the yGot structure with the information from the GNMI update message for the
 // LLDP neighbor state.
 func (p *ocLldpParser) lldpIfNbState(nf *gnmi.Notification, updNum int) {
 	pathMeta, err := p.getPathMeta(nf.Prefix, nf.Update[updNum].Path)
-	if err != nil {
+	if err != nil || pathMeta.nbrId == "" {
 		p.InvalidPath()
 		return
 	}
 	// Create the interface if missing
-	if p.yStruct != nil && p.yStruct.GetLldp() != nil {
-		if _, ok := p.yStruct.GetLldp().Interface[pathMeta.ifName]; !ok {
-			newIf, err := p.yStruct.GetLldp().NewInterface(pathMeta.ifName)
-			if err != nil {
-				return
-			}
-			newIf.PopulateDefaults()
-		}
-	} else {
+	if err := p.addInterface(pathMeta.ifName); err != nil {
 		return
 	}
 	// Create the neighbor if missing
@@ -225,39 +297,40 @@ func (p *ocLldpParser) lldpIfNbState(nf *gnmi.Notification, updNum int) {
 		}
 		newNbr.PopulateDefaults()
 	}
+	p.lastSeen[nbrKey{ifName: pathMeta.ifName, nbrId: pathMeta.nbrId}] = p.nfTime
 	// Load the gnmi update into yGot struct
 	source := nf.Update[updNum].Val
 	target := p.yStruct.GetLldp().Interface[pathMeta.ifName].Neighbor[pathMeta.nbrId]
 	switch pathMeta.leafName {
 	case "age":
-		target.Age = ygot.Uint64(source.GetUintVal())
+		target.Age = ygot.Uint64(plugins.UintVal(source))
 	case "chassis-id":
-		target.ChassisId = ygot.String(source.GetStringVal())
+		target.ChassisId = ygot.String(plugins.BinaryVal(source))
 	case "chassis-id-type":
 		target.ChassisIdType = ysoclldp.E_OpenconfigLldp_ChassisIdType(
-			p.eMapper.GetEnumFromString(source.GetStringVal(), target.ChassisIdType))
+			p.CheckEnum(p.eMapper.GetEnumFromString(plugins.StringVal(source), target.ChassisIdType), plugins.StringVal(source)))
 	case "id":
-		target.Id = ygot.String(source.GetStringVal())
+		target.Id = ygot.String(plugins.StringVal(source))
 	case "last-update":
-		target.LastUpdate = ygot.Int64(source.GetIntVal())
+		target.LastUpdate = ygot.Int64(plugins.IntVal(source))
 	case "management-address":
-		target.ManagementAddress = ygot.String(source.GetStringVal())
+		target.ManagementAddress = ygot.String(plugins.StringVal(source))
 	case "management-address-type":
-		target.ManagementAddressType = ygot.String(source.GetStringVal())
+		target.ManagementAddressType = ygot.String(plugins.StringVal(source))
 	case "port-description":
-		target.PortDescription = ygot.String(p.sanitizeDescription(source.GetStringVal()))
+		target.PortDescription = ygot.String(p.sanitizeDescription(plugins.StringVal(source)))
 	case "port-id":
-		target.PortId = ygot.String(source.GetStringVal())
+		target.PortId = ygot.String(plugins.BinaryVal(source))
 	case "port-id-type":
 		target.PortIdType = ysoclldp.E_OpenconfigLldp_PortIdType(
-			p.eMapper.GetEnumFromString(source.GetStringVal(), target.PortIdType))
+			p.CheckEnum(p.eMapper.GetEnumFromString(plugins.StringVal(source), target.PortIdType), plugins.StringVal(source)))
 	case "system-description":
-		target.SystemDescription = ygot.String(source.GetStringVal())
+		target.SystemDescription = ygot.String(plugins.StringVal(source))
 	case "system-name":
-		target.SystemName = ygot.String(source.GetStringVal())
+		target.SystemName = ygot.String(plugins.StringVal(source))
 	case "ttl":
-		target.Ttl = ygot.Uint16(uint16(source.GetUintVal()))
+		target.Ttl = ygot.Uint16(uint16(plugins.UintVal(source)))
 	default:
-		p.LeafNotFound()
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
 	}
 }
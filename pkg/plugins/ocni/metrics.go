@@ -0,0 +1,40 @@
+package ocni
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/exporter"
+)
+
+// ocNiIfMetric represents the Openconfig Network Instance Interfaces info metric.
+//
+// Fields:
+// - CustomLabel: Custom label associated with the metric.
+// - NiName: Network instance name.
+// - NiType: Network instance type.
+// - IfId: Network instance interface id.
+// - IfName: Bound interface name.
+// - SubIfIndex: Bound subinterface index.
+type ocNiIfMetric struct {
+	exporter.MetricCommons
+	CustomLabel string `label:"custom_label"`
+	NiName      string `label:"network_instance"`
+	NiType      string `label:"ni_type"`
+	IfId        string `label:"id"`
+	IfName      string `label:"name"`
+	SubIfIndex  string `label:"index"`
+}
+
+// newNiIfMetric creates a new ocNiIfMetric info metric.
+func (f *ocNiFormatter) newNiIfMetric() ocNiIfMetric {
+	metric := ocNiIfMetric{}
+	// Common fields
+	metric.Name = "oc_ni_if"
+	metric.Help = "Openconfig Network Instance Interfaces Metric"
+	metric.Device = f.config.DevName
+	metric.Type = prometheus.GaugeValue
+	metric.Info = true
+	metric.CustomLabel = f.config.CustomLabel
+	return metric
+}
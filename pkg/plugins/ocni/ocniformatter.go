@@ -0,0 +1,89 @@
+package ocni
+
+import (
+	"fmt"
+	log "github.com/automixer/gtexporter/pkg/logger"
+	"github.com/openconfig/ygot/ygot"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/datamodels/ysocni"
+	"github.com/automixer/gtexporter/pkg/exporter"
+	"github.com/automixer/gtexporter/pkg/plugins"
+)
+
+const (
+	plugName  = "oc_network_instance"
+	dataModel = "openconfig-network-instance"
+	// Paths to subscribe
+	niState   = "/network-instances/network-instance/state"
+	niIfState = "/network-instances/network-instance/interfaces/interface/state"
+)
+
+// init register the parser and the formatter to the plugin registration system
+func init() {
+	err := plugins.Register(plugName, newFormatter, newParser)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// ocNiFormatter is a type that represents a formatter for Openconfig network-instance data.
+type ocNiFormatter struct {
+	config plugins.Config
+	root   *ysocni.Root
+}
+
+// newFormatter creates a new instance of ocNiFormatter and initializes its config field with the provided config.
+func newFormatter(cfg plugins.Config) (plugins.Formatter, error) {
+	f := &ocNiFormatter{}
+	f.config = cfg
+	return f, nil
+}
+
+// GetPaths returns the XPaths and Datamodels for the ocNiFormatter plugin.
+func (f *ocNiFormatter) GetPaths() plugins.FormatterPaths {
+	return plugins.FormatterPaths{
+		XPaths:    []string{niState, niIfState},
+		Datamodel: dataModel,
+	}
+}
+
+// Describe returns a slice of exporter.GMetric objects containing the description of the ocNiFormatter plugin.
+func (f *ocNiFormatter) Describe() []exporter.GMetric {
+	return []exporter.GMetric{f.newNiIfMetric()}
+}
+
+// Collect returns a slice of GMetric objects containing network instance interfaces metrics.
+func (f *ocNiFormatter) Collect() []exporter.GMetric {
+	out := make([]exporter.GMetric, 0)
+	out = append(out, f.niIfInfo()...)
+	return out
+}
+
+// ScrapeEvent implements the plugin's formatter interface.
+// It is called by the plugin when a scrape event occurs.
+func (f *ocNiFormatter) ScrapeEvent(ys ygot.GoStruct) func() {
+	f.root = ysocni.GoStructToOcNi(ys)
+	return func() {
+		f.root = nil
+	}
+}
+
+// niIfInfo scans the yGot GoStruct and returns a slice of network-instance/interfaces info metrics
+func (f *ocNiFormatter) niIfInfo() []exporter.GMetric {
+	out := make([]exporter.GMetric, 0, len(f.root.NetworkInstance))
+	for niName, niObject := range f.root.NetworkInstance {
+		for ifId, ifObject := range niObject.Interface {
+			metric := f.newNiIfMetric()
+			metric.NiName = niName
+			metric.NiType = niObject.GetType().ShortString()
+			metric.IfId = ifId
+			metric.IfName = ifObject.GetInterface()
+			if ifObject.Subinterface != nil {
+				metric.SubIfIndex = fmt.Sprint(ifObject.GetSubinterface())
+			}
+			out = append(out, metric)
+		}
+	}
+	return out
+}
@@ -0,0 +1,281 @@
+package ocni
+
+import (
+	"errors"
+	"fmt"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"strings"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/datamodels/ysocni"
+	"github.com/automixer/gtexporter/pkg/plugins"
+)
+
+const yStructInitialSize = 16
+
+// pathMetadata represents metadata extracted from a path.
+// It contains information about the network instance name, interface ID, and leaf name.
+type pathMetadata struct {
+	niName   string
+	ifId     string
+	isIf     bool
+	leafName string
+}
+
+// ocNiParser represents a parser for OpenConfig network-instance data.
+// It implements the plugins.Parser interface and includes a ygot structure for storing network-instance data
+// and an EnumMapper for mapping string enum values to their corresponding integer values.
+type ocNiParser struct {
+	plugins.ParserMon
+	yStruct *ysocni.Root
+	eMapper *ysocni.EnumMapper
+}
+
+// newParser creates a new ocNiParser and initializes its fields based on the given configuration.
+// It returns the newly created parser or an error if there was an issue during initialization.
+func newParser(cfg plugins.Config) (plugins.Parser, error) {
+	p := &ocNiParser{}
+	if err := p.ParserMon.Configure(cfg); err != nil {
+		return nil, err
+	}
+	p.yStruct = &ysocni.Root{
+		NetworkInstance: make(map[string]*ysocni.NetworkInstance, yStructInitialSize),
+	}
+	p.eMapper = ysocni.NewEnumMapper()
+	return p, nil
+}
+
+// CheckOut returns the yGot structure.
+func (p *ocNiParser) CheckOut() ygot.GoStruct {
+	if p.yStruct == nil {
+		panic(fmt.Sprint("yGot structure not initialized"))
+	}
+	return p.yStruct
+}
+
+// ClearCache resets the yGot structure.
+func (p *ocNiParser) ClearCache() {
+	p.yStruct = &ysocni.Root{
+		NetworkInstance: make(map[string]*ysocni.NetworkInstance, yStructInitialSize),
+	}
+}
+
+// getPathMeta returns the metadata of the given path by parsing it and extracting the necessary information.
+// The metadata includes the network instance name, the interface ID and the name of the leaf node.
+// If any of the metadata is missing or the path is invalid, an error is returned.
+func (p *ocNiParser) getPathMeta(pfx, path *gnmi.Path) (*pathMetadata, error) {
+	var fullPath []string
+	out := &pathMetadata{}
+
+	// Build the full path as a slice of strings
+	if pfx != nil {
+		sPfx, err := ygot.PathToStrings(pfx)
+		if err != nil {
+			return nil, err
+		}
+		if len(sPfx) > 0 {
+			fullPath = append(fullPath, sPfx...)
+		}
+	}
+	if path != nil {
+		sPath, err := ygot.PathToStrings(path)
+		if err != nil {
+			return nil, err
+		}
+		fullPath = append(fullPath, sPath...)
+	}
+	if len(fullPath) < 2 {
+		return nil, errors.New("path too short")
+	}
+
+	// Scan fullPath and extract metadata
+	for _, elem := range fullPath {
+		switch {
+		case strings.HasPrefix(elem, "network-instance[name=") && strings.Count(elem, "=") == 1:
+			_, after, found := strings.Cut(elem, "=")
+			if found {
+				out.niName = after[:len(after)-1]
+			}
+		case strings.HasPrefix(elem, "interface[id=") && strings.Count(elem, "=") == 1:
+			_, after, found := strings.Cut(elem, "=")
+			if found {
+				out.isIf = true
+				out.ifId = after[:len(after)-1]
+			}
+		}
+	}
+	out.leafName = fullPath[len(fullPath)-1]
+
+	// Final check
+	if out.niName == "" || out.leafName == "" || (out.isIf && out.ifId == "") {
+		return nil, errors.New("invalid path metadata")
+	}
+	return out, nil
+}
+
+// ParseNotification analyzes a GNMI notification and calls the appropriate decoding method.
+func (p *ocNiParser) ParseNotification(nf *gnmi.Notification) {
+	if p.yStruct == nil {
+		panic(fmt.Sprint("yGot structure not initialized"))
+	}
+
+	// Process GNMI delete messages
+	for _, gDelete := range nf.Delete {
+		p.removeDbEntry(nf.Prefix, gDelete)
+	}
+
+	// Process GNMI update messages
+	for i, update := range nf.Update {
+		// An update without a value would overwrite the cached leaf with a zero value
+		if update.GetVal() == nil {
+			p.NilValue()
+			continue
+		}
+		updHandler := p.updHandlerLookup(nf.Prefix, update.Path)
+		if updHandler == nil {
+			continue
+		}
+		p.UpdateDuplicates(uint64(update.GetDuplicates()))
+		updHandler(nf, i)
+	}
+}
+
+// removeDbEntry removes the yGot GoStruct entry specified by the given prefix and path.
+func (p *ocNiParser) removeDbEntry(pfx, path *gnmi.Path) {
+	pathMeta, err := p.getPathMeta(pfx, path)
+	if err != nil {
+		p.InvalidPath()
+		return
+	}
+
+	ni, ok := p.yStruct.NetworkInstance[pathMeta.niName]
+	if !ok {
+		p.DeleteNotFound()
+		return
+	}
+	if !pathMeta.isIf {
+		// Delete network instance
+		p.yStruct.DeleteNetworkInstance(pathMeta.niName)
+		return
+	}
+	// Delete network instance interface
+	if _, ok := ni.Interface[pathMeta.ifId]; ok {
+		ni.DeleteInterface(pathMeta.ifId)
+	} else {
+		p.DeleteNotFound()
+	}
+}
+
+// updHandlerLookup returns the appropriate decoding handler based on the given prefix and path.
+func (p *ocNiParser) updHandlerLookup(pfx, path *gnmi.Path) func(*gnmi.Notification, int) {
+	sPfx, _ := ygot.PathToSchemaPath(pfx)
+	sPath, _ := ygot.PathToSchemaPath(path)
+	var fullPath string
+	if len(sPfx) > 1 {
+		fullPath += sPfx
+	}
+	fullPath += sPath
+	leafIndex := strings.LastIndex(fullPath, "/")
+	if leafIndex == -1 {
+		p.InvalidPath()
+		return nil
+	}
+
+	// Find the proper handler
+	switch fullPath[:leafIndex] {
+	case niState:
+		return p.niState
+	case niIfState:
+		return p.niIfState
+	default:
+		p.ContainerNotFound()
+	}
+	return nil
+}
+
+// getOrCreateNi returns the network instance with the given name, creating it if missing.
+func (p *ocNiParser) getOrCreateNi(name string) *ysocni.NetworkInstance {
+	if _, ok := p.yStruct.NetworkInstance[name]; !ok {
+		newNi, err := p.yStruct.NewNetworkInstance(name)
+		if err != nil {
+			return nil
+		}
+		newNi.PopulateDefaults()
+	}
+	return p.yStruct.NetworkInstance[name]
+}
+
+// niState updates the yGot structure with the information from the GNMI update message for the
+// network instance state.
+func (p *ocNiParser) niState(nf *gnmi.Notification, updNum int) {
+	pathMeta, err := p.getPathMeta(nf.Prefix, nf.Update[updNum].Path)
+	if err != nil {
+		p.InvalidPath()
+		return
+	}
+	// Create the network instance if missing
+	target := p.getOrCreateNi(pathMeta.niName)
+	if target == nil {
+		return
+	}
+	// Load the gnmi update into yGot struct
+	source := nf.Update[updNum].Val
+	switch pathMeta.leafName {
+	case "description":
+		target.Description = ygot.String(plugins.StringVal(source))
+	case "enabled":
+		target.Enabled = ygot.Bool(plugins.BoolVal(source))
+	case "name":
+		target.Name = ygot.String(plugins.StringVal(source))
+	case "route-distinguisher":
+		target.RouteDistinguisher = ygot.String(plugins.StringVal(source))
+	case "router-id":
+		target.RouterId = ygot.String(plugins.StringVal(source))
+	case "type":
+		target.Type = ysocni.E_OpenconfigNetworkInstanceTypes_NETWORK_INSTANCE_TYPE(
+			p.CheckEnum(p.eMapper.GetEnumFromString(plugins.StringVal(source), target.Type), plugins.StringVal(source)))
+	case "enabled-address-families":
+		// enabled-address-families isn't handled but present to avoid false LeafNotFound() counting
+	default:
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
+	}
+}
+
+// niIfState updates the yGot structure with the information from the GNMI update message for the
+// network instance interface state.
+func (p *ocNiParser) niIfState(nf *gnmi.Notification, updNum int) {
+	pathMeta, err := p.getPathMeta(nf.Prefix, nf.Update[updNum].Path)
+	if err != nil || !pathMeta.isIf {
+		p.InvalidPath()
+		return
+	}
+	// Create the network instance if missing
+	ni := p.getOrCreateNi(pathMeta.niName)
+	if ni == nil {
+		return
+	}
+	// Create the interface if missing
+	if _, ok := ni.Interface[pathMeta.ifId]; !ok {
+		newIf, err := ni.NewInterface(pathMeta.ifId)
+		if err != nil {
+			return
+		}
+		newIf.PopulateDefaults()
+	}
+	// Load the gnmi update into yGot struct
+	source := nf.Update[updNum].Val
+	target := ni.Interface[pathMeta.ifId]
+	switch pathMeta.leafName {
+	case "id":
+		target.Id = ygot.String(plugins.StringVal(source))
+	case "interface":
+		target.Interface = ygot.String(plugins.StringVal(source))
+	case "subinterface":
+		target.Subinterface = ygot.Uint32(uint32(plugins.UintVal(source)))
+	case "associated-address-families":
+		// associated-address-families isn't handled but present to avoid false LeafNotFound() counting
+	default:
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
+	}
+}
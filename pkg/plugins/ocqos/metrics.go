@@ -0,0 +1,43 @@
+package ocqos
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/exporter"
+)
+
+// ocQosQueueMetric represents the Openconfig QoS Interface Queues Metric.
+//
+// Fields:
+// - Metric: Name of the metric.
+// - CustomLabel: Custom label associated with the metric.
+// - IfName: Interface id.
+// - Queue: Queue name.
+type ocQosQueueMetric struct {
+	exporter.MetricCommons
+	Metric      string `label:"metric"`
+	CustomLabel string `label:"custom_label"`
+	IfName      string `label:"name"`
+	Queue       string `label:"queue"`
+}
+
+// qosQueueHelp holds the help string of the ocQosQueueMetric, by metric type.
+var qosQueueHelp = map[prometheus.ValueType]string{
+	prometheus.CounterValue: "Openconfig QoS Interface Queues counters. " +
+		"The metric label carries the counter name (e.g.: transmit_pkts, dropped_octets)",
+	prometheus.GaugeValue: "Openconfig QoS Interface Queues gauges. " +
+		"The metric label carries the gauge name (max-queue-len, avg-queue-len)",
+}
+
+// newQosQueueMetric creates a new ocQosQueueMetric with the given metric type.
+func (f *ocQosFormatter) newQosQueueMetric(mType prometheus.ValueType) ocQosQueueMetric {
+	metric := ocQosQueueMetric{}
+	// Common fields
+	metric.Name = "oc_qos_queue"
+	metric.Help = qosQueueHelp[mType]
+	metric.Device = f.config.DevName
+	metric.Type = mType
+	metric.CustomLabel = f.config.CustomLabel
+	return metric
+}
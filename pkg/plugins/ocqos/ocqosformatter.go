@@ -0,0 +1,109 @@
+package ocqos
+
+import (
+	log "github.com/automixer/gtexporter/pkg/logger"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/prometheus/client_golang/prometheus"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/datamodels/ysocif"
+	"github.com/automixer/gtexporter/pkg/datamodels/ysocqos"
+	"github.com/automixer/gtexporter/pkg/exporter"
+	"github.com/automixer/gtexporter/pkg/plugins"
+)
+
+const (
+	plugName  = "oc_qos"
+	dataModel = "openconfig-qos"
+	// Paths to subscribe
+	queueState = "/qos/interfaces/interface/output/queues/queue/state"
+)
+
+// queueGauges lists the queue state leaves that are not counters.
+var queueGauges = map[string]bool{
+	"max-queue-len": true,
+	"avg-queue-len": true,
+}
+
+// init register the parser and the formatter to the plugin registration system
+func init() {
+	err := plugins.Register(plugName, newFormatter, newParser)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// ocQosFormatter is a type that represents a formatter for Openconfig QoS data.
+type ocQosFormatter struct {
+	config plugins.Config
+	root   *ysocqos.Root
+}
+
+// newFormatter creates a new instance of ocQosFormatter and initializes its config field with the provided config.
+func newFormatter(cfg plugins.Config) (plugins.Formatter, error) {
+	f := &ocQosFormatter{}
+	f.config = cfg
+	return f, nil
+}
+
+// GetPaths returns the XPaths and Datamodels for the ocQosFormatter plugin.
+func (f *ocQosFormatter) GetPaths() plugins.FormatterPaths {
+	return plugins.FormatterPaths{
+		XPaths:    []string{queueState},
+		Datamodel: dataModel,
+	}
+}
+
+// Describe returns a slice of exporter.GMetric objects containing the description of the ocQosFormatter plugin.
+func (f *ocQosFormatter) Describe() []exporter.GMetric {
+	return []exporter.GMetric{
+		f.newQosQueueMetric(prometheus.CounterValue),
+		f.newQosQueueMetric(prometheus.GaugeValue),
+	}
+}
+
+// Collect returns a slice of GMetric objects containing QoS queues metrics.
+func (f *ocQosFormatter) Collect() []exporter.GMetric {
+	out := make([]exporter.GMetric, 0)
+	out = append(out, f.queueMetrics()...)
+	return out
+}
+
+// ScrapeEvent implements the plugin's formatter interface.
+// It is called by the plugin when a scrape event occurs.
+func (f *ocQosFormatter) ScrapeEvent(ys ygot.GoStruct) func() {
+	f.root = ysocqos.GoStructToOcQos(ys)
+	return func() {
+		f.root = nil
+	}
+}
+
+// queueMetrics scans the yGot GoStruct and returns a slice of qos/interfaces/interface/output/queues metrics
+func (f *ocQosFormatter) queueMetrics() []exporter.GMetric {
+	out := make([]exporter.GMetric, 0, len(f.root.GetQos().Interface))
+
+	// Set counters pull mode
+	pullMode := ysocif.Normal
+	if f.config.UseGoDefaults {
+		pullMode = ysocif.UseGoDefault
+	}
+
+	for ifName, ifObject := range f.root.GetQos().Interface {
+		for queueName, queueObject := range ifObject.GetOutput().Queue {
+			values := ysocif.GetCountersFromStruct(*queueObject, pullMode)
+			for valueName, value := range values {
+				mType := prometheus.CounterValue
+				if queueGauges[valueName] {
+					mType = prometheus.GaugeValue
+				}
+				metric := f.newQosQueueMetric(mType)
+				metric.IfName = ifName
+				metric.Queue = queueName
+				metric.Metric = valueName
+				metric.Value = value
+				out = append(out, metric)
+			}
+		}
+	}
+	return out
+}
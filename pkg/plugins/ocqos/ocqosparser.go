@@ -0,0 +1,234 @@
+package ocqos
+
+import (
+	"errors"
+	"fmt"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"strings"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/datamodels/ysocqos"
+	"github.com/automixer/gtexporter/pkg/plugins"
+)
+
+const yStructInitialSize = 128
+
+// pathMetadata represents metadata extracted from a path.
+// It contains information about the interface id, queue name, and leaf name.
+type pathMetadata struct {
+	ifName    string
+	queueName string
+	leafName  string
+}
+
+// ocQosParser represents a parser for OpenConfig QoS data.
+// It implements the plugins.Parser interface and includes a ygot structure for storing QoS data.
+type ocQosParser struct {
+	plugins.ParserMon
+	yStruct *ysocqos.Root
+}
+
+// newParser creates a new ocQosParser and initializes its fields based on the given configuration.
+// It returns the newly created parser or an error if there was an issue during initialization.
+func newParser(cfg plugins.Config) (plugins.Parser, error) {
+	p := &ocQosParser{}
+	if err := p.ParserMon.Configure(cfg); err != nil {
+		return nil, err
+	}
+	p.ClearCache()
+	return p, nil
+}
+
+// CheckOut returns the yGot structure.
+func (p *ocQosParser) CheckOut() ygot.GoStruct {
+	if p.yStruct == nil {
+		panic(fmt.Sprint("yGot structure not initialized"))
+	}
+	return p.yStruct
+}
+
+// ClearCache resets the yGot structure, populates default values, and initializes the Qos.Interface map.
+func (p *ocQosParser) ClearCache() {
+	p.yStruct = &ysocqos.Root{}
+	p.yStruct.PopulateDefaults()
+	p.yStruct.Qos.Interface = make(map[string]*ysocqos.Qos_Interface, yStructInitialSize)
+}
+
+// getPathMeta returns the metadata of the given path by parsing it and extracting the necessary information.
+// The metadata includes the interface id, the queue name, and the name of the leaf node.
+// If any of the metadata is missing or the path is invalid, an error is returned.
+func (p *ocQosParser) getPathMeta(pfx, path *gnmi.Path) (*pathMetadata, error) {
+	var fullPath []string
+	out := &pathMetadata{}
+
+	// Build the full path as a slice of strings
+	if pfx != nil {
+		sPfx, err := ygot.PathToStrings(pfx)
+		if err != nil {
+			return nil, err
+		}
+		if len(sPfx) > 0 {
+			fullPath = append(fullPath, sPfx...)
+		}
+	}
+	if path != nil {
+		sPath, err := ygot.PathToStrings(path)
+		if err != nil {
+			return nil, err
+		}
+		fullPath = append(fullPath, sPath...)
+	}
+	if len(fullPath) < 2 {
+		return nil, errors.New("path too short")
+	}
+
+	// Scan fullPath and extract metadata
+	for _, elem := range fullPath {
+		switch {
+		case strings.HasPrefix(elem, "interface[interface-id=") && strings.Count(elem, "=") == 1:
+			_, after, found := strings.Cut(elem, "=")
+			if found {
+				out.ifName = after[:len(after)-1]
+			}
+		case strings.HasPrefix(elem, "queue[name=") && strings.Count(elem, "=") == 1:
+			_, after, found := strings.Cut(elem, "=")
+			if found {
+				out.queueName = after[:len(after)-1]
+			}
+		}
+	}
+	out.leafName = fullPath[len(fullPath)-1]
+
+	// Final check
+	if out.ifName == "" || out.leafName == "" {
+		return nil, errors.New("invalid path metadata")
+	}
+	return out, nil
+}
+
+// ParseNotification analyzes a GNMI notification and calls the appropriate decoding method.
+func (p *ocQosParser) ParseNotification(nf *gnmi.Notification) {
+	if p.yStruct == nil {
+		panic(fmt.Sprint("yGot structure not initialized"))
+	}
+
+	// Process GNMI delete messages
+	for _, gDelete := range nf.Delete {
+		p.removeDbEntry(nf.Prefix, gDelete)
+	}
+
+	// Process GNMI update messages
+	for i, update := range nf.Update {
+		// An update without a value would overwrite the cached leaf with a zero value
+		if update.GetVal() == nil {
+			p.NilValue()
+			continue
+		}
+		updHandler := p.updHandlerLookup(nf.Prefix, update.Path)
+		if updHandler == nil {
+			continue
+		}
+		p.UpdateDuplicates(uint64(update.GetDuplicates()))
+		updHandler(nf, i)
+	}
+}
+
+// removeDbEntry removes the yGot GoStruct entry specified by the given prefix and path.
+func (p *ocQosParser) removeDbEntry(pfx, path *gnmi.Path) {
+	pathMeta, err := p.getPathMeta(pfx, path)
+	if err != nil {
+		p.InvalidPath()
+		return
+	}
+
+	iface, ok := p.yStruct.GetQos().Interface[pathMeta.ifName]
+	if !ok {
+		p.DeleteNotFound()
+		return
+	}
+	if pathMeta.queueName == "" {
+		// Delete interface
+		p.yStruct.GetQos().DeleteInterface(pathMeta.ifName)
+		return
+	}
+	// Delete queue
+	if _, ok := iface.GetOutput().Queue[pathMeta.queueName]; ok {
+		iface.GetOutput().DeleteQueue(pathMeta.queueName)
+	} else {
+		p.DeleteNotFound()
+	}
+}
+
+// updHandlerLookup returns the appropriate decoding handler based on the given prefix and path.
+func (p *ocQosParser) updHandlerLookup(pfx, path *gnmi.Path) func(*gnmi.Notification, int) {
+	sPfx, _ := ygot.PathToSchemaPath(pfx)
+	sPath, _ := ygot.PathToSchemaPath(path)
+	var fullPath string
+	if len(sPfx) > 1 {
+		fullPath += sPfx
+	}
+	fullPath += sPath
+	leafIndex := strings.LastIndex(fullPath, "/")
+	if leafIndex == -1 {
+		p.InvalidPath()
+		return nil
+	}
+
+	// Find the proper handler
+	switch fullPath[:leafIndex] {
+	case queueState:
+		return p.queueState
+	default:
+		p.ContainerNotFound()
+	}
+	return nil
+}
+
+// queueState updates the yGot structure with the information from the GNMI update message for the
+// interface output queue state.
+func (p *ocQosParser) queueState(nf *gnmi.Notification, updNum int) {
+	pathMeta, err := p.getPathMeta(nf.Prefix, nf.Update[updNum].Path)
+	if err != nil || pathMeta.queueName == "" {
+		p.InvalidPath()
+		return
+	}
+	// Create the interface if missing
+	if _, ok := p.yStruct.GetQos().Interface[pathMeta.ifName]; !ok {
+		newIf, err := p.yStruct.GetQos().NewInterface(pathMeta.ifName)
+		if err != nil {
+			return
+		}
+		newIf.PopulateDefaults()
+	}
+	// Create the queue if missing
+	output := p.yStruct.GetQos().Interface[pathMeta.ifName].GetOrCreateOutput()
+	if _, ok := output.Queue[pathMeta.queueName]; !ok {
+		newQueue, err := output.NewQueue(pathMeta.queueName)
+		if err != nil {
+			return
+		}
+		newQueue.PopulateDefaults()
+	}
+	// Load the gnmi update into yGot struct
+	source := nf.Update[updNum].Val
+	target := output.Queue[pathMeta.queueName]
+	switch pathMeta.leafName {
+	case "avg-queue-len":
+		target.AvgQueueLen = ygot.Uint64(plugins.UintVal(source))
+	case "dropped-octets":
+		target.DroppedOctets = ygot.Uint64(plugins.UintVal(source))
+	case "dropped-pkts":
+		target.DroppedPkts = ygot.Uint64(plugins.UintVal(source))
+	case "max-queue-len":
+		target.MaxQueueLen = ygot.Uint64(plugins.UintVal(source))
+	case "name":
+		target.Name = ygot.String(plugins.StringVal(source))
+	case "transmit-octets":
+		target.TransmitOctets = ygot.Uint64(plugins.UintVal(source))
+	case "transmit-pkts":
+		target.TransmitPkts = ygot.Uint64(plugins.UintVal(source))
+	default:
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
+	}
+}
@@ -0,0 +1,88 @@
+package ocsystem
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/exporter"
+)
+
+// ocSysInfoMetric represents the Openconfig System global state info Metric.
+//
+// Fields:
+// - CustomLabel: Custom label associated with the metric.
+// - Hostname: Device hostname.
+// - SoftwareVersion: Operating system version of the active controller.
+type ocSysInfoMetric struct {
+	exporter.MetricCommons
+	CustomLabel     string `label:"custom_label"`
+	Hostname        string `label:"hostname"`
+	SoftwareVersion string `label:"software_version"`
+}
+
+// newSysInfoMetric creates a new ocSysInfoMetric.
+func (f *ocSysFormatter) newSysInfoMetric() ocSysInfoMetric {
+	metric := ocSysInfoMetric{}
+	// Common fields
+	metric.Name = "device_system"
+	metric.Help = "Openconfig System hostname and software version"
+	metric.Device = f.config.DevName
+	metric.Type = prometheus.GaugeValue
+	metric.Info = true
+	metric.CustomLabel = f.config.CustomLabel
+	return metric
+}
+
+// ocSysNtpMetric represents the Openconfig System NTP servers Metric.
+//
+// Fields:
+// - Metric: Name of the metric.
+// - CustomLabel: Custom label associated with the metric.
+// - Address: NTP server address or hostname.
+type ocSysNtpMetric struct {
+	exporter.MetricCommons
+	Metric      string `label:"metric"`
+	CustomLabel string `label:"custom_label"`
+	Address     string `label:"address"`
+}
+
+// newSysNtpMetric creates a new ocSysNtpMetric gauge.
+func (f *ocSysFormatter) newSysNtpMetric() ocSysNtpMetric {
+	metric := ocSysNtpMetric{}
+	// Common fields
+	metric.Name = "oc_sys_ntp_server"
+	metric.Help = "Openconfig System NTP servers gauges. " +
+		"The metric label carries the gauge name (e.g.: offset_ms, stratum, root_delay_ms)"
+	metric.Device = f.config.DevName
+	metric.Type = prometheus.GaugeValue
+	metric.CustomLabel = f.config.CustomLabel
+	return metric
+}
+
+// ocSysProcessMetric represents the Openconfig System processes Metric.
+//
+// Fields:
+// - Metric: Name of the metric.
+// - CustomLabel: Custom label associated with the metric.
+// - Pid: Process pid.
+// - ProcName: Process name.
+type ocSysProcessMetric struct {
+	exporter.MetricCommons
+	Metric      string `label:"metric"`
+	CustomLabel string `label:"custom_label"`
+	Pid         string `label:"pid"`
+	ProcName    string `label:"name"`
+}
+
+// newSysProcessMetric creates a new ocSysProcessMetric gauge.
+func (f *ocSysFormatter) newSysProcessMetric() ocSysProcessMetric {
+	metric := ocSysProcessMetric{}
+	// Common fields
+	metric.Name = "oc_sys_process"
+	metric.Help = "Openconfig System processes gauges. " +
+		"The metric label carries the gauge name (cpu_utilization, memory_usage, memory_utilization)"
+	metric.Device = f.config.DevName
+	metric.Type = prometheus.GaugeValue
+	metric.CustomLabel = f.config.CustomLabel
+	return metric
+}
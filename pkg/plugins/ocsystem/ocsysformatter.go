@@ -0,0 +1,180 @@
+package ocsystem
+
+import (
+	"fmt"
+	log "github.com/automixer/gtexporter/pkg/logger"
+	"github.com/openconfig/ygot/ygot"
+	"strconv"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/datamodels/ysocsys"
+	"github.com/automixer/gtexporter/pkg/exporter"
+	"github.com/automixer/gtexporter/pkg/plugins"
+)
+
+const (
+	plugName  = "oc_system"
+	dataModel = "openconfig-system"
+	// Paths to subscribe
+	systemState    = "/system/state"
+	ntpServerState = "/system/ntp/servers/server/state"
+	processState   = "/system/processes/process/state"
+)
+
+// systemInfoLeaves lists the systemState leaves subscribed for the system info metric.
+// The rest of the container (e.g.: current-datetime) is not subscribed.
+var systemInfoLeaves = []string{"hostname", "software-version"}
+
+// init register the parser and the formatter to the plugin registration system
+func init() {
+	err := plugins.Register(plugName, newFormatter, newParser)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// ocSysFormatter is a type that represents a formatter for Openconfig System data.
+type ocSysFormatter struct {
+	config         plugins.Config
+	root           *ysocsys.Root
+	ntpOffsets     map[string]float64 // Key: NTP server address
+	disableNtp     bool
+	disableProcess bool
+	disableSysInfo bool
+}
+
+// newFormatter creates a new instance of ocSysFormatter and initializes its config field with the provided config.
+func newFormatter(cfg plugins.Config) (plugins.Formatter, error) {
+	f := &ocSysFormatter{}
+	f.config = cfg
+	f.disableNtp, _ = strconv.ParseBool(f.config.Options["disable_ntp"])
+	f.disableProcess, _ = strconv.ParseBool(f.config.Options["disable_process"])
+	f.disableSysInfo, _ = strconv.ParseBool(f.config.Options["disable_system_info"])
+	return f, nil
+}
+
+// GetPaths returns the XPaths and Datamodels for the ocSysFormatter plugin.
+func (f *ocSysFormatter) GetPaths() plugins.FormatterPaths {
+	fp := plugins.FormatterPaths{
+		Datamodel: dataModel,
+	}
+	if !f.disableSysInfo {
+		for _, leaf := range systemInfoLeaves {
+			fp.XPaths = append(fp.XPaths, systemState+"/"+leaf)
+		}
+	}
+	if !f.disableNtp {
+		fp.XPaths = append(fp.XPaths, ntpServerState)
+	}
+	if !f.disableProcess {
+		fp.XPaths = append(fp.XPaths, processState)
+	}
+	return fp
+}
+
+// Describe returns a slice of exporter.GMetric objects containing the description of the ocSysFormatter plugin.
+func (f *ocSysFormatter) Describe() []exporter.GMetric {
+	return []exporter.GMetric{
+		f.newSysInfoMetric(),
+		f.newSysNtpMetric(),
+		f.newSysProcessMetric(),
+	}
+}
+
+// Collect returns a slice of GMetric objects containing system info, NTP servers and processes metrics.
+func (f *ocSysFormatter) Collect() []exporter.GMetric {
+	out := make([]exporter.GMetric, 0)
+	if !f.disableSysInfo {
+		out = append(out, f.systemInfo()...)
+	}
+	if !f.disableNtp {
+		out = append(out, f.ntpMetrics()...)
+	}
+	if !f.disableProcess {
+		out = append(out, f.processMetrics()...)
+	}
+	return out
+}
+
+// ScrapeEvent implements the plugin's formatter interface.
+// It is called by the plugin when a scrape event occurs.
+func (f *ocSysFormatter) ScrapeEvent(ys ygot.GoStruct) func() {
+	f.root = ysocsys.GoStructToOcSys(ys)
+	f.ntpOffsets = ysocsys.GoStructToNtpOffsets(ys)
+	return func() {
+		f.root = nil
+		f.ntpOffsets = nil
+	}
+}
+
+// systemInfo returns the system info metric, a single series per device.
+// Nothing is returned until the device sends one of its leaves, unless use_go_defaults is set.
+func (f *ocSysFormatter) systemInfo() []exporter.GMetric {
+	system := f.root.GetSystem()
+	if system.Hostname == nil && system.SoftwareVersion == nil && !f.config.UseGoDefaults {
+		return nil
+	}
+	metric := f.newSysInfoMetric()
+	metric.Hostname = system.GetHostname()
+	metric.SoftwareVersion = system.GetSoftwareVersion()
+	return []exporter.GMetric{metric}
+}
+
+// ntpMetrics scans the yGot GoStruct and returns a slice of NTP servers metrics.
+// Leaves not received from the device are skipped, unless use_go_defaults is set.
+func (f *ocSysFormatter) ntpMetrics() []exporter.GMetric {
+	out := make([]exporter.GMetric, 0, len(f.root.GetSystem().GetNtp().Server))
+	for address, server := range f.root.GetSystem().GetNtp().Server {
+		gauges := make(map[string]float64)
+		if offset, ok := f.ntpOffsets[address]; ok || f.config.UseGoDefaults {
+			gauges["offset_ms"] = offset
+		}
+		if server.Stratum != nil || f.config.UseGoDefaults {
+			gauges["stratum"] = float64(server.GetStratum())
+		}
+		if server.RootDelay != nil || f.config.UseGoDefaults {
+			gauges["root_delay_ms"] = float64(server.GetRootDelay())
+		}
+		if server.RootDispersion != nil || f.config.UseGoDefaults {
+			gauges["root_dispersion_ms"] = float64(server.GetRootDispersion())
+		}
+		if server.PollInterval != nil || f.config.UseGoDefaults {
+			gauges["poll_interval_seconds"] = float64(server.GetPollInterval())
+		}
+		for gaugeName, gaugeValue := range gauges {
+			metric := f.newSysNtpMetric()
+			metric.Address = address
+			metric.Metric = gaugeName
+			metric.Value = gaugeValue
+			out = append(out, metric)
+		}
+	}
+	return out
+}
+
+// processMetrics scans the yGot GoStruct and returns a slice of processes metrics.
+// Leaves not received from the device are skipped, unless use_go_defaults is set.
+func (f *ocSysFormatter) processMetrics() []exporter.GMetric {
+	out := make([]exporter.GMetric, 0, len(f.root.GetSystem().Process))
+	for pid, process := range f.root.GetSystem().Process {
+		gauges := make(map[string]float64)
+		if process.CpuUtilization != nil || f.config.UseGoDefaults {
+			gauges["cpu_utilization"] = float64(process.GetCpuUtilization())
+		}
+		if process.MemoryUsage != nil || f.config.UseGoDefaults {
+			gauges["memory_usage"] = float64(process.GetMemoryUsage())
+		}
+		if process.MemoryUtilization != nil || f.config.UseGoDefaults {
+			gauges["memory_utilization"] = float64(process.GetMemoryUtilization())
+		}
+		for gaugeName, gaugeValue := range gauges {
+			metric := f.newSysProcessMetric()
+			metric.Pid = fmt.Sprint(pid)
+			metric.ProcName = process.GetName()
+			metric.Metric = gaugeName
+			metric.Value = gaugeValue
+			out = append(out, metric)
+		}
+	}
+	return out
+}
@@ -0,0 +1,280 @@
+package ocsystem
+
+import (
+	"errors"
+	"fmt"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"strconv"
+	"strings"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/datamodels/ysocsys"
+	"github.com/automixer/gtexporter/pkg/plugins"
+)
+
+const yStructInitialSize = 64
+
+// pathMetadata represents metadata extracted from a path.
+// It contains the NTP server address or the process pid, depending on the list the path belongs to,
+// and the leaf name. System global state paths have no keys.
+type pathMetadata struct {
+	address  string
+	pid      uint64
+	isProc   bool
+	isGlobal bool
+	leafName string
+}
+
+// ocSysParser represents a parser for OpenConfig System data.
+// It implements the plugins.Parser interface and includes a ygot structure for storing NTP and processes data.
+type ocSysParser struct {
+	plugins.ParserMon
+	yStruct    *ysocsys.Root
+	ntpOffsets map[string]float64 // Key: NTP server address. Signed, possibly decimal: not stored into yStruct
+}
+
+// newParser creates a new ocSysParser and initializes its fields based on the given configuration.
+// It returns the newly created parser or an error if there was an issue during initialization.
+func newParser(cfg plugins.Config) (plugins.Parser, error) {
+	p := &ocSysParser{}
+	if err := p.ParserMon.Configure(cfg); err != nil {
+		return nil, err
+	}
+	p.ClearCache()
+	return p, nil
+}
+
+// CheckOut returns the yGot structure, along with the NTP servers offset.
+func (p *ocSysParser) CheckOut() ygot.GoStruct {
+	if p.yStruct == nil {
+		panic(fmt.Sprint("yGot structure not initialized"))
+	}
+	return &ysocsys.NtpOffsetRoot{Root: p.yStruct, NtpOffsets: p.ntpOffsets}
+}
+
+// ClearCache resets the yGot structure, populates default values, and initializes the lists.
+func (p *ocSysParser) ClearCache() {
+	p.yStruct = &ysocsys.Root{}
+	p.yStruct.PopulateDefaults()
+	p.yStruct.GetOrCreateSystem().GetOrCreateNtp().Server = make(map[string]*ysocsys.System_Ntp_Server, yStructInitialSize)
+	p.yStruct.GetSystem().Process = make(map[uint64]*ysocsys.System_Process, yStructInitialSize)
+	p.ntpOffsets = make(map[string]float64, yStructInitialSize)
+}
+
+// getPathMeta returns the metadata of the given path by scanning its elements and extracting the necessary
+// information. The NTP server address and the process pid are read from the list keys.
+// System global state leaves (/system/state/<leaf>) are key-less.
+// If any of the metadata is missing or the path is invalid, an error is returned.
+func (p *ocSysParser) getPathMeta(pfx, path *gnmi.Path) (*pathMetadata, error) {
+	var elems []*gnmi.PathElem
+	out := &pathMetadata{}
+
+	// Build the full path as a slice of path elements
+	elems = append(elems, pfx.GetElem()...)
+	elems = append(elems, path.GetElem()...)
+	if len(elems) < 2 {
+		return nil, errors.New("path too short")
+	}
+
+	// Scan the path elements and extract metadata
+	var hasKey bool
+	for _, elem := range elems {
+		switch elem.GetName() {
+		case "server":
+			out.address, hasKey = elem.GetKey()["address"]
+		case "process":
+			pid, err := strconv.ParseUint(elem.GetKey()["pid"], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			out.pid = pid
+			out.isProc = true
+			hasKey = true
+		}
+	}
+	out.leafName = elems[len(elems)-1].GetName()
+	out.isGlobal = len(elems) == 3 && elems[0].GetName() == "system" && elems[1].GetName() == "state"
+
+	// Final check
+	if out.isGlobal {
+		return out, nil
+	}
+	if !hasKey || out.leafName == "" || (!out.isProc && out.address == "") {
+		return nil, errors.New("invalid path metadata")
+	}
+	return out, nil
+}
+
+// ParseNotification analyzes a GNMI notification and calls the appropriate decoding method.
+func (p *ocSysParser) ParseNotification(nf *gnmi.Notification) {
+	if p.yStruct == nil {
+		panic(fmt.Sprint("yGot structure not initialized"))
+	}
+
+	// Process GNMI delete messages
+	for _, gDelete := range nf.Delete {
+		p.removeDbEntry(nf.Prefix, gDelete)
+	}
+
+	// Process GNMI update messages
+	for i, update := range nf.Update {
+		// An update without a value would overwrite the cached leaf with a zero value
+		if update.GetVal() == nil {
+			p.NilValue()
+			continue
+		}
+		updHandler := p.updHandlerLookup(nf.Prefix, update.Path)
+		if updHandler == nil {
+			continue
+		}
+		p.UpdateDuplicates(uint64(update.GetDuplicates()))
+		updHandler(nf, i)
+	}
+}
+
+// removeDbEntry removes the yGot GoStruct entry specified by the given prefix and path.
+// Only system global state leaves, NTP server and process deletes are supported.
+func (p *ocSysParser) removeDbEntry(pfx, path *gnmi.Path) {
+	pathMeta, err := p.getPathMeta(pfx, path)
+	if err != nil {
+		p.InvalidPath()
+		return
+	}
+	if pathMeta.isGlobal {
+		switch pathMeta.leafName {
+		case "hostname":
+			p.yStruct.GetSystem().Hostname = nil
+		case "software-version":
+			p.yStruct.GetSystem().SoftwareVersion = nil
+		default:
+			p.DeleteNotFound()
+		}
+		return
+	}
+	if pathMeta.isProc {
+		if p.yStruct.GetSystem().GetProcess(pathMeta.pid) == nil {
+			p.DeleteNotFound()
+			return
+		}
+		p.yStruct.GetSystem().DeleteProcess(pathMeta.pid)
+		return
+	}
+	if p.yStruct.GetSystem().GetNtp().GetServer(pathMeta.address) == nil {
+		p.DeleteNotFound()
+		return
+	}
+	p.yStruct.GetSystem().GetNtp().DeleteServer(pathMeta.address)
+	delete(p.ntpOffsets, pathMeta.address)
+}
+
+// updHandlerLookup returns the appropriate decoding handler based on the given prefix and path.
+func (p *ocSysParser) updHandlerLookup(pfx, path *gnmi.Path) func(*gnmi.Notification, int) {
+	sPfx, _ := ygot.PathToSchemaPath(pfx)
+	sPath, _ := ygot.PathToSchemaPath(path)
+	var fullPath string
+	if len(sPfx) > 1 {
+		fullPath += sPfx
+	}
+	fullPath += sPath
+	leafIndex := strings.LastIndex(fullPath, "/")
+	if leafIndex == -1 {
+		p.InvalidPath()
+		return nil
+	}
+
+	// Find the proper handler
+	switch fullPath[:leafIndex] {
+	case systemState:
+		return p.systemState
+	case ntpServerState:
+		return p.ntpServerState
+	case processState:
+		return p.processState
+	default:
+		p.ContainerNotFound()
+	}
+	return nil
+}
+
+// systemState updates the yGot structure with the information from the GNMI update message for the
+// system global state.
+func (p *ocSysParser) systemState(nf *gnmi.Notification, updNum int) {
+	pathMeta, err := p.getPathMeta(nf.Prefix, nf.Update[updNum].Path)
+	if err != nil || !pathMeta.isGlobal {
+		p.InvalidPath()
+		return
+	}
+	target := p.yStruct.GetSystem()
+	// Load the gnmi update into yGot struct
+	source := nf.Update[updNum].Val
+	switch pathMeta.leafName {
+	case "hostname":
+		target.Hostname = ygot.String(plugins.StringVal(source))
+	case "software-version":
+		target.SoftwareVersion = ygot.String(plugins.StringVal(source))
+	default:
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
+	}
+}
+
+// ntpServerState updates the yGot structure with the information from the GNMI update message for the
+// NTP server state. The offset is a signed value, possibly decimal: it is kept into ntpOffsets, since the
+// upstream model declares it as uint64.
+func (p *ocSysParser) ntpServerState(nf *gnmi.Notification, updNum int) {
+	pathMeta, err := p.getPathMeta(nf.Prefix, nf.Update[updNum].Path)
+	if err != nil || pathMeta.isProc {
+		p.InvalidPath()
+		return
+	}
+	target := p.yStruct.GetSystem().GetNtp().GetOrCreateServer(pathMeta.address)
+	// Load the gnmi update into yGot struct
+	source := nf.Update[updNum].Val
+	switch pathMeta.leafName {
+	case "address":
+		// List key. Already set
+	case "port":
+		target.Port = ygot.Uint16(uint16(plugins.UintVal(source)))
+	case "stratum":
+		target.Stratum = ygot.Uint8(uint8(plugins.UintVal(source)))
+	case "root-delay":
+		target.RootDelay = ygot.Uint32(uint32(plugins.UintVal(source)))
+	case "root-dispersion":
+		target.RootDispersion = ygot.Uint64(plugins.UintVal(source))
+	case "offset":
+		p.ntpOffsets[pathMeta.address] = plugins.FloatVal(source)
+	case "poll-interval":
+		target.PollInterval = ygot.Uint32(uint32(plugins.UintVal(source)))
+	default:
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
+	}
+}
+
+// processState updates the yGot structure with the information from the GNMI update message for the
+// process state.
+func (p *ocSysParser) processState(nf *gnmi.Notification, updNum int) {
+	pathMeta, err := p.getPathMeta(nf.Prefix, nf.Update[updNum].Path)
+	if err != nil || !pathMeta.isProc {
+		p.InvalidPath()
+		return
+	}
+	target := p.yStruct.GetSystem().GetOrCreateProcess(pathMeta.pid)
+	// Load the gnmi update into yGot struct
+	source := nf.Update[updNum].Val
+	switch pathMeta.leafName {
+	case "pid":
+		// List key. Already set
+	case "name":
+		target.Name = ygot.String(plugins.StringVal(source))
+	case "cpu-utilization":
+		target.CpuUtilization = ygot.Uint8(uint8(plugins.UintVal(source)))
+	case "memory-usage":
+		target.MemoryUsage = ygot.Uint64(plugins.UintVal(source))
+	case "memory-utilization":
+		target.MemoryUtilization = ygot.Uint8(uint8(plugins.UintVal(source)))
+	case "args", "start-time", "cpu-usage-user", "cpu-usage-system":
+		// Not handled but present to avoid false LeafNotFound() counting
+	default:
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
+	}
+}
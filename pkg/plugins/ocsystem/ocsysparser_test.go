@@ -0,0 +1,61 @@
+package ocsystem
+
+import (
+	"github.com/automixer/gtexporter/pkg/datamodels/ysocsys"
+	"github.com/automixer/gtexporter/pkg/plugins"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"testing"
+)
+
+// mustPath converts an xPath into a gNMI path.
+func mustPath(t *testing.T, xPath string) *gnmi.Path {
+	t.Helper()
+	p, err := ygot.StringToStructuredPath(xPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestParseNtpOffset(t *testing.T) {
+	p, err := newParser(plugins.Config{DevName: "dev1", PlugName: "oc_system", PlugId: "oc_system",
+		Options: map[string]string{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pfx := mustPath(t, "/system/ntp/servers/server[address=10.0.0.1]/state")
+	p.ParseNotification(&gnmi.Notification{
+		Prefix: pfx,
+		Update: []*gnmi.Update{
+			{
+				Path: mustPath(t, "/offset"),
+				Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_DoubleVal{DoubleVal: -0.25}},
+			},
+			{
+				Path: mustPath(t, "/stratum"),
+				Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 2}},
+			},
+		},
+	})
+
+	ys := p.CheckOut()
+	if got, ok := ysocsys.GoStructToNtpOffsets(ys)["10.0.0.1"]; !ok || got != -0.25 {
+		t.Errorf("offset is %v (found: %v), want -0.25", got, ok)
+	}
+	server := ysocsys.GoStructToOcSys(ys).GetSystem().GetNtp().GetServer("10.0.0.1")
+	if server.Offset != nil {
+		t.Errorf("offset stored into the GoStruct as %d, want nil", server.GetOffset())
+	}
+	if got := server.GetStratum(); got != 2 {
+		t.Errorf("stratum is %d, want 2", got)
+	}
+
+	// Deleting the server drops its offset too
+	p.ParseNotification(&gnmi.Notification{
+		Delete: []*gnmi.Path{mustPath(t, "/system/ntp/servers/server[address=10.0.0.1]")},
+	})
+	if offsets := ysocsys.GoStructToNtpOffsets(p.CheckOut()); len(offsets) != 0 {
+		t.Errorf("offsets are %v after the server delete, want none", offsets)
+	}
+}
@@ -0,0 +1,45 @@
+package octermdev
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/exporter"
+)
+
+// ocTdChannelMetric represents the Openconfig Terminal Device Logical Channels Metric.
+//
+// Fields:
+// - Metric: Name of the metric.
+// - Stat: Statistic of the metric (instant, avg, min or max). Empty for counters.
+// - CustomLabel: Custom label associated with the metric.
+// - Index: Logical channel index.
+// - Description: Logical channel description.
+type ocTdChannelMetric struct {
+	exporter.MetricCommons
+	Metric      string `label:"metric"`
+	Stat        string `label:"stat"`
+	CustomLabel string `label:"custom_label"`
+	Index       string `label:"index"`
+	Description string `label:"description"`
+}
+
+// tdChannelHelp holds the help string of the ocTdChannelMetric, by metric type.
+var tdChannelHelp = map[prometheus.ValueType]string{
+	prometheus.CounterValue: "Openconfig Terminal Device logical channels OTN counters. " +
+		"The metric label carries the counter name (e.g.: fec_uncorrectable_blocks)",
+	prometheus.GaugeValue: "Openconfig Terminal Device logical channels OTN gauges. " +
+		"The metric label carries the gauge name (pre_fec_ber, q_value, esnr), the stat label its statistic",
+}
+
+// newTdChannelMetric creates a new ocTdChannelMetric with the given metric type.
+func (f *ocTdFormatter) newTdChannelMetric(mType prometheus.ValueType) ocTdChannelMetric {
+	metric := ocTdChannelMetric{}
+	// Common fields
+	metric.Name = "oc_td_channel"
+	metric.Help = tdChannelHelp[mType]
+	metric.Device = f.config.DevName
+	metric.Type = mType
+	metric.CustomLabel = f.config.CustomLabel
+	return metric
+}
@@ -0,0 +1,129 @@
+package octermdev
+
+import (
+	"fmt"
+	log "github.com/automixer/gtexporter/pkg/logger"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/prometheus/client_golang/prometheus"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/datamodels/ysoctd"
+	"github.com/automixer/gtexporter/pkg/exporter"
+	"github.com/automixer/gtexporter/pkg/plugins"
+)
+
+const (
+	plugName  = "oc_terminal_device"
+	dataModel = "openconfig-terminal-device"
+	// Paths to subscribe
+	channelState = "/terminal-device/logical-channels/channel/state"
+	otnState     = "/terminal-device/logical-channels/channel/otn/state"
+)
+
+// init register the parser and the formatter to the plugin registration system
+func init() {
+	err := plugins.Register(plugName, newFormatter, newParser)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+// ocTdFormatter is a type that represents a formatter for Openconfig Terminal Device data.
+type ocTdFormatter struct {
+	config plugins.Config
+	root   *ysoctd.Root
+}
+
+// newFormatter creates a new instance of ocTdFormatter and initializes its config field with the provided config.
+func newFormatter(cfg plugins.Config) (plugins.Formatter, error) {
+	f := &ocTdFormatter{}
+	f.config = cfg
+	return f, nil
+}
+
+// GetPaths returns the XPaths and Datamodels for the ocTdFormatter plugin.
+func (f *ocTdFormatter) GetPaths() plugins.FormatterPaths {
+	return plugins.FormatterPaths{
+		XPaths:    []string{channelState, otnState},
+		Datamodel: dataModel,
+	}
+}
+
+// Describe returns a slice of exporter.GMetric objects containing the description of the ocTdFormatter plugin.
+func (f *ocTdFormatter) Describe() []exporter.GMetric {
+	return []exporter.GMetric{
+		f.newTdChannelMetric(prometheus.CounterValue),
+		f.newTdChannelMetric(prometheus.GaugeValue),
+	}
+}
+
+// Collect returns a slice of GMetric objects containing logical channels metrics.
+func (f *ocTdFormatter) Collect() []exporter.GMetric {
+	out := make([]exporter.GMetric, 0)
+	out = append(out, f.channelMetrics()...)
+	return out
+}
+
+// ScrapeEvent implements the plugin's formatter interface.
+// It is called by the plugin when a scrape event occurs.
+func (f *ocTdFormatter) ScrapeEvent(ys ygot.GoStruct) func() {
+	f.root = ysoctd.GoStructToOcTd(ys)
+	return func() {
+		f.root = nil
+	}
+}
+
+// otnStats returns the avg/min/max/instant statistics of an OTN gauge, keyed by statistic name.
+func otnStats(instant, avg, minimum, maximum *float64) map[string]*float64 {
+	return map[string]*float64{"instant": instant, "avg": avg, "min": minimum, "max": maximum}
+}
+
+// channelMetrics scans the yGot GoStruct and returns a slice of terminal-device logical channels metrics.
+// Statistics not received from the device are skipped, unless use_go_defaults is set.
+func (f *ocTdFormatter) channelMetrics() []exporter.GMetric {
+	out := make([]exporter.GMetric, 0, len(f.root.GetTerminalDevice().Channel))
+	for index, channel := range f.root.GetTerminalDevice().Channel {
+		otn := channel.GetOtn()
+		if otn == nil {
+			continue
+		}
+		// Counters
+		if otn.FecUncorrectableBlocks != nil || f.config.UseGoDefaults {
+			metric := f.newTdChannelMetric(prometheus.CounterValue)
+			metric.Index = fmt.Sprint(index)
+			metric.Description = channel.GetDescription()
+			metric.Metric = "fec-uncorrectable-blocks"
+			metric.Value = float64(otn.GetFecUncorrectableBlocks())
+			out = append(out, metric)
+		}
+		// Gauges
+		gauges := make(map[string]map[string]*float64)
+		if s := otn.GetPreFecBer(); s != nil {
+			gauges["pre_fec_ber"] = otnStats(s.Instant, s.Avg, s.Min, s.Max)
+		}
+		if s := otn.GetQValue(); s != nil {
+			gauges["q_value"] = otnStats(s.Instant, s.Avg, s.Min, s.Max)
+		}
+		if s := otn.GetEsnr(); s != nil {
+			gauges["esnr"] = otnStats(s.Instant, s.Avg, s.Min, s.Max)
+		}
+		for gaugeName, stats := range gauges {
+			for stat, value := range stats {
+				if value == nil {
+					if !f.config.UseGoDefaults {
+						continue
+					}
+					value = ygot.Float64(0)
+				}
+				metric := f.newTdChannelMetric(prometheus.GaugeValue)
+				metric.Index = fmt.Sprint(index)
+				metric.Description = channel.GetDescription()
+				metric.Metric = gaugeName
+				metric.Stat = stat
+				metric.Value = *value
+				out = append(out, metric)
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,258 @@
+package octermdev
+
+import (
+	"errors"
+	"fmt"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"strconv"
+	"strings"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/datamodels/ysoctd"
+	"github.com/automixer/gtexporter/pkg/plugins"
+)
+
+const yStructInitialSize = 64
+
+// pathMetadata represents metadata extracted from a path.
+// It contains information about the logical channel index, the OTN statistics container (if any)
+// and the leaf name.
+type pathMetadata struct {
+	index     uint32
+	hasIndex  bool
+	container string
+	leafName  string
+}
+
+// ocTdParser represents a parser for OpenConfig Terminal Device data.
+// It implements the plugins.Parser interface and includes a ygot structure for storing logical channels data.
+type ocTdParser struct {
+	plugins.ParserMon
+	yStruct *ysoctd.Root
+}
+
+// newParser creates a new ocTdParser and initializes its fields based on the given configuration.
+// It returns the newly created parser or an error if there was an issue during initialization.
+func newParser(cfg plugins.Config) (plugins.Parser, error) {
+	p := &ocTdParser{}
+	if err := p.ParserMon.Configure(cfg); err != nil {
+		return nil, err
+	}
+	p.ClearCache()
+	return p, nil
+}
+
+// CheckOut returns the yGot structure.
+func (p *ocTdParser) CheckOut() ygot.GoStruct {
+	if p.yStruct == nil {
+		panic(fmt.Sprint("yGot structure not initialized"))
+	}
+	return p.yStruct
+}
+
+// ClearCache resets the yGot structure, populates default values, and initializes the Channel map.
+func (p *ocTdParser) ClearCache() {
+	p.yStruct = &ysoctd.Root{}
+	p.yStruct.PopulateDefaults()
+	p.yStruct.TerminalDevice.Channel = make(map[uint32]*ysoctd.TerminalDevice_Channel, yStructInitialSize)
+}
+
+// getPathMeta returns the metadata of the given path by scanning its elements and extracting the necessary
+// information. The logical channel index is read from the channel list key.
+// If any of the metadata is missing or the path is invalid, an error is returned.
+func (p *ocTdParser) getPathMeta(pfx, path *gnmi.Path) (*pathMetadata, error) {
+	var elems []*gnmi.PathElem
+	out := &pathMetadata{}
+
+	// Build the full path as a slice of path elements
+	elems = append(elems, pfx.GetElem()...)
+	elems = append(elems, path.GetElem()...)
+	if len(elems) < 2 {
+		return nil, errors.New("path too short")
+	}
+
+	// Scan the path elements and extract metadata
+	for _, elem := range elems {
+		switch elem.GetName() {
+		case "channel":
+			index, err := strconv.ParseUint(elem.GetKey()["index"], 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			out.index = uint32(index)
+			out.hasIndex = true
+		case "pre-fec-ber", "q-value", "esnr":
+			out.container = elem.GetName()
+		}
+	}
+	out.leafName = elems[len(elems)-1].GetName()
+
+	// Final check
+	if !out.hasIndex || out.leafName == "" {
+		return nil, errors.New("invalid path metadata")
+	}
+	return out, nil
+}
+
+// ParseNotification analyzes a GNMI notification and calls the appropriate decoding method.
+func (p *ocTdParser) ParseNotification(nf *gnmi.Notification) {
+	if p.yStruct == nil {
+		panic(fmt.Sprint("yGot structure not initialized"))
+	}
+
+	// Process GNMI delete messages
+	for _, gDelete := range nf.Delete {
+		p.removeDbEntry(nf.Prefix, gDelete)
+	}
+
+	// Process GNMI update messages
+	for i, update := range nf.Update {
+		// An update without a value would overwrite the cached leaf with a zero value
+		if update.GetVal() == nil {
+			p.NilValue()
+			continue
+		}
+		updHandler := p.updHandlerLookup(nf.Prefix, update.Path)
+		if updHandler == nil {
+			continue
+		}
+		p.UpdateDuplicates(uint64(update.GetDuplicates()))
+		updHandler(nf, i)
+	}
+}
+
+// removeDbEntry removes the yGot GoStruct entry specified by the given prefix and path.
+// Only logical channel deletes are supported.
+func (p *ocTdParser) removeDbEntry(pfx, path *gnmi.Path) {
+	pathMeta, err := p.getPathMeta(pfx, path)
+	if err != nil {
+		p.InvalidPath()
+		return
+	}
+	if p.yStruct.GetTerminalDevice().GetChannel(pathMeta.index) == nil {
+		p.DeleteNotFound()
+		return
+	}
+	p.yStruct.GetTerminalDevice().DeleteChannel(pathMeta.index)
+}
+
+// updHandlerLookup returns the appropriate decoding handler based on the given prefix and path.
+func (p *ocTdParser) updHandlerLookup(pfx, path *gnmi.Path) func(*gnmi.Notification, int) {
+	sPfx, _ := ygot.PathToSchemaPath(pfx)
+	sPath, _ := ygot.PathToSchemaPath(path)
+	var fullPath string
+	if len(sPfx) > 1 {
+		fullPath += sPfx
+	}
+	fullPath += sPath
+	leafIndex := strings.LastIndex(fullPath, "/")
+	if leafIndex == -1 {
+		p.InvalidPath()
+		return nil
+	}
+
+	// Find the proper handler
+	switch fullPath[:leafIndex] {
+	case channelState:
+		return p.channelState
+	case otnState, otnState + "/pre-fec-ber", otnState + "/q-value", otnState + "/esnr":
+		return p.otnState
+	default:
+		p.ContainerNotFound()
+	}
+	return nil
+}
+
+// getChannel returns the logical channel with the given index, creating it if missing.
+func (p *ocTdParser) getChannel(index uint32) (*ysoctd.TerminalDevice_Channel, error) {
+	channel, ok := p.yStruct.GetTerminalDevice().Channel[index]
+	if !ok {
+		var err error
+		channel, err = p.yStruct.GetTerminalDevice().NewChannel(index)
+		if err != nil {
+			return nil, err
+		}
+		channel.PopulateDefaults()
+	}
+	return channel, nil
+}
+
+// channelState updates the yGot structure with the information from the GNMI update message for the
+// logical channel state.
+func (p *ocTdParser) channelState(nf *gnmi.Notification, updNum int) {
+	pathMeta, err := p.getPathMeta(nf.Prefix, nf.Update[updNum].Path)
+	if err != nil {
+		p.InvalidPath()
+		return
+	}
+	target, err := p.getChannel(pathMeta.index)
+	if err != nil {
+		return
+	}
+	// Load the gnmi update into yGot struct
+	source := nf.Update[updNum].Val
+	switch pathMeta.leafName {
+	case "index":
+		target.Index = ygot.Uint32(uint32(plugins.UintVal(source)))
+	case "description":
+		target.Description = ygot.String(plugins.StringVal(source))
+	default:
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
+	}
+}
+
+// otnState updates the yGot structure with the information from the GNMI update message for the
+// logical channel OTN state. BER, Q-value and ESNR statistics are decimal64 leaves.
+func (p *ocTdParser) otnState(nf *gnmi.Notification, updNum int) {
+	pathMeta, err := p.getPathMeta(nf.Prefix, nf.Update[updNum].Path)
+	if err != nil {
+		p.InvalidPath()
+		return
+	}
+	channel, err := p.getChannel(pathMeta.index)
+	if err != nil {
+		return
+	}
+	target := channel.GetOrCreateOtn()
+	// Load the gnmi update into yGot struct
+	source := nf.Update[updNum].Val
+	var found bool
+	switch pathMeta.container {
+	case "pre-fec-ber":
+		s := target.GetOrCreatePreFecBer()
+		found = setStat(pathMeta.leafName, plugins.FloatVal(source), &s.Instant, &s.Avg, &s.Min, &s.Max)
+	case "q-value":
+		s := target.GetOrCreateQValue()
+		found = setStat(pathMeta.leafName, plugins.FloatVal(source), &s.Instant, &s.Avg, &s.Min, &s.Max)
+	case "esnr":
+		s := target.GetOrCreateEsnr()
+		found = setStat(pathMeta.leafName, plugins.FloatVal(source), &s.Instant, &s.Avg, &s.Min, &s.Max)
+	default:
+		if pathMeta.leafName == "fec-uncorrectable-blocks" {
+			target.FecUncorrectableBlocks = ygot.Uint64(plugins.UintVal(source))
+			found = true
+		}
+	}
+	if !found {
+		p.LeafNotFound(nf.Prefix, nf.Update[updNum].Path)
+	}
+}
+
+// setStat stores value into the avg/min/max/instant statistic field selected by leaf.
+// It returns false if leaf is not a statistic.
+func setStat(leaf string, value float64, instant, avg, minimum, maximum **float64) bool {
+	switch leaf {
+	case "instant":
+		*instant = ygot.Float64(value)
+	case "avg":
+		*avg = ygot.Float64(value)
+	case "min":
+		*minimum = ygot.Float64(value)
+	case "max":
+		*maximum = ygot.Float64(value)
+	default:
+		return false
+	}
+	return true
+}
@@ -1,8 +1,12 @@
 package plugins
 
 import (
+	log "github.com/automixer/gtexporter/pkg/logger"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
 	"github.com/prometheus/client_golang/prometheus"
 	"reflect"
+	"strconv"
 	"sync"
 
 	// Local packages
@@ -16,23 +20,34 @@ import (
 //   - ContainerNotFound: Tracks the number of times the update's YANG container was not found.
 //   - LeafNotFound: Tracks the number of times the update's YANG leaf was not found.
 //   - InvalidPath: Tracks the number of times an invalid GNMI path was encountered.
+//   - UnknownEnum: Tracks the number of enum leaves whose value is not known by the yGot GoStruct.
+//   - NilValue: Tracks the number of GNMI updates received without a value.
 type pmCounters struct {
 	Duplicates        uint64 `label:"gnmi_update_duplicates"`
 	DeleteNotFound    uint64 `label:"delete_path_not_found"`
 	ContainerNotFound uint64 `label:"yang_container_not_found"`
 	LeafNotFound      uint64 `label:"yang_leaf_not_found"`
 	InvalidPath       uint64 `label:"invalid_gnmi_path"`
+	UnknownEnum       uint64 `label:"unknown_enum_values"`
+	NilValue          uint64 `label:"gnmi_update_nil_values"`
 }
 
 type ParserMon struct {
-	Cfg      Config
-	counters pmCounters
-	mutex    sync.Mutex
+	Cfg           Config
+	counters      pmCounters
+	mutex         sync.Mutex
+	logUnknown    bool            // Log unknown leaves the first time they are seen
+	unknownLeaves map[string]bool // Key: unknown leaf full path
 }
 
 // Configure takes a Config struct and assigns it to the Cfg field of the ParserMon struct.
 func (p *ParserMon) Configure(cfg Config) error {
 	p.Cfg = cfg
+	if p.Cfg.PlugId == "" {
+		p.Cfg.PlugId = p.Cfg.PlugName
+	}
+	p.logUnknown, _ = strconv.ParseBool(cfg.Options["log_unknown_leaves"])
+	p.unknownLeaves = make(map[string]bool)
 	return nil
 }
 
@@ -54,7 +69,7 @@ func (p *ParserMon) Collect() []exporter.GMetric {
 	out := make([]exporter.GMetric, 0, rType.NumField())
 	for i := 0; i < rType.NumField(); i++ {
 		metric := newParserMetric(prometheus.CounterValue, p.Cfg.DevName)
-		metric.PlugName = p.Cfg.PlugName
+		metric.PlugName = p.Cfg.PlugId
 		metric.Metric = rType.Field(i).Tag.Get("label")
 		metric.Value = float64(rValue.Field(i).Uint())
 		out = append(out, metric)
@@ -81,10 +96,35 @@ func (p *ParserMon) ContainerNotFound() {
 	p.counters.ContainerNotFound++
 }
 
-func (p *ParserMon) LeafNotFound() {
+// LeafNotFound counts an update whose YANG leaf is not handled by the parser.
+// If the log_unknown_leaves option is set, the leaf path is logged the first time it is seen.
+func (p *ParserMon) LeafNotFound(pfx, path *gnmi.Path) {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
 	p.counters.LeafNotFound++
+
+	if !p.logUnknown {
+		return
+	}
+	var fullPath string
+	if sPfx, err := ygot.PathToSchemaPath(pfx); err == nil && len(sPfx) > 1 {
+		fullPath += sPfx
+	}
+	sPath, _ := ygot.PathToSchemaPath(path)
+	fullPath += sPath
+	if p.unknownLeaves[fullPath] {
+		return
+	}
+	p.unknownLeaves[fullPath] = true
+	log.With("device", p.Cfg.DevName, "plugin", p.Cfg.PlugId).Infof("received an unknown leaf: %s", fullPath)
+}
+
+// NilValue counts an update received without a value. Such updates are skipped by the parsers,
+// so that the cached data is not overwritten with zero values.
+func (p *ParserMon) NilValue() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.counters.NilValue++
 }
 
 func (p *ParserMon) InvalidPath() {
@@ -92,3 +132,14 @@ func (p *ParserMon) InvalidPath() {
 	defer p.mutex.Unlock()
 	p.counters.InvalidPath++
 }
+
+// CheckEnum counts the enum leaves whose raw string is not empty but maps to the unset (0) value,
+// i.e.: values not known by the generated yGot code. It returns the given value unchanged.
+func (p *ParserMon) CheckEnum(value int64, raw string) int64 {
+	if value == 0 && raw != "" {
+		p.mutex.Lock()
+		defer p.mutex.Unlock()
+		p.counters.UnknownEnum++
+	}
+	return value
+}
@@ -2,9 +2,14 @@ package plugins
 
 import (
 	"fmt"
+	log "github.com/automixer/gtexporter/pkg/logger"
 	"github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/openconfig/ygot/ygot"
 	"github.com/prometheus/client_golang/prometheus"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -12,10 +17,17 @@ import (
 	"github.com/automixer/gtexporter/pkg/exporter"
 )
 
+// metricNameRx matches the legal Prometheus metric names.
+var metricNameRx = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+
 // FormatterPaths represents the paths to be subscribed by the client on behalf of the formatter needs.
+// XPaths must not be empty. If Datamodel is empty, the device capabilities are not checked for the formatter.
+// Modes optionally overrides the device subscription mode of some XPaths (e.g.: ON_CHANGE status leaves
+// alongside SAMPLE counters).
 type FormatterPaths struct {
 	XPaths    []string
 	Datamodel string
+	Modes     map[string]gnmi.SubscriptionMode // Key: xPath, as listed in XPaths
 }
 
 // Formatter is an interface that defines the methods required from a formatter object.
@@ -27,6 +39,21 @@ type Formatter interface {
 	ScrapeEvent(ys ygot.GoStruct) func()
 }
 
+// DerivedFormatter is an optional interface that a formatter can implement to contribute extra metrics,
+// computed from the yGot GoStruct content at collect time (e.g.: average frame size from octets and packets).
+// Derived metrics are collected after the standard formatter metrics.
+type DerivedFormatter interface {
+	DescribeDerived() []exporter.GMetric
+	CollectDerived(ys ygot.GoStruct) []exporter.GMetric
+}
+
+// StaleParser is an optional interface that a parser can implement to evict, in cache mode, the entries not
+// refreshed by the device within a time window (e.g.: devices that never send gNMI deletes).
+// ClearStale removes the entries not updated since the given time and returns their number.
+type StaleParser interface {
+	ClearStale(before time.Time) int
+}
+
 // Parser represents an interface that defines the methods required from a parser object.
 // A parser object is responsible for loading the received GNMI data into the chosen yGot GoStruct.
 type Parser interface {
@@ -39,13 +66,17 @@ type Parser interface {
 
 type Config struct {
 	DevName        string
-	PlugName       string
+	PlugName       string // Registered plugin name (e.g.: oc_interfaces)
+	PlugId         string // Plugin instance name (e.g.: oc_interfaces#uplinks). Defaults to PlugName
 	CustomLabel    string
 	DescSanitize   string
 	UseGoDefaults  bool
 	CacheData      bool
+	DisableSelfMon bool // Self-monitoring metrics are not registered nor collected
+	DisableDeletes bool // gNMI deletes are dropped before reaching the parser
 	ScrapeInterval time.Duration
 	Options        map[string]string
+	SeriesBudget   *SeriesBudget // max_series_per_device budget, shared by the device plugins. Nil means no limit
 }
 
 // Plugin represents a plugin that collects metrics using a formatter and parser.
@@ -57,11 +88,53 @@ type Plugin struct {
 	formatter      Formatter
 	parser         Parser
 	formatterInfos FormatterPaths
+	gnmiUpdates    uint64                          // gNMI updates routed to this plugin
+	gnmiDeletes    uint64                          // gNMI deletes routed to this plugin
+	gnmiBytes      uint64                          // gNMI updates routed to this plugin carrying BYTES values
+	seriesTotal    uint64                          // Series collected from the formatter since startup
+	lastSeries     int                             // Series collected from the formatter during the last collection
+	typeOverrides  map[string]prometheus.ValueType // Key: formatter metric name
+	bufPeak        int                             // Passthrough buffer high-water mark of the last scrape interval
+	bufParseTime   time.Duration                   // Passthrough buffer parse time of the last scrape
+	seriesLimited  bool                            // Series were dropped by the SeriesBudget during the last collection
+	deltaExp       bool                            // Experimental. Only changed series are sent
+	lastValues     map[string]float64              // Series values of the last collection. Key: series identity
+	deadbands      map[string]float64              // Key: metric name or metric label value. Value: threshold
+	rawLabels      bool                            // Metric label values keep the hyphens of the OpenConfig leaf names
+	staleTTL       time.Duration                   // Cache mode entries not refreshed within it are evicted. Zero disables
+	staleEvicted   uint64                          // Cache entries evicted by staleTTL
+	dbValues       map[string]float64              // Deadband gauges values last emitted. Key: series identity
 }
 
 func New(cfg Config) (*Plugin, error) {
+	if cfg.PlugId == "" {
+		cfg.PlugId = cfg.PlugName
+	}
+	// The mode option overrides the device data mode for this plugin
+	switch cfg.Options["mode"] {
+	case "":
+	case "cache":
+		cfg.CacheData = true
+	case "passthrough":
+		cfg.CacheData = false
+	default:
+		return nil, fmt.Errorf("%s: invalid mode: %s", cfg.PlugId, cfg.Options["mode"])
+	}
 	plug := &Plugin{config: cfg}
-	plug.buf = newBuf(cfg.ScrapeInterval)
+	coalesce, _ := strconv.ParseBool(cfg.Options["coalesce_updates"])
+	plug.buf = newBuf(cfg.ScrapeInterval, coalesce)
+	plug.deltaExp, _ = strconv.ParseBool(cfg.Options["delta_exposition"])
+	if plug.deltaExp {
+		log.With("device", cfg.DevName, "plugin", cfg.PlugId).Warning(
+			"delta_exposition is experimental. Unchanged series are not exported and go stale on Prometheus.")
+	}
+	switch cfg.Options["metric_label_style"] {
+	case "", "underscore":
+	case "raw":
+		plug.rawLabels = true
+	default:
+		return nil, fmt.Errorf("%s: invalid metric_label_style: %s", cfg.PlugId, cfg.Options["metric_label_style"])
+	}
 
 	// Load plugin formatter
 	if _, ok := formatters[cfg.PlugName]; !ok {
@@ -73,6 +146,10 @@ func New(cfg Config) (*Plugin, error) {
 	}
 	plug.formatter = formatter
 	plug.formatterInfos = plug.formatter.GetPaths()
+	if len(plug.formatterInfos.XPaths) == 0 {
+		// An empty Datamodel is allowed: it skips the device capabilities check
+		return nil, fmt.Errorf("formatter %s has no paths to subscribe", cfg.PlugId)
+	}
 
 	// Load plugin parser
 	if _, ok := parsers[cfg.PlugName]; !ok {
@@ -84,21 +161,166 @@ func New(cfg Config) (*Plugin, error) {
 	}
 	plug.parser = parser
 
+	// Stale cache entries eviction
+	if opt := cfg.Options["stale_entry_ttl"]; opt != "" {
+		ttl, err := time.ParseDuration(opt)
+		if err != nil || ttl <= 0 {
+			return nil, fmt.Errorf("%s: invalid stale_entry_ttl: %s", cfg.PlugId, opt)
+		}
+		if _, ok := parser.(StaleParser); !ok {
+			return nil, fmt.Errorf("%s: stale_entry_ttl is not supported by this plugin", cfg.PlugId)
+		}
+		if !cfg.CacheData {
+			log.With("device", cfg.DevName, "plugin", cfg.PlugId).Warning(
+				"stale_entry_ttl only applies to cache mode. Ignored.")
+		}
+		plug.staleTTL = ttl
+	}
+
+	// Metric type overrides
+	plug.typeOverrides, err = parseTypeOverrides(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Gauges deadband
+	plug.deadbands, err = parseDeadbands(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Prepare descriptors for registration
-	desc := formatter.Describe()                                                        // User metrics from formatter
-	desc = append(desc, newFormatterMetric(prometheus.GaugeValue, plug.config.DevName)) // Formatter self-monitoring
-	desc = append(desc, parser.Describe()...)                                           // Parser self monitoring
+	desc := formatter.Describe() // User metrics from formatter
+	if df, ok := formatter.(DerivedFormatter); ok {
+		// Derived metrics from formatter
+		desc = append(desc, df.DescribeDerived()...)
+	}
+	for i := range desc {
+		desc[i] = exporter.OverrideType(desc[i], plug.typeOverrides)
+	}
 
 	// Register plugin to exporter
 	if err := exporter.Registry(plug, desc); err != nil {
 		return nil, err
 	}
+
+	// Register plugin self-monitoring to exporter
+	if !cfg.DisableSelfMon {
+		smDesc := []exporter.GMetric{newFormatterMetric(prometheus.GaugeValue, plug.config.DevName)} // Formatter
+		smDesc = append(smDesc, parser.Describe()...)                                                // Parser
+		smDesc = append(smDesc, newPluginMetric(prometheus.CounterValue, plug.config.DevName))       // Plugin
+		if err := exporter.GroupRegistry(exporter.SelfMonGroup, &pluginMon{plug: plug}, smDesc); err != nil {
+			return nil, err
+		}
+	}
 	return plug, nil
 }
 
-// GetPlugName retrieves the name of the plugin from its configuration.
+// MetricName returns the base name of the main formatter metric: the metric_name_override plugin option if set,
+// otherwise the given default name. The override must be a legal Prometheus metric name.
+func MetricName(cfg Config, name string) (string, error) {
+	override := cfg.Options["metric_name_override"]
+	if override == "" {
+		return name, nil
+	}
+	if !metricNameRx.MatchString(override) {
+		return "", fmt.Errorf("%s: invalid metric_name_override: %s", cfg.PlugId, override)
+	}
+	return override, nil
+}
+
+// parseTypeOverrides parses the type_override plugin option.
+// The option format is: "<metric_name>:<counter|gauge|untyped>,...", where metric_name is the
+// formatter metric name, without prefix and suffix (e.g.: oc_if).
+func parseTypeOverrides(cfg Config) (map[string]prometheus.ValueType, error) {
+	opt := strings.ReplaceAll(cfg.Options["type_override"], " ", "")
+	if opt == "" {
+		return nil, nil
+	}
+	out := make(map[string]prometheus.ValueType)
+	for _, item := range strings.Split(opt, ",") {
+		name, mType, found := strings.Cut(item, ":")
+		if !found || name == "" {
+			return nil, fmt.Errorf("%s: invalid type_override item: %s", cfg.PlugId, item)
+		}
+		switch mType {
+		case "counter":
+			out[name] = prometheus.CounterValue
+			log.With("device", cfg.DevName, "plugin", cfg.PlugId).Warningf(
+				"metric %s is exported as a counter. Make sure its values are monotonic.", name)
+		case "gauge":
+			out[name] = prometheus.GaugeValue
+		case "untyped":
+			out[name] = prometheus.UntypedValue
+		default:
+			return nil, fmt.Errorf("%s: invalid type_override type: %s", cfg.PlugId, mType)
+		}
+	}
+	return out, nil
+}
+
+// parseDeadbands parses the deadband plugin option.
+// The option format is: "<name>:<threshold>,...", where name is either a formatter metric name, without prefix and
+// suffix (e.g.: oc_td_channel), or the value of the metric label of a series (e.g.: input_power_instant).
+func parseDeadbands(cfg Config) (map[string]float64, error) {
+	opt := strings.ReplaceAll(cfg.Options["deadband"], " ", "")
+	if opt == "" {
+		return nil, nil
+	}
+	out := make(map[string]float64)
+	for _, item := range strings.Split(opt, ",") {
+		name, threshold, found := strings.Cut(item, ":")
+		if !found || name == "" {
+			return nil, fmt.Errorf("%s: invalid deadband item: %s", cfg.PlugId, item)
+		}
+		value, err := strconv.ParseFloat(threshold, 64)
+		if err != nil || value <= 0 {
+			return nil, fmt.Errorf("%s: invalid deadband threshold: %s", cfg.PlugId, threshold)
+		}
+		out[name] = value
+	}
+	return out, nil
+}
+
+// applyDeadband returns the given gauge with its last emitted value if the new one differs by no more than the
+// configured threshold. The value actually emitted is recorded into seen. Other metrics are returned unchanged.
+func (p *Plugin) applyDeadband(m exporter.GMetric, seen map[string]float64) exporter.GMetric {
+	mc := exporter.Commons(m)
+	if mc.Type != prometheus.GaugeValue || mc.Info {
+		return m
+	}
+	// The metric label is more specific than the metric name
+	threshold, ok := p.deadbands[exporter.LabelValue(m, "metric")]
+	if !ok {
+		if threshold, ok = p.deadbands[mc.Name]; !ok {
+			return m
+		}
+	}
+	key, value, comparable := exporter.SeriesSample(m)
+	if !comparable {
+		return m
+	}
+	if prev, found := p.dbValues[key]; found && math.Abs(value-prev) <= threshold {
+		seen[key] = prev
+		return exporter.OverrideValue(m, prev)
+	}
+	seen[key] = value
+	return m
+}
+
+// normalizeMetricLabel returns the given metric with the hyphens of its metric label value replaced by
+// underscores (e.g.: in-octets -> in_octets). Metrics without hyphens are returned unchanged.
+func normalizeMetricLabel(m exporter.GMetric) exporter.GMetric {
+	value := exporter.LabelValue(m, "metric")
+	if !strings.Contains(value, "-") {
+		return m
+	}
+	return exporter.OverrideLabel(m, "metric", strings.ReplaceAll(value, "-", "_"))
+}
+
+// GetPlugName retrieves the instance name of the plugin from its configuration.
 func (p *Plugin) GetPlugName() string {
-	return p.config.PlugName
+	return p.config.PlugId
 }
 
 // GetPathsToSubscribe retrieves the list of XPaths that the plugin should subscribe to for data collection.
@@ -106,11 +328,29 @@ func (p *Plugin) GetPathsToSubscribe() []string {
 	return p.formatterInfos.XPaths
 }
 
+// GetPathMode returns the subscription mode hint of the given xPath, if the formatter requires one.
+func (p *Plugin) GetPathMode(xPath string) (gnmi.SubscriptionMode, bool) {
+	mode, ok := p.formatterInfos.Modes[xPath]
+	return mode, ok
+}
+
+// IsCacheMode reports whether the plugin keeps the gNMI notifications data over time (cache mode).
+// Passthrough plugins are subscribed with the gNMI updates_only flag.
+func (p *Plugin) IsCacheMode() bool {
+	return p.config.CacheData
+}
+
 // GetDataModel returns the list of data models associated with the plugin's formatter xPaths.
 func (p *Plugin) GetDataModel() string {
 	return p.formatterInfos.Datamodel
 }
 
+// GetEncoding returns the gNMI encoding configured for the plugin subscriptions.
+// An empty string means the device encoding is used.
+func (p *Plugin) GetEncoding() string {
+	return p.config.Options["gnmi_encoding"]
+}
+
 // GetMetrics implements the exporter GMetricSource interface
 // It is called by the exporter, and it sends the output of the formatter object.
 func (p *Plugin) GetMetrics(ch chan<- exporter.GMetric) {
@@ -119,10 +359,18 @@ func (p *Plugin) GetMetrics(ch chan<- exporter.GMetric) {
 
 	// If passthrough mode, send nf buffer to parser
 	if !p.config.CacheData {
+		p.bufPeak = p.buf.peakLen()
 		buf := p.buf.checkout()
+		start := time.Now()
 		for _, nf := range buf {
 			p.parser.ParseNotification(nf)
 		}
+		p.bufParseTime = time.Since(start)
+	}
+
+	// In cache mode, evict the entries not refreshed within stale_entry_ttl
+	if p.config.CacheData && p.staleTTL > 0 {
+		p.staleEvicted += uint64(p.parser.(StaleParser).ClearStale(time.Now().Add(-p.staleTTL)))
 	}
 
 	// Check out the yGot GoStruct and send it to the formatter
@@ -130,28 +378,144 @@ func (p *Plugin) GetMetrics(ch chan<- exporter.GMetric) {
 	endScrape := p.formatter.ScrapeEvent(ys)
 	defer endScrape()
 
-	// Gather metrics from formatter
+	// Gather metrics from formatter. Once the device max_series_per_device is reached, further series are dropped.
+	// In delta exposition mode, series unchanged since the last collection are skipped
+	budget := p.config.SeriesBudget
+	if budget != nil {
+		budget.begin(p)
+	}
 	mCounter := 0
-	for _, m := range p.formatter.Collect() {
-		mCounter++
-		ch <- m
+	limited := false
+	var seen map[string]float64
+	if p.deltaExp {
+		seen = make(map[string]float64, len(p.lastValues))
+	}
+	var dbSeen map[string]float64
+	if p.deadbands != nil {
+		dbSeen = make(map[string]float64, len(p.dbValues))
+	}
+	send := func(metrics []exporter.GMetric) {
+		for _, m := range metrics {
+			m = exporter.OverrideType(m, p.typeOverrides)
+			if !p.rawLabels {
+				m = normalizeMetricLabel(m)
+			}
+			if p.deadbands != nil {
+				m = p.applyDeadband(m, dbSeen)
+			}
+			key, value, comparable := "", 0.0, false
+			if p.deltaExp {
+				key, value, comparable = exporter.SeriesSample(m)
+				if prev, ok := p.lastValues[key]; comparable && ok && prev == value {
+					seen[key] = value
+					continue
+				}
+			}
+			if budget != nil && !budget.take() {
+				limited = true
+				return
+			}
+			mCounter++
+			ch <- m
+			if comparable {
+				seen[key] = value
+			}
+		}
+	}
+	send(p.formatter.Collect())
+	if df, ok := p.formatter.(DerivedFormatter); ok && !limited {
+		send(df.CollectDerived(ys))
+	}
+	if limited && !p.seriesLimited {
+		log.With("device", p.config.DevName, "plugin", p.config.PlugId).Warningf(
+			"max_series_per_device (%d) exceeded. Further series are dropped. Please check the plugin filters.",
+			budget.limit)
+	}
+	p.seriesLimited = limited
+	if p.deltaExp {
+		p.lastValues = seen
+	}
+	if p.deadbands != nil {
+		p.dbValues = dbSeen
+	}
+
+	p.seriesTotal += uint64(mCounter)
+	p.lastSeries = mCounter
+
+	// If passthrough mode, clear parser yGot GoStruct
+	if !p.config.CacheData {
+		p.parser.ClearCache()
 	}
+}
 
+// pluginMon is the metric source of the plugin self-monitoring metrics.
+// It is registered into the exporter self-monitoring group, so it can be served on its own path.
+type pluginMon struct {
+	plug *Plugin
+}
+
+// GetMetrics implements the exporter GMetricSource interface
+// Formatter values refer to the last completed collection of the plugin.
+func (m *pluginMon) GetMetrics(ch chan<- exporter.GMetric) {
+	m.plug.mutex.Lock()
+	defer m.plug.mutex.Unlock()
+	m.plug.sendSelfMon(ch)
+}
+
+// sendSelfMon sends the formatter, parser and plugin self-monitoring metrics.
+func (p *Plugin) sendSelfMon(ch chan<- exporter.GMetric) {
 	// Send formatter self monitoring data
 	fMon := newFormatterMetric(prometheus.GaugeValue, p.config.DevName)
 	fMon.Metric = "collected_series"
-	fMon.Value = float64(mCounter)
-	fMon.PlugName = p.config.PlugName
+	fMon.Value = float64(p.lastSeries)
+	fMon.PlugName = p.config.PlugId
 	ch <- fMon
+	if !p.config.CacheData {
+		fMon.Metric = "buffer_peak_notifications"
+		fMon.Value = float64(p.bufPeak)
+		ch <- fMon
+		fMon.Metric = "buffer_parse_seconds"
+		fMon.Value = p.bufParseTime.Seconds()
+		ch <- fMon
+	}
+	if p.config.SeriesBudget != nil {
+		fMon.Metric = "series_limit_exceeded"
+		fMon.Value = 0
+		if p.seriesLimited {
+			fMon.Value = 1
+		}
+		ch <- fMon
+	}
 
 	// Gather self-monitoring from parser
 	for _, m := range p.parser.Collect() {
 		ch <- m
 	}
 
-	// If passthrough mode, clear parser yGot GoStruct
-	if !p.config.CacheData {
-		p.parser.ClearCache()
+	// Send plugin self monitoring data
+	pMon := newPluginMetric(prometheus.CounterValue, p.config.DevName)
+	pMon.PlugName = p.config.PlugId
+	pMon.Metric = "gnmi_updates"
+	pMon.Value = float64(p.gnmiUpdates)
+	ch <- pMon
+	pMon.Metric = "gnmi_deletes"
+	pMon.Value = float64(p.gnmiDeletes)
+	ch <- pMon
+	pMon.Metric = "gnmi_bytes_values"
+	pMon.Value = float64(p.gnmiBytes)
+	ch <- pMon
+	pMon.Metric = "series_collected"
+	pMon.Value = float64(p.seriesTotal)
+	ch <- pMon
+	if p.staleTTL > 0 {
+		pMon.Metric = "stale_entries_evicted"
+		pMon.Value = float64(p.staleEvicted)
+		ch <- pMon
+	}
+	if p.buf.coalesce {
+		pMon.Metric = "gnmi_updates_coalesced"
+		pMon.Value = float64(p.buf.coalescedCount())
+		ch <- pMon
 	}
 }
 
@@ -165,6 +529,7 @@ func (p *Plugin) OnSync(status bool) {
 	if p.onSync && !status {
 		p.parser.ClearCache()
 		p.buf.clearBuffer()
+		p.lastValues = nil // A full set of series is sent after resync
 	}
 	p.onSync = status
 }
@@ -176,6 +541,23 @@ func (p *Plugin) Notification(nf *gnmi.Notification) {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
 
+	p.gnmiUpdates += uint64(len(nf.GetUpdate()))
+	p.gnmiDeletes += uint64(len(nf.GetDelete()))
+	p.gnmiBytes += countBytesVal(nf)
+
+	if p.config.DisableDeletes && len(nf.GetDelete()) > 0 {
+		if len(nf.GetUpdate()) == 0 {
+			return
+		}
+		// The notification is shared with other plugins: deletes are dropped from a copy
+		nf = &gnmi.Notification{
+			Timestamp: nf.GetTimestamp(),
+			Prefix:    nf.GetPrefix(),
+			Update:    nf.GetUpdate(),
+			Atomic:    nf.GetAtomic(),
+		}
+	}
+
 	if p.config.CacheData {
 		// Cache mode
 		p.parser.ParseNotification(nf)
@@ -0,0 +1,166 @@
+package plugins
+
+import (
+	"github.com/automixer/gtexporter/pkg/exporter"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/prometheus/client_golang/prometheus"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const testPlugName = "test_plugin"
+
+// testMetric is the metric of the test formatter.
+type testMetric struct {
+	exporter.MetricCommons
+	Metric string `label:"metric"`
+	IfName string `label:"name"`
+}
+
+func newTestMetric(metric string) testMetric {
+	m := testMetric{Metric: metric, IfName: "eth-0"}
+	m.Name = "test"
+	m.Device = "dev1"
+	m.Type = prometheus.CounterValue
+	return m
+}
+
+// testFormatter emits a metric for each of its metric label values.
+type testFormatter struct {
+	metrics []string
+}
+
+func (f *testFormatter) Describe() []exporter.GMetric { return []exporter.GMetric{newTestMetric("")} }
+func (f *testFormatter) GetPaths() FormatterPaths {
+	return FormatterPaths{XPaths: []string{"/interfaces/interface/state"}}
+}
+func (f *testFormatter) ScrapeEvent(ygot.GoStruct) func() { return func() {} }
+
+func (f *testFormatter) Collect() []exporter.GMetric {
+	out := make([]exporter.GMetric, 0, len(f.metrics))
+	for _, metric := range f.metrics {
+		out = append(out, newTestMetric(metric))
+	}
+	return out
+}
+
+// testParser is a parser doing nothing.
+type testParser struct{}
+
+func (p *testParser) Describe() []exporter.GMetric         { return nil }
+func (p *testParser) Collect() []exporter.GMetric          { return nil }
+func (p *testParser) CheckOut() ygot.GoStruct              { return nil }
+func (p *testParser) ParseNotification(*gnmi.Notification) {}
+func (p *testParser) ClearCache()                          {}
+
+// newTestPlugin returns a plugin instance whose formatter emits the given metric label values.
+func newTestPlugin(t *testing.T, options map[string]string, metrics ...string) *Plugin {
+	t.Helper()
+	exporter.Registry = func(exporter.GMetricSource, []exporter.GMetric) error { return nil }
+	formatters[testPlugName] = func(Config) (Formatter, error) { return &testFormatter{metrics: metrics}, nil }
+	parsers[testPlugName] = func(Config) (Parser, error) { return &testParser{}, nil }
+	t.Cleanup(func() {
+		delete(formatters, testPlugName)
+		delete(parsers, testPlugName)
+	})
+	plug, err := New(Config{DevName: "dev1", PlugName: testPlugName, Options: options, DisableSelfMon: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return plug
+}
+
+// collectLabel returns the values of the given label of the metrics collected by the plugin.
+func collectLabel(plug *Plugin, key string) []string {
+	ch := make(chan exporter.GMetric, 16)
+	plug.GetMetrics(ch)
+	close(ch)
+	var out []string
+	for m := range ch {
+		out = append(out, exporter.LabelValue(m, key))
+	}
+	return out
+}
+
+func TestMetricLabelStyle(t *testing.T) {
+	metrics := []string{"in-octets", "carrier-transitions", "last_change", "in-fcs-errors", "speed"}
+	tests := []struct {
+		name  string
+		style string
+		want  []string
+	}{
+		{
+			name:  "default",
+			style: "",
+			want:  []string{"in_octets", "carrier_transitions", "last_change", "in_fcs_errors", "speed"},
+		},
+		{
+			name:  "underscore",
+			style: "underscore",
+			want:  []string{"in_octets", "carrier_transitions", "last_change", "in_fcs_errors", "speed"},
+		},
+		{
+			name:  "raw",
+			style: "raw",
+			want:  []string{"in-octets", "carrier-transitions", "last_change", "in-fcs-errors", "speed"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plug := newTestPlugin(t, map[string]string{"metric_label_style": tt.style}, metrics...)
+			if got := collectLabel(plug, "metric"); !slices.Equal(got, tt.want) {
+				t.Errorf("metric labels %q, want %q", got, tt.want)
+			}
+			// Only the metric label is normalized
+			for _, name := range collectLabel(plug, "name") {
+				if name != "eth-0" {
+					t.Errorf("name label %q, want eth-0", name)
+				}
+			}
+		})
+	}
+}
+
+func TestMetricLabelStyleInvalid(t *testing.T) {
+	exporter.Registry = func(exporter.GMetricSource, []exporter.GMetric) error { return nil }
+	_, err := New(Config{DevName: "dev1", PlugName: testPlugName, Options: map[string]string{"metric_label_style": "dash"}})
+	if err == nil || !strings.Contains(err.Error(), "metric_label_style") {
+		t.Errorf("invalid metric_label_style: got error %v", err)
+	}
+}
+
+func TestSeriesBudget(t *testing.T) {
+	budget := NewSeriesBudget(5)
+	plugA := newTestPlugin(t, nil, "in-octets", "out-octets", "in-pkts")
+	plugB := newTestPlugin(t, nil, "in-errors", "out-errors", "in-discards")
+	plugA.config.SeriesBudget = budget
+	plugB.config.SeriesBudget = budget
+
+	// The budget is refilled on each collection
+	for run := 0; run < 3; run++ {
+		if got := collectLabel(plugA, "metric"); len(got) != 3 {
+			t.Errorf("run %d: plugin A sent %d series, want 3", run, len(got))
+		}
+		if got := collectLabel(plugB, "metric"); !slices.Equal(got, []string{"in_errors", "out_errors"}) {
+			t.Errorf("run %d: plugin B sent %q, want the first 2 series", run, got)
+		}
+		if plugA.seriesLimited || !plugB.seriesLimited {
+			t.Errorf("run %d: series_limit_exceeded is %v for A and %v for B, want false and true",
+				run, plugA.seriesLimited, plugB.seriesLimited)
+		}
+	}
+
+	// Without a budget, all the series are sent
+	plugC := newTestPlugin(t, nil, "in-octets", "out-octets", "in-pkts", "out-pkts", "in-errors", "out-errors")
+	if got := collectLabel(plugC, "metric"); len(got) != 6 {
+		t.Errorf("plugin without budget sent %d series, want 6", len(got))
+	}
+}
+
+func TestNewSeriesBudgetNoLimit(t *testing.T) {
+	if b := NewSeriesBudget(0); b != nil {
+		t.Errorf("zero limit: got budget %+v, want nil", b)
+	}
+}
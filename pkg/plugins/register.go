@@ -1,6 +1,14 @@
 package plugins
 
-import "fmt"
+import (
+	"fmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"maps"
+	"slices"
+
+	// Local packages
+	"github.com/automixer/gtexporter/pkg/exporter"
+)
 
 var (
 	formatters = map[string]InitFormatter{}
@@ -15,6 +23,53 @@ type InitFormatter func(cfg Config) (Formatter, error)
 // a `Parser` and an error. It is used to initialize and register parsers.
 type InitParser func(cfg Config) (Parser, error)
 
+// LabelNames returns the label keys of the metrics of all the registered plugins, self-monitoring included.
+// Formatters are instantiated with an empty configuration for this purpose.
+func LabelNames() map[string]bool {
+	metrics := []exporter.GMetric{
+		newFormatterMetric(prometheus.GaugeValue, ""),
+		newParserMetric(prometheus.CounterValue, ""),
+		newPluginMetric(prometheus.CounterValue, ""),
+	}
+	for name, newFormatter := range formatters {
+		f, err := newFormatter(Config{PlugName: name, PlugId: name, Options: map[string]string{}})
+		if err != nil {
+			continue
+		}
+		metrics = append(metrics, f.Describe()...)
+		if df, ok := f.(DerivedFormatter); ok {
+			metrics = append(metrics, df.DescribeDerived()...)
+		}
+	}
+	out := make(map[string]bool)
+	for _, m := range metrics {
+		for _, k := range exporter.LabelKeys(m) {
+			out[k] = true
+		}
+	}
+	return out
+}
+
+// RegisteredPlugins returns the names of the registered plugins, sorted.
+func RegisteredPlugins() []string {
+	return slices.Sorted(maps.Keys(formatters))
+}
+
+// DataModels returns the data model declared by each registered plugin. Key: plugin name.
+// Formatters are instantiated with an empty configuration for this purpose.
+func DataModels() map[string]string {
+	out := make(map[string]string, len(formatters))
+	for name, newFormatter := range formatters {
+		f, err := newFormatter(Config{PlugName: name, PlugId: name, Options: map[string]string{}})
+		if err != nil {
+			out[name] = ""
+			continue
+		}
+		out[name] = f.GetPaths().Datamodel
+	}
+	return out
+}
+
 // Register registers a formatter and parser with the given plugin name.
 // It returns an error if a formatter or parser with the same name has already been registered.
 func Register(name string, f InitFormatter, p InitParser) error {
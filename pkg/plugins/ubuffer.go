@@ -2,7 +2,9 @@ package plugins
 
 import (
 	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
 	"sort"
+	"strings"
 	"time"
 )
 
@@ -10,19 +12,30 @@ import (
 const (
 	bufInitialCap         = 2048
 	scrapeDelayMultiplier = 2
+	coalesceKeySep        = "\x00" // Separates the parts of the coalesce index keys
 )
 
 // uBuffer represents a buffer for storing gNMI notifications.
+// In coalesce mode, updates are stored one per notification and indexed by path: a newer update of an
+// already buffered path replaces the older one (latest wins). Deletes are always kept.
 type uBuffer struct {
 	buf       []*gnmi.Notification
 	scrapeInt time.Duration
 	deadline  time.Time
 	noScrape  bool
+	peak      int            // Buffer length high-water mark since the last checkout
+	coalesce  bool           // Keep the latest update per path only
+	index     map[string]int // Coalesce mode. Key: target, origin, prefix and path. Value: buf position
+	coalesced uint64         // Updates replaced by a newer one of the same path, since startup
 }
 
-func newBuf(scrapeInt time.Duration) *uBuffer {
+func newBuf(scrapeInt time.Duration, coalesce bool) *uBuffer {
 	buf := uBuffer{
-		buf: make([]*gnmi.Notification, 0, bufInitialCap),
+		buf:      make([]*gnmi.Notification, 0, bufInitialCap),
+		coalesce: coalesce,
+	}
+	if coalesce {
+		buf.index = make(map[string]int, bufInitialCap)
 	}
 	buf.scrapeInt = scrapeInt
 	buf.deadline = time.Now().Add(scrapeInt * scrapeDelayMultiplier)
@@ -40,21 +53,77 @@ func (b *uBuffer) add(nf *gnmi.Notification) {
 		b.clearBuffer()
 		return
 	}
-	b.buf = append(b.buf, nf)
+	if b.coalesce {
+		b.addCoalesced(nf)
+	} else {
+		b.buf = append(b.buf, nf)
+	}
+	b.peak = max(b.peak, len(b.buf))
+}
+
+// addCoalesced splits the given notification into one notification per update, replacing the buffered
+// updates of the same path. Deletes are kept into a notification of their own.
+func (b *uBuffer) addCoalesced(nf *gnmi.Notification) {
+	if len(nf.GetDelete()) > 0 {
+		b.buf = append(b.buf, &gnmi.Notification{
+			Timestamp: nf.GetTimestamp(),
+			Prefix:    nf.GetPrefix(),
+			Delete:    nf.GetDelete(),
+			Atomic:    nf.GetAtomic(),
+		})
+	}
+	pfx, _ := ygot.PathToString(nf.GetPrefix())
+	for _, upd := range nf.GetUpdate() {
+		path, err := ygot.PathToString(upd.GetPath())
+		single := &gnmi.Notification{
+			Timestamp: nf.GetTimestamp(),
+			Prefix:    nf.GetPrefix(),
+			Update:    []*gnmi.Update{upd},
+			Atomic:    nf.GetAtomic(),
+		}
+		if err != nil {
+			// Not indexable. Let the parser deal with it
+			b.buf = append(b.buf, single)
+			continue
+		}
+		// The parts are delimited: element names can contain "/", which ygot.PathToString does not escape
+		key := strings.Join([]string{nf.GetPrefix().GetTarget(), nf.GetPrefix().GetOrigin(), pfx, path}, coalesceKeySep)
+		if i, ok := b.index[key]; ok {
+			b.buf[i] = single
+			b.coalesced++
+			continue
+		}
+		b.index[key] = len(b.buf)
+		b.buf = append(b.buf, single)
+	}
 }
 
 // checkout returns the buffered notifications.
 func (b *uBuffer) checkout() []*gnmi.Notification {
 	out := b.buf
 	b.clearBuffer()
-	// Sort updates by timestamp (ascending)
-	sort.Slice(out, func(i, j int) bool { return out[i].Timestamp < out[j].Timestamp })
+	b.peak = 0
+	// Sort updates by timestamp (ascending). Notifications with the same timestamp keep the arrival order
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Timestamp < out[j].Timestamp })
 	b.noScrape = false
 	b.deadline = time.Now().Add(b.scrapeInt * scrapeDelayMultiplier)
 	return out
 }
 
+// peakLen returns the buffer length high-water mark since the last checkout.
+func (b *uBuffer) peakLen() int {
+	return b.peak
+}
+
+// coalescedCount returns the number of updates replaced by a newer one of the same path, since startup.
+func (b *uBuffer) coalescedCount() uint64 {
+	return b.coalesced
+}
+
 // clearBuffer empties the buffer by creating a new empty slice with the initial capacity.
 func (b *uBuffer) clearBuffer() {
 	b.buf = make([]*gnmi.Notification, 0, bufInitialCap)
+	if b.coalesce {
+		b.index = make(map[string]int, bufInitialCap)
+	}
 }
@@ -0,0 +1,147 @@
+package plugins
+
+import (
+	"fmt"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	"slices"
+	"testing"
+	"time"
+)
+
+// mustPath converts an xPath into a gNMI path.
+func mustPath(t *testing.T, xPath string) *gnmi.Path {
+	t.Helper()
+	p, err := ygot.StringToStructuredPath(xPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// updNf returns a notification updating the given leaf, under the counters of interface eth0.
+func updNf(t *testing.T, ts int64, leaf string, value uint64) *gnmi.Notification {
+	t.Helper()
+	return &gnmi.Notification{
+		Timestamp: ts,
+		Prefix:    mustPath(t, "/interfaces/interface[name=eth0]/state/counters"),
+		Update: []*gnmi.Update{{
+			Path: mustPath(t, "/"+leaf),
+			Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: value}},
+		}},
+	}
+}
+
+// delNf returns a notification deleting the given leaf, under the counters of interface eth0.
+func delNf(t *testing.T, ts int64, leaf string) *gnmi.Notification {
+	t.Helper()
+	return &gnmi.Notification{
+		Timestamp: ts,
+		Prefix:    mustPath(t, "/interfaces/interface[name=eth0]/state/counters"),
+		Delete:    []*gnmi.Path{mustPath(t, "/"+leaf)},
+	}
+}
+
+// describeNfs returns a "<timestamp>:<leaf>=<value>" or "<timestamp>:-<leaf>" item per buffered update or delete.
+func describeNfs(nfs []*gnmi.Notification) []string {
+	var out []string
+	for _, nf := range nfs {
+		for _, del := range nf.GetDelete() {
+			path, _ := ygot.PathToString(del)
+			out = append(out, fmt.Sprintf("%d:-%s", nf.GetTimestamp(), path[1:]))
+		}
+		for _, upd := range nf.GetUpdate() {
+			path, _ := ygot.PathToString(upd.GetPath())
+			out = append(out, fmt.Sprintf("%d:%s=%d", nf.GetTimestamp(), path[1:], upd.GetVal().GetUintVal()))
+		}
+	}
+	return out
+}
+
+func TestBufCoalesce(t *testing.T) {
+	tests := []struct {
+		name          string
+		nfs           func(t *testing.T) []*gnmi.Notification
+		want          []string
+		wantCoalesced uint64
+	}{
+		{
+			name: "replaced update",
+			nfs: func(t *testing.T) []*gnmi.Notification {
+				return []*gnmi.Notification{updNf(t, 1, "in-octets", 100), updNf(t, 2, "in-octets", 200)}
+			},
+			want:          []string{"2:in-octets=200"},
+			wantCoalesced: 1,
+		},
+		{
+			name: "update then delete",
+			nfs: func(t *testing.T) []*gnmi.Notification {
+				return []*gnmi.Notification{updNf(t, 1, "in-octets", 100), delNf(t, 2, "in-octets")}
+			},
+			want: []string{"1:in-octets=100", "2:-in-octets"},
+		},
+		{
+			name: "update, delete and update again",
+			nfs: func(t *testing.T) []*gnmi.Notification {
+				return []*gnmi.Notification{
+					updNf(t, 1, "in-octets", 100), delNf(t, 2, "in-octets"), updNf(t, 3, "in-octets", 300)}
+			},
+			want:          []string{"2:-in-octets", "3:in-octets=300"},
+			wantCoalesced: 1,
+		},
+		{
+			name: "distinct leaves",
+			nfs: func(t *testing.T) []*gnmi.Notification {
+				return []*gnmi.Notification{
+					updNf(t, 3, "in-octets", 300),
+					updNf(t, 1, "out-octets", 100),
+					updNf(t, 2, "in-pkts", 200),
+					updNf(t, 4, "out-octets", 400),
+				}
+			},
+			want:          []string{"2:in-pkts=200", "3:in-octets=300", "4:out-octets=400"},
+			wantCoalesced: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := newBuf(time.Minute, true)
+			for _, nf := range tt.nfs(t) {
+				buf.add(nf)
+			}
+			if got := describeNfs(buf.checkout()); !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			if buf.coalescedCount() != tt.wantCoalesced {
+				t.Errorf("got %d coalesced updates, want %d", buf.coalescedCount(), tt.wantCoalesced)
+			}
+		})
+	}
+}
+
+func TestBufCoalesceKeyParts(t *testing.T) {
+	elems := func(names ...string) *gnmi.Path {
+		p := &gnmi.Path{}
+		for _, name := range names {
+			p.Elem = append(p.Elem, &gnmi.PathElem{Name: name})
+		}
+		return p
+	}
+	upd := func(pfx, path *gnmi.Path) *gnmi.Notification {
+		return &gnmi.Notification{Timestamp: 1, Prefix: pfx, Update: []*gnmi.Update{{
+			Path: path,
+			Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 1}},
+		}}}
+	}
+	// Different leaves, whose prefix and path strings concatenate to the same "/a/b/c"
+	buf := newBuf(time.Minute, true)
+	buf.add(upd(elems("a"), elems("b/c")))
+	buf.add(upd(elems("a/b"), elems("c")))
+	buf.add(upd(&gnmi.Path{Target: "t1", Elem: elems("a").Elem}, elems("b/c")))
+	if n := len(buf.checkout()); n != 3 {
+		t.Errorf("got %d buffered updates, want 3", n)
+	}
+	if buf.coalescedCount() != 0 {
+		t.Errorf("got %d coalesced updates, want 0", buf.coalescedCount())
+	}
+}
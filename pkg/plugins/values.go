@@ -0,0 +1,211 @@
+package plugins
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"math"
+	"net"
+	"strconv"
+)
+
+// The value extractors below return the value of a gNMI TypedValue regardless of the encoding in use.
+// Scalar values are accepted as native gNMI types (PROTO), ASCII strings and JSON/JSON_IETF documents.
+// As per RFC 7951, 64 bits integers may be received as JSON strings. Some devices send numeric values,
+// like uint64 counters, as gNMI string values: they are parsed too. Unsupported values return the zero value.
+
+// UintVal returns the unsigned integer value of a gNMI TypedValue.
+func UintVal(v *gnmi.TypedValue) uint64 {
+	switch val := v.GetValue().(type) {
+	case *gnmi.TypedValue_UintVal:
+		return val.UintVal
+	case *gnmi.TypedValue_IntVal:
+		if val.IntVal >= 0 {
+			return uint64(val.IntVal)
+		}
+		return 0
+	case *gnmi.TypedValue_AsciiVal:
+		return parseUint(val.AsciiVal)
+	case *gnmi.TypedValue_StringVal:
+		return parseUint(val.StringVal)
+	}
+	switch j := jsonVal(v).(type) {
+	case json.Number:
+		return parseUint(j.String())
+	case string:
+		return parseUint(j)
+	}
+	return 0
+}
+
+// parseUint returns the unsigned integer value of a decimal string, or 0 if it is not a valid uint64.
+// Unlike strconv.ParseUint, out of range values return 0 rather than the max value.
+func parseUint(s string) uint64 {
+	out, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return out
+}
+
+// IntVal returns the signed integer value of a gNMI TypedValue.
+func IntVal(v *gnmi.TypedValue) int64 {
+	switch val := v.GetValue().(type) {
+	case *gnmi.TypedValue_IntVal:
+		return val.IntVal
+	case *gnmi.TypedValue_UintVal:
+		if val.UintVal <= math.MaxInt64 {
+			return int64(val.UintVal)
+		}
+		return 0
+	case *gnmi.TypedValue_AsciiVal:
+		return parseInt(val.AsciiVal)
+	case *gnmi.TypedValue_StringVal:
+		return parseInt(val.StringVal)
+	}
+	switch j := jsonVal(v).(type) {
+	case json.Number:
+		return parseInt(j.String())
+	case string:
+		return parseInt(j)
+	}
+	return 0
+}
+
+// parseInt returns the signed integer value of a decimal string, or 0 if it is not a valid int64.
+func parseInt(s string) int64 {
+	out, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return out
+}
+
+// FloatVal returns the floating point value of a gNMI TypedValue.
+// Besides float and double values, it decodes decimal64 values (e.g.: BER, Q-factor and OSNR leaves),
+// sent as gNMI Decimal64 by some devices, and integer values.
+func FloatVal(v *gnmi.TypedValue) float64 {
+	switch val := v.GetValue().(type) {
+	case *gnmi.TypedValue_DoubleVal:
+		return val.DoubleVal
+	case *gnmi.TypedValue_FloatVal:
+		// Deprecated by gNMI, still sent by some devices
+		return float64(val.FloatVal)
+	case *gnmi.TypedValue_DecimalVal:
+		// Deprecated by gNMI, still sent by some devices
+		return float64(val.DecimalVal.GetDigits()) / math.Pow10(int(val.DecimalVal.GetPrecision()))
+	case *gnmi.TypedValue_IntVal:
+		return float64(val.IntVal)
+	case *gnmi.TypedValue_UintVal:
+		return float64(val.UintVal)
+	case *gnmi.TypedValue_AsciiVal:
+		out, _ := strconv.ParseFloat(val.AsciiVal, 64)
+		return out
+	case *gnmi.TypedValue_StringVal:
+		out, _ := strconv.ParseFloat(val.StringVal, 64)
+		return out
+	}
+	switch j := jsonVal(v).(type) {
+	case json.Number:
+		out, _ := strconv.ParseFloat(j.String(), 64)
+		return out
+	case string:
+		// RFC 7951 encodes decimal64 values as JSON strings
+		out, _ := strconv.ParseFloat(j, 64)
+		return out
+	}
+	return 0
+}
+
+// BoolVal returns the boolean value of a gNMI TypedValue.
+func BoolVal(v *gnmi.TypedValue) bool {
+	switch val := v.GetValue().(type) {
+	case *gnmi.TypedValue_BoolVal:
+		return val.BoolVal
+	case *gnmi.TypedValue_AsciiVal:
+		out, _ := strconv.ParseBool(val.AsciiVal)
+		return out
+	}
+	out, _ := jsonVal(v).(bool)
+	return out
+}
+
+// StringVal returns the string value of a gNMI TypedValue.
+func StringVal(v *gnmi.TypedValue) string {
+	switch val := v.GetValue().(type) {
+	case *gnmi.TypedValue_StringVal:
+		return val.StringVal
+	case *gnmi.TypedValue_AsciiVal:
+		return val.AsciiVal
+	}
+	switch j := jsonVal(v).(type) {
+	case string:
+		return j
+	case json.Number:
+		return j.String()
+	}
+	return ""
+}
+
+// BinaryVal returns the string value of a gNMI TypedValue.
+// BYTES encoded values are rendered as colon separated hex strings (e.g.: aa:bb:cc:dd:ee:ff).
+// It is meant for binary friendly leaves, like MAC addresses and LLDP ids.
+func BinaryVal(v *gnmi.TypedValue) string {
+	if b := v.GetBytesVal(); b != nil {
+		return net.HardwareAddr(b).String()
+	}
+	return StringVal(v)
+}
+
+// LeaflistVal returns the elements of a leaf-list gNMI TypedValue.
+// JSON arrays are converted into scalar TypedValues.
+func LeaflistVal(v *gnmi.TypedValue) []*gnmi.TypedValue {
+	if ll := v.GetLeaflistVal(); ll != nil {
+		return ll.GetElement()
+	}
+	list, _ := jsonVal(v).([]any)
+	out := make([]*gnmi.TypedValue, 0, len(list))
+	for _, elem := range list {
+		switch e := elem.(type) {
+		case string:
+			out = append(out, &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: e}})
+		case bool:
+			out = append(out, &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: e}})
+		case json.Number:
+			// Kept as ASCII, so it can be read by any numeric extractor
+			out = append(out, &gnmi.TypedValue{Value: &gnmi.TypedValue_AsciiVal{AsciiVal: e.String()}})
+		}
+	}
+	return out
+}
+
+// jsonVal decodes a JSON or JSON_IETF TypedValue. It returns nil for other encodings or malformed documents.
+func jsonVal(v *gnmi.TypedValue) any {
+	var raw []byte
+	switch val := v.GetValue().(type) {
+	case *gnmi.TypedValue_JsonIetfVal:
+		raw = val.JsonIetfVal
+	case *gnmi.TypedValue_JsonVal:
+		raw = val.JsonVal
+	default:
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var out any
+	if err := dec.Decode(&out); err != nil {
+		return nil
+	}
+	return out
+}
+
+// countBytesVal returns the number of updates of the notification carrying a BYTES encoded value.
+func countBytesVal(nf *gnmi.Notification) uint64 {
+	var n uint64
+	for _, upd := range nf.GetUpdate() {
+		if upd.GetVal().GetBytesVal() != nil {
+			n++
+		}
+	}
+	return n
+}
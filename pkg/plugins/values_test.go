@@ -0,0 +1,126 @@
+package plugins
+
+import (
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"testing"
+)
+
+func bytesUpdate(b []byte) *gnmi.Update {
+	return &gnmi.Update{Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_BytesVal{BytesVal: b}}}
+}
+
+func uintUpdate(v uint64) *gnmi.Update {
+	return &gnmi.Update{Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: v}}}
+}
+
+func TestCountBytesVal(t *testing.T) {
+	tests := []struct {
+		name string
+		nf   *gnmi.Notification
+		want uint64
+	}{
+		{
+			name: "nil notification",
+			nf:   nil,
+			want: 0,
+		},
+		{
+			name: "no bytes",
+			nf:   &gnmi.Notification{Update: []*gnmi.Update{uintUpdate(1), uintUpdate(2)}},
+			want: 0,
+		},
+		{
+			name: "bytes only",
+			nf:   &gnmi.Notification{Update: []*gnmi.Update{bytesUpdate([]byte{0xaa, 0xbb}), bytesUpdate([]byte{1})}},
+			want: 2,
+		},
+		{
+			name: "mixed",
+			nf:   &gnmi.Notification{Update: []*gnmi.Update{uintUpdate(1), bytesUpdate([]byte{0xaa}), {}}},
+			want: 1,
+		},
+		{
+			name: "empty bytes",
+			nf:   &gnmi.Notification{Update: []*gnmi.Update{bytesUpdate([]byte{})}},
+			want: 1,
+		},
+		{
+			name: "nil update",
+			nf:   &gnmi.Notification{Update: []*gnmi.Update{nil, bytesUpdate([]byte{1})}},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := countBytesVal(tt.nf); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBinaryVal(t *testing.T) {
+	tests := []struct {
+		name string
+		val  *gnmi.TypedValue
+		want string
+	}{
+		{
+			name: "bytes",
+			val:  bytesUpdate([]byte{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}).Val,
+			want: "aa:bb:cc:dd:ee:ff",
+		},
+		{
+			name: "string",
+			val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "aa:bb"}},
+			want: "aa:bb",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BinaryVal(tt.val); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUintValString(t *testing.T) {
+	str := func(s string) *gnmi.TypedValue {
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: s}}
+	}
+	ascii := func(s string) *gnmi.TypedValue {
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_AsciiVal{AsciiVal: s}}
+	}
+	jsonIetf := func(s string) *gnmi.TypedValue {
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonIetfVal{JsonIetfVal: []byte(s)}}
+	}
+	tests := []struct {
+		name string
+		val  *gnmi.TypedValue
+		want uint64
+	}{
+		{name: "in-octets string", val: str("123456789012"), want: 123456789012},
+		{name: "string max uint64", val: str("18446744073709551615"), want: 18446744073709551615},
+		{name: "string negative", val: str("-1"), want: 0},
+		{name: "string overflow", val: str("18446744073709551616"), want: 0},
+		{name: "string non numeric", val: str("n/a"), want: 0},
+		{name: "string empty", val: str(""), want: 0},
+		{name: "ascii", val: ascii("42"), want: 42},
+		{name: "ascii negative", val: ascii("-42"), want: 0},
+		{name: "json_ietf string", val: jsonIetf(`"18446744073709551615"`), want: 18446744073709551615},
+		{name: "json_ietf string negative", val: jsonIetf(`"-5"`), want: 0},
+		{name: "json_ietf string overflow", val: jsonIetf(`"99999999999999999999"`), want: 0},
+		{name: "json_ietf string non numeric", val: jsonIetf(`"abc"`), want: 0},
+		{name: "json_ietf number", val: jsonIetf(`1000`), want: 1000},
+		{name: "uint", val: &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 7}}, want: 7},
+		{name: "nil", val: nil, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UintVal(tt.val); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}